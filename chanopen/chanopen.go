@@ -0,0 +1,111 @@
+package chanopen
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/funding"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// ErrNotFound is returned when a loop out doesn't open a channel.
+	ErrNotFound = errors.New("channel open not found")
+
+	// ErrCapacityTooLow is returned when the part of a loop out that is
+	// left for the channel is below the minimum channel size.
+	ErrCapacityTooLow = errors.New("channel capacity below minimum")
+
+	// ErrNoNegotiation is returned when the sweep of a loop out is
+	// finalized without an ongoing channel negotiation.
+	ErrNoNegotiation = errors.New("no ongoing channel negotiation")
+
+	// ErrNegotiationTimeout is returned when the peer doesn't accept the
+	// channel in time.
+	ErrNegotiationTimeout = errors.New("channel negotiation timed out")
+)
+
+// State is an enum which represents the state of a channel open.
+type State uint8
+
+const (
+	// StatePending indicates that the channel was not funded yet. Its
+	// funding is negotiated whenever the sweep of the loop out is
+	// published.
+	StatePending State = iota
+
+	// StateFunded indicates that the sweep of the loop out was finalized
+	// as the funding transaction of the channel.
+	StateFunded
+
+	// StateFallback indicates that the channel could not be negotiated,
+	// so the sweep of the loop out pays to its destination address.
+	StateFallback
+)
+
+// String returns a string representation of a state.
+func (s State) String() string {
+	switch s {
+	case StatePending:
+		return "pending"
+
+	case StateFunded:
+		return "funded"
+
+	case StateFallback:
+		return "fallback"
+
+	default:
+		return "unknown"
+	}
+}
+
+// ChannelOpen is a channel that is funded by the sweep of a loop out.
+type ChannelOpen struct {
+	// SwapHash is the hash of the loop out.
+	SwapHash lntypes.Hash
+
+	// Peer is the node that the channel is opened with.
+	Peer route.Vertex
+
+	// Private is set if the channel is not announced to the network.
+	Private bool
+
+	// Capacity is the capacity of the channel.
+	Capacity btcutil.Amount
+
+	// State is the state of the channel open.
+	State State
+
+	// SweepTx is the signed sweep transaction that funds the channel. It
+	// is set once the channel is funded.
+	SweepTx *wire.MsgTx
+
+	// FailureReason is the reason why the sweep fell back to paying its
+	// destination address.
+	FailureReason string
+
+	// Created is the time at which the channel open was requested.
+	Created time.Time
+
+	// Updated is the time at which the state last changed.
+	Updated time.Time
+}
+
+// Capacity returns the capacity of the channel that the sweep of a loop out
+// funds. The maximum miner fee of the swap is kept out of the channel, so
+// that it can pay the fee of the sweep, which pays the remainder to our
+// wallet.
+func Capacity(amt, maxMinerFee btcutil.Amount) (btcutil.Amount, error) {
+	capacity := amt - maxMinerFee
+	if capacity < funding.MinChanFundingSize {
+		return 0, fmt.Errorf("%w: %v is less than %v",
+			ErrCapacityTooLow, capacity, funding.MinChanFundingSize)
+	}
+
+	return capacity, nil
+}
@@ -0,0 +1,515 @@
+package chanopen
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/sweepbatcher"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// negotiationTimeout is the time that we wait for a peer to accept a channel
+// before we give up on the attempt.
+const negotiationTimeout = time.Minute
+
+// Store persists the channel opens of loop outs.
+type Store interface {
+	// AddChannelOpen persists the channel open of a loop out.
+	AddChannelOpen(ctx context.Context, open *ChannelOpen) error
+
+	// GetChannelOpen returns the channel open of a loop out, or
+	// ErrNotFound if the loop out doesn't open a channel.
+	GetChannelOpen(ctx context.Context,
+		swapHash lntypes.Hash) (*ChannelOpen, error)
+
+	// UpdateChannelOpen persists the state, sweep transaction and failure
+	// reason of a channel open.
+	UpdateChannelOpen(ctx context.Context, open *ChannelOpen) error
+}
+
+// Config contains the external functionality required by the manager.
+type Config struct {
+	// Store persists our channel opens.
+	Store Store
+
+	// Lightning is the lnd client that negotiates our channels.
+	Lightning lndclient.LightningClient
+
+	// ChainParams are the parameters of the network that we open channels
+	// on.
+	ChainParams *chaincfg.Params
+
+	// Clock allows easy mocking of time in unit tests.
+	Clock clock.Clock
+}
+
+// negotiation is an ongoing negotiation of a channel through lnd's PSBT
+// funding flow.
+type negotiation struct {
+	// pendingChanID identifies the channel in lnd until it is funded.
+	pendingChanID [32]byte
+
+	// output is the funding output of the channel. It is set before done
+	// is closed, if the negotiation succeeded.
+	output *wire.TxOut
+
+	// err is the error that the negotiation failed with. It is set before
+	// done is closed.
+	err error
+
+	// done is closed once lnd handed out the funding output of the
+	// channel, or the negotiation failed.
+	done chan struct{}
+
+	// cancel closes the open channel stream of the negotiation.
+	cancel func()
+}
+
+// ready returns a boolean indicating whether the negotiation completed,
+// successfully or not.
+func (n *negotiation) ready() bool {
+	select {
+	case <-n.done:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// Manager negotiates the channels that the sweeps of loop outs fund directly.
+// It implements the sweepbatcher's ChannelFunder, which asks it for the
+// funding output of a channel whenever the sweep of its loop out is
+// published. Channels are negotiated in the background, so that the batcher
+// isn't held up by slow peers: it gets the funding output once lnd handed it
+// out, at a later block. Funding transactions are published by the batcher,
+// so lnd is told not to publish them.
+type Manager struct {
+	cfg *Config
+
+	// mu protects negotiations.
+	mu sync.Mutex
+
+	// negotiations are the ongoing channel negotiations by swap hash.
+	negotiations map[lntypes.Hash]*negotiation
+}
+
+// A compile-time check that Manager implements the batcher's ChannelFunder.
+var _ sweepbatcher.ChannelFunder = (*Manager)(nil)
+
+// NewManager creates a new channel open manager.
+func NewManager(cfg *Config) *Manager {
+	return &Manager{
+		cfg:          cfg,
+		negotiations: make(map[lntypes.Hash]*negotiation),
+	}
+}
+
+// Register records that the sweep of a loop out funds a channel with a peer.
+func (m *Manager) Register(ctx context.Context, swapHash lntypes.Hash,
+	peer route.Vertex, private bool, capacity btcutil.Amount) error {
+
+	now := m.cfg.Clock.Now()
+	err := m.cfg.Store.AddChannelOpen(ctx, &ChannelOpen{
+		SwapHash: swapHash,
+		Peer:     peer,
+		Private:  private,
+		Capacity: capacity,
+		State:    StatePending,
+		Created:  now,
+		Updated:  now,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Sweep of swap %v funds a %v channel with %v", swapHash,
+		capacity, peer)
+
+	return nil
+}
+
+// ChannelOpen returns the channel open of a loop out, or ErrNotFound if the
+// loop out doesn't open a channel.
+func (m *Manager) ChannelOpen(ctx context.Context,
+	swapHash lntypes.Hash) (*ChannelOpen, error) {
+
+	return m.cfg.Store.GetChannelOpen(ctx, swapHash)
+}
+
+// FundingOutput returns the funding output of the channel that the sweep of a
+// loop out funds. If the channel is not negotiated yet, its negotiation is
+// started in the background and sweepbatcher.ErrFundingPending is returned
+// until it completes. If the sweep was finalized already, its transaction is
+// returned instead.
+func (m *Manager) FundingOutput(ctx context.Context,
+	swapHash lntypes.Hash) (*wire.TxOut, *wire.MsgTx, error) {
+
+	open, err := m.cfg.Store.GetChannelOpen(ctx, swapHash)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, nil, nil
+
+	case err != nil:
+		return nil, nil, err
+	}
+
+	switch open.State {
+	case StateFunded:
+		return nil, open.SweepTx, nil
+
+	case StateFallback:
+		return nil, nil, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	n, ok := m.negotiations[swapHash]
+	if !ok {
+		n, err = m.startNegotiation(ctx, open)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		m.negotiations[swapHash] = n
+	}
+
+	if !n.ready() {
+		return nil, nil, sweepbatcher.ErrFundingPending
+	}
+
+	// A failed negotiation is started over at the next attempt.
+	if n.err != nil {
+		delete(m.negotiations, swapHash)
+
+		return nil, nil, n.err
+	}
+
+	return n.output, nil, nil
+}
+
+// FinalizeFunding verifies the sweep transaction of a loop out with lnd and
+// hands it over as the funding transaction of its channel. The transaction is
+// persisted, so that it is published unchanged from now on.
+func (m *Manager) FinalizeFunding(ctx context.Context, swapHash lntypes.Hash,
+	packet []byte, tx *wire.MsgTx) error {
+
+	m.mu.Lock()
+	n, ok := m.negotiations[swapHash]
+	m.mu.Unlock()
+
+	if !ok || !n.ready() || n.err != nil {
+		return ErrNoNegotiation
+	}
+
+	_, err := m.cfg.Lightning.FundingStateStep(
+		ctx, &lnrpc.FundingTransitionMsg{
+			Trigger: &lnrpc.FundingTransitionMsg_PsbtVerify{
+				PsbtVerify: &lnrpc.FundingPsbtVerify{
+					FundedPsbt:    packet,
+					PendingChanId: n.pendingChanID[:],
+				},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("verify funding psbt: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return err
+	}
+
+	_, err = m.cfg.Lightning.FundingStateStep(
+		ctx, &lnrpc.FundingTransitionMsg{
+			Trigger: &lnrpc.FundingTransitionMsg_PsbtFinalize{
+				PsbtFinalize: &lnrpc.FundingPsbtFinalize{
+					FinalRawTx:    buf.Bytes(),
+					PendingChanId: n.pendingChanID[:],
+				},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("finalize funding psbt: %w", err)
+	}
+
+	// The negotiation is complete, so it must no longer be canceled. Its
+	// stream is closed once lnd reports the channel as pending.
+	m.mu.Lock()
+	delete(m.negotiations, swapHash)
+	m.mu.Unlock()
+
+	open, err := m.cfg.Store.GetChannelOpen(ctx, swapHash)
+	if err != nil {
+		return err
+	}
+
+	open.State = StateFunded
+	open.SweepTx = tx
+	open.Updated = m.cfg.Clock.Now()
+
+	err = m.cfg.Store.UpdateChannelOpen(ctx, open)
+	if err != nil {
+		return fmt.Errorf("persist funding tx: %w", err)
+	}
+
+	log.Infof("Sweep of swap %v funds channel %v:%v", swapHash,
+		tx.TxHash(), fundingIndex(tx, n.output))
+
+	return nil
+}
+
+// CancelFunding cancels the ongoing negotiation of the channel that the sweep
+// of a loop out funds. If fallBack is set, we give up on the channel, and the
+// sweep pays to its destination address.
+func (m *Manager) CancelFunding(ctx context.Context, swapHash lntypes.Hash,
+	fallBack bool, reason error) error {
+
+	m.cancelNegotiation(ctx, swapHash)
+
+	if !fallBack {
+		return nil
+	}
+
+	open, err := m.cfg.Store.GetChannelOpen(ctx, swapHash)
+	if err != nil {
+		return err
+	}
+
+	log.Warnf("Sweep of swap %v falls back to its destination address: "+
+		"%v", swapHash, reason)
+
+	open.State = StateFallback
+	open.FailureReason = reason.Error()
+	open.Updated = m.cfg.Clock.Now()
+
+	return m.cfg.Store.UpdateChannelOpen(ctx, open)
+}
+
+// startNegotiation starts to negotiate the channel of a loop out in the
+// background.
+func (m *Manager) startNegotiation(ctx context.Context,
+	open *ChannelOpen) (*negotiation, error) {
+
+	n := &negotiation{
+		done: make(chan struct{}),
+	}
+	if _, err := rand.Read(n.pendingChanID[:]); err != nil {
+		return nil, err
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	n.cancel = cancel
+
+	go func() {
+		defer close(n.done)
+
+		n.output, n.err = m.negotiate(ctx, streamCtx, open, n)
+	}()
+
+	return n, nil
+}
+
+// negotiate opens a channel through lnd's PSBT funding flow and waits for lnd
+// to hand out the funding output of the channel.
+func (m *Manager) negotiate(ctx, streamCtx context.Context, open *ChannelOpen,
+	n *negotiation) (*wire.TxOut, error) {
+
+	shim := &lnrpc.FundingShim{
+		Shim: &lnrpc.FundingShim_PsbtShim{
+			PsbtShim: &lnrpc.PsbtShim{
+				PendingChanId: n.pendingChanID[:],
+				NoPublish:     true,
+			},
+		},
+	}
+
+	updates, errChan, err := m.cfg.Lightning.OpenChannelStream(
+		streamCtx, open.Peer, open.Capacity, 0, open.Private,
+		lndclient.WithFundingShim(shim),
+	)
+	if err != nil {
+		n.cancel()
+
+		return nil, fmt.Errorf("open channel: %w", err)
+	}
+
+	timeout := m.cfg.Clock.TickAfter(negotiationTimeout)
+	for {
+		select {
+		case update := <-updates:
+			if update.PsbtFund == nil {
+				continue
+			}
+
+			output, err := m.fundingOutput(open, update.PsbtFund)
+			if err != nil {
+				m.cancelShim(ctx, n)
+
+				return nil, err
+			}
+
+			go m.watch(
+				streamCtx, open.SwapHash, n, updates, errChan,
+			)
+
+			return output, nil
+
+		case err := <-errChan:
+			n.cancel()
+
+			return nil, fmt.Errorf("channel negotiation failed: %w",
+				err)
+
+		case <-timeout:
+			m.cancelShim(ctx, n)
+
+			return nil, ErrNegotiationTimeout
+
+		case <-streamCtx.Done():
+			return nil, streamCtx.Err()
+		}
+	}
+}
+
+// fundingOutput checks the funding request of lnd against our channel open
+// and returns the funding output that it asks for.
+func (m *Manager) fundingOutput(open *ChannelOpen,
+	fund *lnrpc.ReadyForPsbtFunding) (*wire.TxOut, error) {
+
+	if btcutil.Amount(fund.FundingAmount) != open.Capacity {
+		return nil, fmt.Errorf("funding amount %v doesn't match "+
+			"capacity %v", btcutil.Amount(fund.FundingAmount),
+			open.Capacity)
+	}
+
+	addr, err := btcutil.DecodeAddress(
+		fund.FundingAddress, m.cfg.ChainParams,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("decode funding address: %w", err)
+	}
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wire.TxOut{
+		Value:    fund.FundingAmount,
+		PkScript: pkScript,
+	}, nil
+}
+
+// watch logs the updates of a negotiation until lnd reports its channel as
+// pending, or the negotiation fails.
+func (m *Manager) watch(ctx context.Context, swapHash lntypes.Hash,
+	n *negotiation, updates <-chan *lndclient.OpenStatusUpdate,
+	errChan <-chan error) {
+
+	defer n.cancel()
+
+	for {
+		select {
+		case update := <-updates:
+			if update.ChanPending == nil {
+				continue
+			}
+
+			txid, err := chainhash.NewHash(update.ChanPending.Txid)
+			if err != nil {
+				log.Errorf("Invalid funding txid of swap "+
+					"%v: %v", swapHash, err)
+
+				return
+			}
+
+			log.Infof("Channel of swap %v is pending in funding "+
+				"tx %v", swapHash, txid)
+
+			return
+
+		case err := <-errChan:
+			log.Debugf("Channel negotiation of swap %v ended: %v",
+				swapHash, err)
+
+			// If the sweep was not finalized yet, the negotiation
+			// is started over at the next attempt.
+			m.mu.Lock()
+			if m.negotiations[swapHash] == n {
+				delete(m.negotiations, swapHash)
+			}
+			m.mu.Unlock()
+
+			return
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// cancelNegotiation cancels the ongoing negotiation of a loop out, if there
+// is one.
+func (m *Manager) cancelNegotiation(ctx context.Context,
+	swapHash lntypes.Hash) {
+
+	m.mu.Lock()
+	n, ok := m.negotiations[swapHash]
+	delete(m.negotiations, swapHash)
+	m.mu.Unlock()
+
+	if ok {
+		m.cancelShim(ctx, n)
+	}
+}
+
+// cancelShim tells lnd to abandon a negotiation, and closes its stream.
+func (m *Manager) cancelShim(ctx context.Context, n *negotiation) {
+	defer n.cancel()
+
+	_, err := m.cfg.Lightning.FundingStateStep(
+		ctx, &lnrpc.FundingTransitionMsg{
+			Trigger: &lnrpc.FundingTransitionMsg_ShimCancel{
+				ShimCancel: &lnrpc.FundingShimCancel{
+					PendingChanId: n.pendingChanID[:],
+				},
+			},
+		},
+	)
+	if err != nil {
+		log.Debugf("Could not cancel funding shim %x: %v",
+			n.pendingChanID, err)
+	}
+}
+
+// fundingIndex returns the index of the funding output in a sweep
+// transaction.
+func fundingIndex(tx *wire.MsgTx, output *wire.TxOut) int {
+	for i, txOut := range tx.TxOut {
+		if txOut.Value == output.Value &&
+			bytes.Equal(txOut.PkScript, output.PkScript) {
+
+			return i
+		}
+	}
+
+	return -1
+}
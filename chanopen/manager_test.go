@@ -0,0 +1,282 @@
+package chanopen
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/sweepbatcher"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/funding"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// mockLightning is a lightning client that negotiates channels through the
+// PSBT funding flow.
+type mockLightning struct {
+	lndclient.LightningClient
+
+	mu sync.Mutex
+
+	// fund is the funding request that we hand out for a channel.
+	fund *lnrpc.ReadyForPsbtFunding
+
+	// openErr fails the negotiation of channels if it is set.
+	openErr error
+
+	// hold keeps the negotiation of channels pending if it is set.
+	hold bool
+
+	// shims are the funding shims that channels were opened with.
+	shims []*lnrpc.PsbtShim
+
+	// steps are the funding steps that were executed.
+	steps []*lnrpc.FundingTransitionMsg
+}
+
+// OpenChannelStream records the funding shim of the channel and either asks
+// for its funding, or fails.
+func (m *mockLightning) OpenChannelStream(_ context.Context, _ route.Vertex,
+	_, _ btcutil.Amount, _ bool, opts ...lndclient.OpenChannelOption) (
+	<-chan *lndclient.OpenStatusUpdate, <-chan error, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	req := &lnrpc.OpenChannelRequest{}
+	for _, opt := range opts {
+		opt(req)
+	}
+	m.shims = append(m.shims, req.FundingShim.GetPsbtShim())
+
+	updates := make(chan *lndclient.OpenStatusUpdate, 1)
+	errChan := make(chan error, 1)
+
+	switch {
+	case m.hold:
+
+	case m.openErr != nil:
+		errChan <- m.openErr
+
+	default:
+		updates <- &lndclient.OpenStatusUpdate{
+			PsbtFund: m.fund,
+		}
+	}
+
+	return updates, errChan, nil
+}
+
+// FundingStateStep records the funding step.
+func (m *mockLightning) FundingStateStep(_ context.Context,
+	req *lnrpc.FundingTransitionMsg) (*lnrpc.FundingStateStepResp, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.steps = append(m.steps, req)
+
+	return &lnrpc.FundingStateStepResp{}, nil
+}
+
+// TestChannelFunding tests negotiating the channels that the sweeps of loop
+// outs fund, and falling back to the destination address.
+func TestChannelFunding(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	params := &chaincfg.RegressionNetParams
+
+	testDb := loopdb.NewTestDB(t)
+	defer testDb.Close()
+
+	fundingAddr, err := btcutil.NewAddressWitnessScriptHash(
+		make([]byte, 32), params,
+	)
+	require.NoError(t, err)
+
+	lightning := &mockLightning{
+		fund: &lnrpc.ReadyForPsbtFunding{
+			FundingAddress: fundingAddr.String(),
+			FundingAmount:  100_000,
+		},
+	}
+
+	manager := NewManager(&Config{
+		Store: NewSQLStore(
+			loopdb.NewTypedStore[Querier](testDb),
+		),
+		Lightning:   lightning,
+		ChainParams: params,
+		Clock:       clock.NewTestClock(time.Unix(1000, 0)),
+	})
+
+	// Sweeps of loop outs that don't open a channel are left alone.
+	output, tx, err := manager.FundingOutput(ctx, lntypes.Hash{1})
+	require.NoError(t, err)
+	require.Nil(t, output)
+	require.Nil(t, tx)
+
+	peer := route.Vertex{2}
+	swapHash := lntypes.Hash{2}
+	err = manager.Register(ctx, swapHash, peer, true, 100_000)
+	require.NoError(t, err)
+
+	// fundingOutput waits for the negotiation of a channel, which runs in
+	// the background, and returns its outcome.
+	fundingOutput := func(swapHash lntypes.Hash) (*wire.TxOut,
+		*wire.MsgTx, error) {
+
+		var (
+			output *wire.TxOut
+			tx     *wire.MsgTx
+			err    error
+		)
+		require.Eventually(t, func() bool {
+			output, tx, err = manager.FundingOutput(ctx, swapHash)
+
+			return !errors.Is(err, sweepbatcher.ErrFundingPending)
+		}, time.Second*5, time.Millisecond*10)
+
+		return output, tx, err
+	}
+
+	// The channel is negotiated in the background without lnd publishing
+	// its funding transaction, and we get the output that funds it once
+	// lnd hands it out.
+	output, tx, err = fundingOutput(swapHash)
+	require.NoError(t, err)
+	require.Nil(t, tx)
+
+	pkScript, err := txscript.PayToAddrScript(fundingAddr)
+	require.NoError(t, err)
+	require.Equal(t, &wire.TxOut{
+		Value:    100_000,
+		PkScript: pkScript,
+	}, output)
+
+	require.Len(t, lightning.shims, 1)
+	require.True(t, lightning.shims[0].NoPublish)
+	pendingChanID := lightning.shims[0].PendingChanId
+
+	// Finalizing the sweep verifies and finalizes the PSBT with lnd, and
+	// persists the sweep so that it is published as is from now on.
+	sweepTx := wire.NewMsgTx(2)
+	sweepTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{3}},
+	})
+	sweepTx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{0x51}})
+	sweepTx.AddTxOut(output)
+
+	err = manager.FinalizeFunding(ctx, swapHash, []byte{1}, sweepTx)
+	require.NoError(t, err)
+
+	require.Len(t, lightning.steps, 2)
+	verify := lightning.steps[0].GetPsbtVerify()
+	require.Equal(t, []byte{1}, verify.FundedPsbt)
+	require.Equal(t, pendingChanID, verify.PendingChanId)
+	finalize := lightning.steps[1].GetPsbtFinalize()
+	require.Equal(t, pendingChanID, finalize.PendingChanId)
+
+	output, tx, err = manager.FundingOutput(ctx, swapHash)
+	require.NoError(t, err)
+	require.Nil(t, output)
+	require.Equal(t, sweepTx.TxHash(), tx.TxHash())
+
+	open, err := manager.ChannelOpen(ctx, swapHash)
+	require.NoError(t, err)
+	require.Equal(t, StateFunded, open.State)
+	require.Equal(t, peer, open.Peer)
+	require.True(t, open.Private)
+
+	// The negotiation of another channel fails, so it is retried until
+	// the batcher gives up on it.
+	lightning.openErr = errors.New("peer offline")
+
+	swapHash = lntypes.Hash{4}
+	err = manager.Register(ctx, swapHash, peer, false, 100_000)
+	require.NoError(t, err)
+
+	_, _, err = fundingOutput(swapHash)
+	require.ErrorIs(t, err, lightning.openErr)
+
+	err = manager.CancelFunding(ctx, swapHash, true, err)
+	require.NoError(t, err)
+
+	output, tx, err = manager.FundingOutput(ctx, swapHash)
+	require.NoError(t, err)
+	require.Nil(t, output)
+	require.Nil(t, tx)
+
+	open, err = manager.ChannelOpen(ctx, swapHash)
+	require.NoError(t, err)
+	require.Equal(t, StateFallback, open.State)
+	require.Contains(t, open.FailureReason, "peer offline")
+
+	// A funding request that doesn't match our channel is rejected, and
+	// its negotiation is canceled.
+	lightning.openErr = nil
+	lightning.fund.FundingAmount = 50_000
+
+	swapHash = lntypes.Hash{5}
+	err = manager.Register(ctx, swapHash, peer, false, 100_000)
+	require.NoError(t, err)
+
+	_, _, err = fundingOutput(swapHash)
+	require.Error(t, err)
+
+	last := lightning.steps[len(lightning.steps)-1]
+	require.Equal(
+		t, lightning.shims[len(lightning.shims)-1].PendingChanId,
+		last.GetShimCancel().PendingChanId,
+	)
+
+	// While a peer doesn't respond, its negotiation stays pending without
+	// holding up the batcher, and its sweep can't be finalized.
+	lightning.hold = true
+
+	swapHash = lntypes.Hash{6}
+	err = manager.Register(ctx, swapHash, peer, false, 100_000)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, _, err = manager.FundingOutput(ctx, swapHash)
+		require.ErrorIs(t, err, sweepbatcher.ErrFundingPending)
+	}
+
+	err = manager.FinalizeFunding(ctx, swapHash, []byte{1}, sweepTx)
+	require.ErrorIs(t, err, ErrNoNegotiation)
+
+	// Once the batcher gives up on the channel, the negotiation is
+	// canceled and the sweep pays to its destination address.
+	err = manager.CancelFunding(ctx, swapHash, true, err)
+	require.NoError(t, err)
+
+	output, tx, err = manager.FundingOutput(ctx, swapHash)
+	require.NoError(t, err)
+	require.Nil(t, output)
+	require.Nil(t, tx)
+}
+
+// TestCapacity tests that the maximum miner fee is kept out of channels, and
+// that channels below the minimum size are rejected.
+func TestCapacity(t *testing.T) {
+	capacity, err := Capacity(100_000, 5_000)
+	require.NoError(t, err)
+	require.Equal(t, btcutil.Amount(95_000), capacity)
+
+	_, err = Capacity(funding.MinChanFundingSize, 1)
+	require.ErrorIs(t, err, ErrCapacityTooLow)
+}
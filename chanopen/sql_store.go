@@ -0,0 +1,144 @@
+package chanopen
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// Querier holds the queries for the channel opens of loop outs, which are
+// keyed by the hash of their swap.
+type Querier interface {
+	// GetLoopOutChannelOpen returns the channel open of a loop out.
+	GetLoopOutChannelOpen(ctx context.Context,
+		swapHash []byte) (sqlc.LoopOutChannelOpen, error)
+
+	// InsertLoopOutChannelOpen inserts the channel open of a loop out.
+	InsertLoopOutChannelOpen(ctx context.Context,
+		arg sqlc.InsertLoopOutChannelOpenParams) error
+
+	// UpdateLoopOutChannelOpen updates the state of a channel open.
+	UpdateLoopOutChannelOpen(ctx context.Context,
+		arg sqlc.UpdateLoopOutChannelOpenParams) error
+}
+
+// BaseDB is the database that channel opens are stored in. Each open is a
+// single row that is updated in place, so no transactions are needed.
+type BaseDB interface {
+	Querier
+}
+
+// SQLStore manages the channel opens of loop outs in the database.
+type SQLStore struct {
+	baseDb BaseDB
+}
+
+// A compile-time check that SQLStore implements Store.
+var _ Store = (*SQLStore)(nil)
+
+// NewSQLStore returns a store for the channel opens of loop outs.
+func NewSQLStore(db BaseDB) *SQLStore {
+	return &SQLStore{
+		baseDb: db,
+	}
+}
+
+// AddChannelOpen persists the channel open of a loop out.
+func (s *SQLStore) AddChannelOpen(ctx context.Context,
+	open *ChannelOpen) error {
+
+	return s.baseDb.InsertLoopOutChannelOpen(
+		ctx, sqlc.InsertLoopOutChannelOpenParams{
+			SwapHash:  open.SwapHash[:],
+			Peer:      open.Peer[:],
+			Private:   open.Private,
+			Capacity:  int64(open.Capacity),
+			State:     int32(open.State),
+			CreatedAt: open.Created.UTC(),
+		},
+	)
+}
+
+// GetChannelOpen returns the channel open of a loop out, or ErrNotFound if
+// the loop out doesn't open a channel.
+func (s *SQLStore) GetChannelOpen(ctx context.Context,
+	swapHash lntypes.Hash) (*ChannelOpen, error) {
+
+	row, err := s.baseDb.GetLoopOutChannelOpen(ctx, swapHash[:])
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, ErrNotFound
+
+	case err != nil:
+		return nil, err
+	}
+
+	return channelOpenFromRow(row)
+}
+
+// UpdateChannelOpen persists the state, sweep transaction and failure reason
+// of a channel open.
+func (s *SQLStore) UpdateChannelOpen(ctx context.Context,
+	open *ChannelOpen) error {
+
+	var sweepTx []byte
+	if open.SweepTx != nil {
+		var buf bytes.Buffer
+		if err := open.SweepTx.Serialize(&buf); err != nil {
+			return err
+		}
+
+		sweepTx = buf.Bytes()
+	}
+
+	return s.baseDb.UpdateLoopOutChannelOpen(
+		ctx, sqlc.UpdateLoopOutChannelOpenParams{
+			SwapHash:      open.SwapHash[:],
+			State:         int32(open.State),
+			SweepTx:       sweepTx,
+			FailureReason: open.FailureReason,
+			UpdatedAt:     open.Updated.UTC(),
+		},
+	)
+}
+
+// channelOpenFromRow converts a database row to a channel open.
+func channelOpenFromRow(row sqlc.LoopOutChannelOpen) (*ChannelOpen, error) {
+	swapHash, err := lntypes.MakeHash(row.SwapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	peer, err := route.NewVertexFromBytes(row.Peer)
+	if err != nil {
+		return nil, err
+	}
+
+	open := &ChannelOpen{
+		SwapHash:      swapHash,
+		Peer:          peer,
+		Private:       row.Private,
+		Capacity:      btcutil.Amount(row.Capacity),
+		State:         State(row.State),
+		FailureReason: row.FailureReason,
+		Created:       row.CreatedAt,
+		Updated:       row.UpdatedAt,
+	}
+
+	if len(row.SweepTx) > 0 {
+		open.SweepTx = &wire.MsgTx{}
+		err := open.SweepTx.Deserialize(bytes.NewReader(row.SweepTx))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return open, nil
+}
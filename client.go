@@ -174,6 +174,10 @@ type ClientConfig struct {
 	// MaxStaticAddrHtlcFeePercentage since it serves the server as backup
 	// transaction in case of fee spikes.
 	MaxStaticAddrHtlcBackupFeePercentage float64
+
+	// ChannelFunder negotiates the channels that the sweeps of loop outs
+	// fund directly. If it is nil, sweeps never fund channels.
+	ChannelFunder sweepbatcher.ChannelFunder
 }
 
 // NewClient returns a new instance to initiate swaps with.
@@ -285,6 +289,13 @@ func NewClient(dbDir string, loopDB loopdb.SwapStore,
 		))
 	}
 
+	if cfg.ChannelFunder != nil {
+		batcherOpts = append(
+			batcherOpts,
+			sweepbatcher.WithChannelFunder(cfg.ChannelFunder),
+		)
+	}
+
 	batcher := sweepbatcher.NewBatcher(
 		cfg.Lnd.WalletKit, cfg.Lnd.ChainNotifier, cfg.Lnd.Signer,
 		swapServerClient.MultiMuSig2SignSweep, verifySchnorrSig,
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/urfave/cli"
+)
+
+var listDecisionsCommand = cli.Command{
+	Name:  "decisions",
+	Usage: "list the decisions recorded in the autoloop journal",
+	Description: `
+	Lists the decisions made by autoloop on each run, including the swaps
+	that were suggested, the channels and peers that were disqualified along
+	with their reasons, the autoloop budget at the time and the hashes of
+	any swaps that were dispatched.
+
+	Decisions are returned in pages, ordered by id. To fetch the next page,
+	pass the last_index_offset of the previous response as --index_offset.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "channel",
+			Usage: "only list decisions that considered this " +
+				"channel.",
+		},
+		cli.StringFlag{
+			Name: "peer",
+			Usage: "only list decisions that considered this peer, " +
+				"or one of its channels.",
+		},
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "unix timestamp in seconds to select decisions " +
+				"made at or after this time.",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "unix timestamp in seconds to select decisions " +
+				"made before this time.",
+		},
+		cli.Uint64Flag{
+			Name: "index_offset",
+			Usage: "the id of the last decision of a previous " +
+				"page, only decisions after it are listed.",
+		},
+		cli.Uint64Flag{
+			Name:  "max_decisions",
+			Usage: "the maximum number of decisions to list.",
+			Value: 100,
+		},
+	},
+	Action: listDecisions,
+}
+
+func listDecisions(ctx *cli.Context) error {
+	if ctx.NArg() > 0 {
+		return cli.ShowCommandHelp(ctx, "decisions")
+	}
+
+	req := &looprpc.ListAutoloopDecisionsRequest{
+		ChannelId:    ctx.Uint64("channel"),
+		StartTime:    ctx.Int64("start_time"),
+		EndTime:      ctx.Int64("end_time"),
+		IndexOffset:  ctx.Uint64("index_offset"),
+		MaxDecisions: uint32(ctx.Uint64("max_decisions")),
+	}
+
+	if ctx.IsSet("peer") {
+		peer, err := route.NewVertexFromStr(ctx.String("peer"))
+		if err != nil {
+			return fmt.Errorf("invalid peer: %w", err)
+		}
+
+		req.Pubkey = peer[:]
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListAutoloopDecisions(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
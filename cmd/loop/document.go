@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var exportParamsCommand = cli.Command{
+	Name:  "export",
+	Usage: "export the liquidity parameters as a JSON document",
+	Description: `
+	Writes the liquidity manager's current parameters as a JSON document
+	that can be edited and imported on other nodes. Rules are listed in the
+	document's rules, each with the channel, peer or node that it applies
+	to. Budget refresh times are not exported.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "output",
+			Usage: "the path to write the document to, if not " +
+				"set the document is printed.",
+		},
+	},
+	Action: exportParams,
+}
+
+func exportParams(ctx *cli.Context) error {
+	if ctx.NArg() > 0 {
+		return cli.ShowCommandHelp(ctx, "export")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ExportLiquidityParams(
+		context.Background(), &looprpc.ExportLiquidityParamsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	docBytes, err := lnrpc.ProtoJSONMarshalOpts.Marshal(resp.Document)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsSet("output") {
+		fmt.Println(string(docBytes))
+		return nil
+	}
+
+	docBytes = append(docBytes, '\n')
+
+	return os.WriteFile(ctx.String("output"), docBytes, 0600)
+}
+
+var importParamsCommand = cli.Command{
+	Name:      "import",
+	Usage:     "import liquidity parameters from a JSON document",
+	ArgsUsage: "file",
+	Description: `
+	Validates the liquidity parameters in a JSON document, in the format
+	written by export, and lists the parameters that it changes. The
+	parameters are set once the changes are confirmed.
+
+	Each rule in the document applies to a channel, a peer or the node, and
+	peers may be identified by pubkey or by the alias of a channel peer.
+	Setting all_channels on a peer's rule applies it to each of the peer's
+	channels. If a channel rule also sets a peer, the channel must belong
+	to that peer.
+	`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "dry_run",
+			Usage: "validate the document and list the parameters " +
+				"that it changes, without setting them.",
+		},
+		cli.BoolFlag{
+			Name: "force, f",
+			Usage: "set the parameters without asking for " +
+				"confirmation.",
+		},
+	},
+	Action: importParams,
+}
+
+func importParams(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "import")
+	}
+
+	docBytes, err := os.ReadFile(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	doc := &looprpc.LiquidityParamsDocument{}
+	err = lnrpc.ProtoJSONUnmarshalOpts.Unmarshal(docBytes, doc)
+	if err != nil {
+		return fmt.Errorf("could not parse document: %w", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	// We always validate the document first, so that the changes can be
+	// reviewed before anything is set.
+	resp, err := client.ImportLiquidityParams(
+		context.Background(), &looprpc.ImportLiquidityParamsRequest{
+			Document: doc,
+			DryRun:   true,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	if ctx.Bool("dry_run") {
+		return nil
+	}
+
+	if len(resp.Changes) == 0 {
+		fmt.Println("No parameters changed")
+		return nil
+	}
+
+	if !ctx.Bool("force") {
+		fmt.Printf("IMPORT PARAMETERS? (y/n): ")
+
+		var answer string
+		fmt.Scanln(&answer)
+		if answer != "y" {
+			return errors.New("import canceled")
+		}
+	}
+
+	_, err = client.ImportLiquidityParams(
+		context.Background(), &looprpc.ImportLiquidityParamsRequest{
+			Document: doc,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Imported liquidity parameters")
+
+	return nil
+}
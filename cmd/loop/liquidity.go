@@ -5,10 +5,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/lightninglabs/loop/liquidity"
 	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/urfave/cli"
 	"google.golang.org/grpc/codes"
@@ -43,10 +47,12 @@ func getParams(ctx *cli.Context) error {
 }
 
 var setLiquidityRuleCommand = cli.Command{
-	Name:        "setrule",
-	Usage:       "set liquidity manager rule for a channel/peer",
-	Description: "Update or remove the liquidity rule for a channel/peer.",
-	ArgsUsage:   "{shortchanid | peerpubkey}",
+	Name:  "setrule",
+	Usage: "set liquidity manager rule for a channel/peer/node",
+	Description: "Update or remove the liquidity rule for a channel/peer, " +
+		"or set a rule for the aggregate balances of all channels " +
+		"with the node argument.",
+	ArgsUsage: "{shortchanid | peerpubkey | node}",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name: "type",
@@ -67,6 +73,68 @@ var setLiquidityRuleCommand = cli.Command{
 			Usage: "the minimum percentage of outbound liquidity " +
 				"that we do not want to drop below.",
 		},
+		cli.Uint64Flag{
+			Name: "incoming_sat",
+			Usage: "the minimum amount of incoming liquidity in " +
+				"satoshis beneath which to recommend loop " +
+				"out to acquire incoming, may not be set " +
+				"with percentage thresholds.",
+		},
+		cli.Uint64Flag{
+			Name: "outgoing_sat",
+			Usage: "the minimum amount of outbound liquidity in " +
+				"satoshis that we do not want to drop " +
+				"below, may not be set with percentage " +
+				"thresholds.",
+		},
+		cli.IntFlag{
+			Name: "opportunistic_band",
+			Usage: "the number of percentage points above the " +
+				"incoming threshold within which to " +
+				"recommend loop out early when on-chain " +
+				"fees are at or below " +
+				"opportunistic_feerate, loop out only.",
+		},
+		cli.Uint64Flag{
+			Name: "opportunistic_feerate",
+			Usage: "the sweep fee rate estimate in sat/vbyte " +
+				"at or below which opportunistic loop outs " +
+				"are recommended.",
+		},
+		cli.Uint64Flag{
+			Name: "budget",
+			Usage: "the maximum amount of fees in satoshis that " +
+				"automatically dispatched swaps for this " +
+				"channel/peer may spend per budget period, " +
+				"in addition to the global autoloop budget.",
+		},
+		cli.DurationFlag{
+			Name: "budgetrefreshperiod",
+			Usage: "the time period over which the " +
+				"channel/peer budget is refreshed.",
+		},
+		cli.DurationFlag{
+			Name: "forecast_lookback",
+			Usage: "the period of forwarding history used to " +
+				"estimate the rate at which the " +
+				"channel/peer's liquidity is drained, " +
+				"percentage thresholds only.",
+		},
+		cli.DurationFlag{
+			Name: "forecast_horizon",
+			Usage: "the period over which liquidity is " +
+				"projected, swaps are recommended if " +
+				"projected liquidity crosses a threshold " +
+				"within this period.",
+		},
+		cli.StringFlag{
+			Name: "asset_id",
+			Usage: "the hex encoded id of a taproot asset to set " +
+				"the channel/peer's rule for, thresholds " +
+				"are applied to the asset balances of its " +
+				"asset channels, loop out percentage " +
+				"thresholds only.",
+		},
 		cli.BoolFlag{
 			Name: "clear",
 			Usage: "remove the rule currently set for the " +
@@ -77,24 +145,31 @@ var setLiquidityRuleCommand = cli.Command{
 }
 
 func setRule(ctx *cli.Context) error {
-	// We require that a channel ID is set for this rule update.
+	// We require that a channel ID, peer or node is set for this rule
+	// update.
 	if ctx.NArg() != 1 {
-		return fmt.Errorf("please set a channel id or peer pubkey " +
-			"for the rule update")
+		return fmt.Errorf("please set a channel id, peer pubkey or " +
+			"node for the rule update")
 	}
 
 	var (
 		pubkey     route.Vertex
 		pubkeyRule bool
+		nodeRule   = ctx.Args().First() == "node"
+		chanID     uint64
+		err        error
 	)
-	chanID, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
-	if err != nil {
-		pubkey, err = route.NewVertexFromStr(ctx.Args().First())
+	if !nodeRule {
+		chanID, err = strconv.ParseUint(ctx.Args().First(), 10, 64)
 		if err != nil {
-			return fmt.Errorf("please provide a valid pubkey: "+
-				"%v, or short channel ID", err)
+			pubkey, err = route.NewVertexFromStr(ctx.Args().First())
+			if err != nil {
+				return fmt.Errorf("please provide a valid "+
+					"pubkey: %v, short channel ID or node",
+					err)
+			}
+			pubkeyRule = true
 		}
-		pubkeyRule = true
 	}
 
 	client, cleanup, err := getClient(ctx)
@@ -116,6 +191,15 @@ func setRule(ctx *cli.Context) error {
 	var (
 		inboundSet  = ctx.IsSet("incoming_threshold")
 		outboundSet = ctx.IsSet("outgoing_threshold")
+		inSatSet    = ctx.IsSet("incoming_sat")
+		outSatSet   = ctx.IsSet("outgoing_sat")
+		bandSet     = ctx.IsSet("opportunistic_band")
+		feeRateSet  = ctx.IsSet("opportunistic_feerate")
+		budgetSet   = ctx.IsSet("budget")
+		refreshSet  = ctx.IsSet("budgetrefreshperiod")
+		lookbackSet = ctx.IsSet("forecast_lookback")
+		horizonSet  = ctx.IsSet("forecast_horizon")
+		assetID     = ctx.String("asset_id")
 		ruleSet     bool
 		otherRules  []*looprpc.LiquidityRule
 	)
@@ -123,8 +207,14 @@ func setRule(ctx *cli.Context) error {
 	// Run through our current set of rules and check whether we have a rule
 	// currently set for this channel or peer. We also track a slice
 	// containing all of the rules we currently have set for other channels,
-	// and peers because we want to leave these rules untouched.
+	// and peers because we want to leave these rules untouched. Rules that
+	// are set for an asset are only matched if we set the same asset.
 	for _, rule := range params.Rules {
+		if rule.AssetId != assetID {
+			otherRules = append(otherRules, rule)
+			continue
+		}
+
 		var (
 			channelRuleSet = rule.ChannelId != 0 &&
 				rule.ChannelId == chanID
@@ -132,9 +222,11 @@ func setRule(ctx *cli.Context) error {
 			peerRuleSet = rule.Pubkey != nil && bytes.Equal(
 				rule.Pubkey, pubkey[:],
 			)
+
+			nodeRuleSet = nodeRule && rule.Node
 		)
 
-		if channelRuleSet || peerRuleSet {
+		if channelRuleSet || peerRuleSet || nodeRuleSet {
 			ruleSet = true
 		} else {
 			otherRules = append(otherRules, rule)
@@ -146,11 +238,14 @@ func setRule(ctx *cli.Context) error {
 	// set excluding the channel specified.
 	if ctx.IsSet("clear") {
 		if !ruleSet {
-			return fmt.Errorf("cannot clear channel: %v, no rule "+
-				"set at present", chanID)
+			return fmt.Errorf("cannot clear rule for: %v, no rule "+
+				"set at present", ctx.Args().First())
 		}
 
-		if inboundSet || outboundSet {
+		if inboundSet || outboundSet || inSatSet || outSatSet ||
+			bandSet || feeRateSet || budgetSet || refreshSet ||
+			lookbackSet || horizonSet {
+
 			return fmt.Errorf("do not set other flags with clear " +
 				"flag")
 		}
@@ -167,15 +262,47 @@ func setRule(ctx *cli.Context) error {
 
 	// If we are setting a rule for this channel (not clearing it), check
 	// that at least one value is set.
-	if !inboundSet && !outboundSet {
+	var (
+		thresholdSet = inboundSet || outboundSet
+		amountSet    = inSatSet || outSatSet
+	)
+
+	if !thresholdSet && !amountSet {
 		return fmt.Errorf("provide at least one flag to set rules or " +
 			"use the --clear flag to remove rules")
 	}
 
+	if thresholdSet && amountSet {
+		return fmt.Errorf("percentage thresholds cannot be set with " +
+			"satoshi amounts")
+	}
+
+	if bandSet != feeRateSet {
+		return fmt.Errorf("opportunistic_band and " +
+			"opportunistic_feerate must be set together")
+	}
+
+	if budgetSet != refreshSet {
+		return fmt.Errorf("budget and budgetrefreshperiod must be " +
+			"set together")
+	}
+
+	if lookbackSet != horizonSet {
+		return fmt.Errorf("forecast_lookback and forecast_horizon " +
+			"must be set together")
+	}
+
+	if lookbackSet && amountSet {
+		return fmt.Errorf("forecasts can only be set with " +
+			"percentage thresholds")
+	}
+
 	// Create a new rule which will be used to overwrite our current rule.
 	newRule := &looprpc.LiquidityRule{
 		ChannelId: chanID,
 		Type:      looprpc.LiquidityRuleType_THRESHOLD,
+		Node:      nodeRule,
+		AssetId:   assetID,
 	}
 	if ctx.IsSet("type") {
 		switch ctx.String("type") {
@@ -206,6 +333,41 @@ func setRule(ctx *cli.Context) error {
 		)
 	}
 
+	if amountSet {
+		newRule.Type = looprpc.LiquidityRuleType_AMOUNT
+		newRule.MinIncomingSat = ctx.Uint64("incoming_sat")
+		newRule.MinOutgoingSat = ctx.Uint64("outgoing_sat")
+	}
+
+	if lookbackSet {
+		newRule.Type = looprpc.LiquidityRuleType_PREDICTIVE
+		newRule.ForecastLookbackSec = uint64(
+			ctx.Duration("forecast_lookback").Seconds(),
+		)
+		newRule.ForecastHorizonSec = uint64(
+			ctx.Duration("forecast_horizon").Seconds(),
+		)
+	}
+
+	if bandSet {
+		newRule.OpportunisticBandPercent = uint32(
+			ctx.Int("opportunistic_band"),
+		)
+		newRule.OpportunisticFeeRateSatPerVbyte = ctx.Uint64(
+			"opportunistic_feerate",
+		)
+	}
+
+	// Start the budget period for the rule now, so that swaps that were
+	// completed before the budget was set do not count towards it.
+	if budgetSet {
+		newRule.BudgetSat = ctx.Uint64("budget")
+		newRule.BudgetRefreshPeriodSec = uint64(
+			ctx.Duration("budgetrefreshperiod").Seconds(),
+		)
+		newRule.BudgetLastRefresh = uint64(time.Now().Unix())
+	}
+
 	// Just set the rules on our current set of parameters and leave the
 	// other values untouched.
 	otherRules = append(otherRules, newRule)
@@ -280,6 +442,14 @@ var setParamsCommand = cli.Command{
 				"previously had a failed swap will be " +
 				"included in suggestions.",
 		},
+		cli.Uint64Flag{
+			Name: "failurebackoffmax",
+			Usage: "the maximum amount of time, in seconds, that " +
+				"the failure backoff grows to for channels " +
+				"that have consecutive failed swaps, set to " +
+				"0 to use a fixed failure backoff that " +
+				"does not grow.",
+		},
 		cli.BoolFlag{
 			Name: "autoloop",
 			Usage: "set to true to enable automated dispatch " +
@@ -292,6 +462,14 @@ var setParamsCommand = cli.Command{
 				"autoloop loop out, set to \"default\" in " +
 				"order to revert to default behavior.",
 		},
+		cli.StringFlag{
+			Name: "destdescriptor",
+			Usage: "watch-only output descriptor or extended " +
+				"public key that autoloop loop outs derive a " +
+				"fresh destination address from, set to " +
+				"\"default\" in order to revert to default " +
+				"behavior.",
+		},
 		cli.StringFlag{
 			Name: "account",
 			Usage: "the name of the account to generate a new " +
@@ -350,6 +528,13 @@ var setParamsCommand = cli.Command{
 			Usage: "the target size of total local balance in " +
 				"satoshis, used by easy autoloop.",
 		},
+		cli.Uint64Flag{
+			Name: "localminimumsat",
+			Usage: "the minimum total local balance in satoshis, " +
+				"below which easy autoloop will dispatch loop " +
+				"ins from the on-chain wallet. Must be below " +
+				"localbalancesat. Set to 0 to disable.",
+		},
 		cli.BoolFlag{
 			Name: "asset_easyautoloop",
 			Usage: "set to true to enable asset easy autoloop, which " +
@@ -367,6 +552,73 @@ var setParamsCommand = cli.Command{
 			Usage: "the target size of total local balance in " +
 				"asset units, used by asset easy autoloop.",
 		},
+		cli.BoolFlag{
+			Name: "staticloopin",
+			Usage: "set to true to fund the loop ins suggested " +
+				"by liquidity rules with static address " +
+				"deposits rather than the on-chain wallet.",
+		},
+		cli.BoolFlag{
+			Name: "requireapproval",
+			Usage: "set to true to add the swaps suggested by " +
+				"autoloop to an approval queue rather than " +
+				"dispatching them.",
+		},
+		cli.DurationFlag{
+			Name: "approvalexpiry",
+			Usage: "the amount of time that a queued swap may " +
+				"be approved for before it expires.",
+		},
+		cli.Uint64Flag{
+			Name: "minchanage",
+			Usage: "the minimum number of confirmations that a " +
+				"channel must have before autoloop considers " +
+				"it for swaps, set to 0 to consider channels " +
+				"of any age.",
+		},
+		cli.Uint64Flag{
+			Name: "minpeeruptime",
+			Usage: "the minimum percentage of time that a peer " +
+				"must have been online for autoloop to " +
+				"consider its channels for swaps, set to 0 " +
+				"to disable.",
+		},
+		cli.BoolFlag{
+			Name: "includepending",
+			Usage: "set to true to count pending htlcs, pending " +
+				"channel opens and in-flight swaps in the " +
+				"balances that liquidity rules are applied to.",
+		},
+		cli.DurationFlag{
+			Name: "roilookback",
+			Usage: "the period of forwarding history used to " +
+				"estimate the routing revenue of a loop " +
+				"out's channels.",
+		},
+		cli.DurationFlag{
+			Name: "roipayback",
+			Usage: "the period that the routing revenue of a " +
+				"loop out's channels must cover its cost " +
+				"within, set to 0 to disable.",
+		},
+		cli.Uint64Flag{
+			Name: "jitteramount",
+			Usage: "the maximum percentage that autoloop reduces " +
+				"the amount of a dispatched swap by, set to " +
+				"0 to disable.",
+		},
+		cli.DurationFlag{
+			Name: "jitterdelay",
+			Usage: "the maximum random delay that autoloop waits " +
+				"for before dispatching swaps, set to 0 to " +
+				"disable.",
+		},
+		cli.Uint64Flag{
+			Name: "jitterconfspread",
+			Usage: "the maximum number of blocks that autoloop " +
+				"adds to the sweep confirmation target of a " +
+				"dispatched loop out, set to 0 to disable.",
+		},
 		cli.BoolFlag{
 			Name: "fast",
 			Usage: "if set new swaps are expected to be " +
@@ -377,6 +629,34 @@ var setParamsCommand = cli.Command{
 				"save on chain fees. Not setting this flag " +
 				"therefore might result in a lower swap fees",
 		},
+		cli.StringSliceFlag{
+			Name: "schedule",
+			Usage: "a window in which automated swaps may be " +
+				"dispatched, expressed as days:hours in UTC, " +
+				"for example \"mon-fri:1-6\" or \"*:22-4\". " +
+				"Days may be a range or comma separated " +
+				"list, hours are start (inclusive) to end " +
+				"(exclusive), and wrap past midnight if " +
+				"the end is before the start, in which " +
+				"case the days are those that the window " +
+				"opens on. May be repeated to set " +
+				"multiple windows, replacing any windows " +
+				"currently set.",
+		},
+		cli.BoolFlag{
+			Name: "clearschedule",
+			Usage: "remove all schedule windows so that " +
+				"automated swaps may be dispatched at any " +
+				"time.",
+		},
+		cli.Uint64Flag{
+			Name: "feerateceiling",
+			Usage: "the on-chain fee rate in sat/vbyte, " +
+				"estimated for the sweep confirmation " +
+				"target, above which automated swaps are " +
+				"not dispatched, set to 0 to remove the " +
+				"ceiling.",
+		},
 	},
 	Action: setParams,
 }
@@ -477,6 +757,12 @@ func setParams(ctx *cli.Context) error {
 		flagSet = true
 	}
 
+	if ctx.IsSet("failurebackoffmax") {
+		params.FailureBackoffMaxSec = ctx.Uint64("failurebackoffmax")
+		params.FailureBackoffFixed = params.FailureBackoffMaxSec == 0
+		flagSet = true
+	}
+
 	if ctx.IsSet("autoloop") {
 		params.Autoloop = ctx.Bool("autoloop")
 		flagSet = true
@@ -492,9 +778,24 @@ func setParams(ctx *cli.Context) error {
 		return fmt.Errorf("cannot set destaddr and account at the " +
 			"same time")
 
+	case ctx.IsSet("destdescriptor") && (ctx.IsSet("destaddr") ||
+		ctx.IsSet("account")):
+
+		return fmt.Errorf("cannot set destdescriptor along with " +
+			"destaddr or account")
+
 	case ctx.IsSet("destaddr"):
 		params.AutoloopDestAddress = ctx.String("destaddr")
 		params.Account = ""
+		params.AutoloopDestDescriptor = ""
+		flagSet = true
+
+	case ctx.IsSet("destdescriptor"):
+		params.AutoloopDestDescriptor = ctx.String("destdescriptor")
+		params.AutoloopDestAddress = ""
+		params.Account = ""
+		params.AccountAddrType =
+			looprpc.AddressType_ADDRESS_TYPE_UNKNOWN
 		flagSet = true
 
 	case ctx.IsSet("account") != ctx.IsSet("account_addr_type"):
@@ -503,6 +804,7 @@ func setParams(ctx *cli.Context) error {
 	case ctx.IsSet("account"):
 		params.Account = ctx.String("account")
 		params.AutoloopDestAddress = ""
+		params.AutoloopDestDescriptor = ""
 		flagSet = true
 	}
 
@@ -567,6 +869,11 @@ func setParams(ctx *cli.Context) error {
 		flagSet = true
 	}
 
+	if ctx.IsSet("localminimumsat") {
+		params.EasyAutoloopLocalMinimumSat = ctx.Uint64("localminimumsat")
+		flagSet = true
+	}
+
 	if ctx.IsSet("asset_easyautoloop") {
 		if !ctx.IsSet("asset_id") {
 			return fmt.Errorf("asset_id must be set to use " +
@@ -587,10 +894,111 @@ func setParams(ctx *cli.Context) error {
 		flagSet = true
 	}
 
+	if ctx.IsSet("staticloopin") {
+		params.StaticLoopIn = ctx.Bool("staticloopin")
+		flagSet = true
+	}
+
+	if ctx.IsSet("requireapproval") {
+		params.RequireApproval = ctx.Bool("requireapproval")
+		flagSet = true
+	}
+
+	if ctx.IsSet("approvalexpiry") {
+		params.ApprovalExpirySec = uint64(
+			ctx.Duration("approvalexpiry").Seconds(),
+		)
+		flagSet = true
+	}
+
+	if ctx.IsSet("minchanage") {
+		params.MinChannelAgeBlocks = uint32(ctx.Uint64("minchanage"))
+		flagSet = true
+	}
+
+	if ctx.IsSet("minpeeruptime") {
+		params.MinPeerUptimePercent = uint32(
+			ctx.Uint64("minpeeruptime"),
+		)
+		flagSet = true
+	}
+
+	if ctx.IsSet("includepending") {
+		params.IncludePending = ctx.Bool("includepending")
+		flagSet = true
+	}
+
+	if ctx.IsSet("roilookback") {
+		params.RoiLookbackSec = uint64(
+			ctx.Duration("roilookback").Seconds(),
+		)
+		flagSet = true
+	}
+
+	// Clearing our payback period disables our roi gate, so we clear our
+	// lookback along with it.
+	if ctx.IsSet("roipayback") {
+		params.RoiPaybackSec = uint64(
+			ctx.Duration("roipayback").Seconds(),
+		)
+		if params.RoiPaybackSec == 0 {
+			params.RoiLookbackSec = 0
+		}
+		flagSet = true
+	}
+
+	if ctx.IsSet("jitteramount") {
+		params.JitterAmountPercent = uint32(ctx.Uint64("jitteramount"))
+		flagSet = true
+	}
+
+	if ctx.IsSet("jitterdelay") {
+		params.JitterDispatchDelaySec = uint64(
+			ctx.Duration("jitterdelay").Seconds(),
+		)
+		flagSet = true
+	}
+
+	if ctx.IsSet("jitterconfspread") {
+		params.JitterConfTargetSpread = uint32(
+			ctx.Uint64("jitterconfspread"),
+		)
+		flagSet = true
+	}
+
 	if ctx.IsSet("fast") {
 		params.FastSwapPublication = true
 	}
 
+	if ctx.IsSet("schedule") && ctx.IsSet("clearschedule") {
+		return fmt.Errorf("schedule cannot be set with clearschedule")
+	}
+
+	if ctx.IsSet("schedule") {
+		params.ScheduleWindows = nil
+		for _, window := range ctx.StringSlice("schedule") {
+			rpcWindow, err := parseScheduleWindow(window)
+			if err != nil {
+				return err
+			}
+
+			params.ScheduleWindows = append(
+				params.ScheduleWindows, rpcWindow,
+			)
+		}
+		flagSet = true
+	}
+
+	if ctx.IsSet("clearschedule") {
+		params.ScheduleWindows = nil
+		flagSet = true
+	}
+
+	if ctx.IsSet("feerateceiling") {
+		params.FeeRateCeilingSatPerVbyte = ctx.Uint64("feerateceiling")
+		flagSet = true
+	}
+
 	if !flagSet {
 		return fmt.Errorf("at least one flag required to set params")
 	}
@@ -637,25 +1045,155 @@ func ppmFromPercentage(percentage float64) (uint64, error) {
 	return uint64(percentage / 100 * liquidity.FeeBase), nil
 }
 
+// scheduleDays maps the abbreviated day names accepted on the command line to
+// their day of the week.
+var scheduleDays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// parseScheduleWindow parses a schedule window expressed as days:hours, where
+// days is either * for every day, a range (mon-fri) or a comma separated list
+// (sat,sun) and hours is a range of UTC hours (start-end).
+func parseScheduleWindow(window string) (*looprpc.ScheduleWindow, error) {
+	parts := strings.Split(window, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("schedule window: %v must be in the "+
+			"format days:hours", window)
+	}
+
+	days, err := parseScheduleDays(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	hours := strings.Split(parts[1], "-")
+	if len(hours) != 2 {
+		return nil, fmt.Errorf("schedule hours: %v must be in the "+
+			"format start-end", parts[1])
+	}
+
+	start, err := strconv.ParseUint(hours[0], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start hour: %w", err)
+	}
+
+	end, err := strconv.ParseUint(hours[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end hour: %w", err)
+	}
+
+	return &looprpc.ScheduleWindow{
+		Days:      days,
+		StartHour: uint32(start),
+		EndHour:   uint32(end),
+	}, nil
+}
+
+// parseScheduleDays parses the days of a schedule window. An empty set of days
+// is returned for *, which applies the window to every day.
+func parseScheduleDays(days string) ([]uint32, error) {
+	if days == "*" {
+		return nil, nil
+	}
+
+	parseDay := func(day string) (time.Weekday, error) {
+		weekday, ok := scheduleDays[strings.ToLower(day)]
+		if !ok {
+			return 0, fmt.Errorf("unknown day: %v, expected one "+
+				"of sun, mon, tue, wed, thu, fri, sat", day)
+		}
+
+		return weekday, nil
+	}
+
+	dayRange := strings.Split(days, "-")
+	if len(dayRange) == 2 {
+		start, err := parseDay(dayRange[0])
+		if err != nil {
+			return nil, err
+		}
+
+		end, err := parseDay(dayRange[1])
+		if err != nil {
+			return nil, err
+		}
+
+		// Allow ranges that wrap around the end of the week, such as
+		// fri-mon.
+		var result []uint32
+		for day := start; ; day = (day + 1) % 7 {
+			result = append(result, uint32(day))
+
+			if day == end {
+				break
+			}
+		}
+
+		return result, nil
+	}
+
+	var result []uint32
+	for _, day := range strings.Split(days, ",") {
+		weekday, err := parseDay(day)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, uint32(weekday))
+	}
+
+	return result, nil
+}
+
 var suggestSwapCommand = cli.Command{
 	Name:  "suggestswaps",
 	Usage: "show a list of suggested swaps",
 	Description: "Displays a list of suggested swaps that aim to obtain " +
 		"the liquidity balance as specified by the rules set in " +
-		"the liquidity manager.",
+		"the liquidity manager. A set of parameters can be " +
+		"provided to preview the swaps that would be suggested " +
+		"if they were set, without saving them.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "params",
+			Usage: "the path to a JSON file containing the " +
+				"liquidity parameters to suggest swaps for.",
+		},
+	},
 	Action: suggestSwap,
 }
 
 func suggestSwap(ctx *cli.Context) error {
+	req := &looprpc.SuggestSwapsRequest{}
+	if ctx.IsSet("params") {
+		paramsBytes, err := os.ReadFile(ctx.String("params"))
+		if err != nil {
+			return err
+		}
+
+		req.Parameters = &looprpc.LiquidityParameters{}
+		err = lnrpc.ProtoJSONUnmarshalOpts.Unmarshal(
+			paramsBytes, req.Parameters,
+		)
+		if err != nil {
+			return fmt.Errorf("could not parse parameters: %w",
+				err)
+		}
+	}
+
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
 		return err
 	}
 	defer cleanup()
 
-	resp, err := client.SuggestSwaps(
-		context.Background(), &looprpc.SuggestSwapsRequest{},
-	)
+	resp, err := client.SuggestSwaps(context.Background(), req)
 	if err == nil {
 		printRespJSON(resp)
 		return nil
@@ -35,7 +35,30 @@ var loopOutCommand = cli.Command{
 	The amount is to be specified in satoshis.
 
 	Optionally a BASE58/bech32 encoded bitcoin destination address may be
-	specified. If not specified, a new wallet address will be generated.`,
+	specified. If not specified, a new wallet address will be generated.
+
+	If any of the --at, --height or --max_sweep_feerate flags are set, the
+	swap is scheduled rather than dispatched right away. The daemon holds
+	a scheduled swap back until all of its conditions hold, then re-quotes
+	it and only dispatches it if the new quote is within the limits that
+	are shown here. Scheduled swaps can be managed with "loop scheduled".
+
+	If --split is set, the swap is split into several loop outs when its
+	amount exceeds the server's maximum swap amount or --max_part_amt. Each
+	part is paid over its own set of channels, and the fee limits that are
+	shown here apply to all parts together. The parts are listed under
+	their group with "loop listswaps --split_group_id".
+
+	If --dest_descriptor is set, the funds are sent to a fresh address that
+	the daemon derives from the watch-only output descriptor or extended
+	public key, skipping addresses that past swaps paid to.
+
+	If --open_channel is set, the sweep of the swap funds a channel with the
+	given peer directly, saving the on-chain transaction that opening the
+	channel would take otherwise. The channel's capacity is the swap amount
+	minus the maximum miner fee; the rest of the sweep goes to the wallet.
+	If the channel can't be negotiated before the swap gets close to its
+	timeout, the whole sweep goes to the wallet instead.`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name: "addr",
@@ -58,6 +81,24 @@ var loopOutCommand = cli.Command{
 				"pay-to-taproot-pubkey(p2tr) is supported",
 			Value: "p2tr",
 		},
+		cli.StringFlag{
+			Name: "dest_descriptor",
+			Usage: "the watch-only output descriptor, such as " +
+				"wpkh(xpub.../0/*), or extended public key " +
+				"to derive a fresh address from that the " +
+				"looped out funds should be sent to",
+		},
+		cli.StringFlag{
+			Name: "open_channel",
+			Usage: "the public key of a connected node to open a " +
+				"channel with, which the sweep of the swap " +
+				"funds directly",
+		},
+		cli.BoolFlag{
+			Name: "private_channel",
+			Usage: "if set, the channel that --open_channel " +
+				"opens is not announced to the network",
+		},
 		cli.Uint64Flag{
 			Name: "amt",
 			Usage: "the amount in satoshis to loop out. To check " +
@@ -116,6 +157,41 @@ var loopOutCommand = cli.Command{
 				"assets daemon has multiple channels of the " +
 				"given asset id with different edge nodes",
 		},
+		cli.StringFlag{
+			Name: "at",
+			Usage: "schedule the swap to be dispatched once the " +
+				"RFC3339 time provided is reached, for " +
+				"example 2006-01-02T15:04:05Z",
+		},
+		cli.Uint64Flag{
+			Name: "height",
+			Usage: "schedule the swap to be dispatched once the " +
+				"block height provided is reached",
+		},
+		cli.Uint64Flag{
+			Name: "max_sweep_feerate",
+			Usage: "schedule the swap to be dispatched once the " +
+				"sweep fee rate estimate for the conf " +
+				"target is at or below the fee rate " +
+				"provided, in sat/vbyte",
+		},
+		cli.BoolFlag{
+			Name: "split",
+			Usage: "split the swap into several loop outs if its " +
+				"amount exceeds the server's maximum swap " +
+				"amount or max_part_amt",
+		},
+		cli.BoolFlag{
+			Name: "sequential",
+			Usage: "dispatch the parts of a split swap one after " +
+				"another, each once the swap of the previous " +
+				"part succeeded",
+		},
+		cli.Uint64Flag{
+			Name: "max_part_amt",
+			Usage: "the maximum amount of each part of a split " +
+				"swap in satoshis",
+		},
 		forceFlag,
 		labelFlag,
 		verboseFlag,
@@ -176,6 +252,14 @@ func loopOut(ctx *cli.Context) error {
 			"address to sweep the loop amount to")
 	}
 
+	destDescriptor := ctx.String("dest_descriptor")
+	if destDescriptor != "" && (ctx.IsSet("addr") ||
+		ctx.IsSet("account") || args.Present()) {
+
+		return fmt.Errorf("cannot set --dest_descriptor along with " +
+			"an address or --account")
+	}
+
 	var destAddr string
 	var account string
 	switch {
@@ -205,6 +289,51 @@ func loopOut(ctx *cli.Context) error {
 		}
 	}
 
+	condition, err := parseLoopOutCondition(ctx)
+	if err != nil {
+		return err
+	}
+
+	if condition != nil && ctx.IsSet("asset_id") {
+		return fmt.Errorf("asset loop outs can't be scheduled")
+	}
+
+	splitSwap := ctx.Bool("split")
+	if !splitSwap && (ctx.IsSet("sequential") ||
+		ctx.IsSet("max_part_amt")) {
+
+		return fmt.Errorf("--sequential and --max_part_amt require " +
+			"--split")
+	}
+
+	if splitSwap && (condition != nil || ctx.IsSet("asset_id")) {
+		return fmt.Errorf("scheduled and asset loop outs can't be " +
+			"split")
+	}
+
+	var channelOpen *looprpc.LoopOutChannelOpen
+	switch {
+	case ctx.IsSet("open_channel") && (destAddr != "" || account != "" ||
+		destDescriptor != "" || splitSwap || ctx.IsSet("asset_id")):
+
+		return fmt.Errorf("cannot set --open_channel along with a " +
+			"destination, --split or --asset_id")
+
+	case ctx.IsSet("open_channel"):
+		nodePubkey, err := hex.DecodeString(ctx.String("open_channel"))
+		if err != nil {
+			return fmt.Errorf("invalid --open_channel: %w", err)
+		}
+
+		channelOpen = &looprpc.LoopOutChannelOpen{
+			NodePubkey: nodePubkey,
+			Private:    ctx.Bool("private_channel"),
+		}
+
+	case ctx.IsSet("private_channel"):
+		return fmt.Errorf("--private_channel requires --open_channel")
+	}
+
 	var assetLoopOutInfo *looprpc.AssetLoopOutRequest
 
 	var assetId []byte
@@ -252,8 +381,20 @@ func loopOut(ctx *cli.Context) error {
 		return fmt.Errorf("at least 1 confirmation required for htlcs")
 	}
 
+	// Split swaps are quoted for a single part, since their amount may
+	// exceed the server's maximum.
+	quoteAmt, parts := amt, 1
+	if splitSwap {
+		quoteAmt, parts, err = splitPartAmount(
+			client, amt, btcutil.Amount(ctx.Uint64("max_part_amt")),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	quoteReq := &looprpc.QuoteRequest{
-		Amt:                     int64(amt),
+		Amt:                     int64(quoteAmt),
 		ConfTarget:              sweepConfTarget,
 		SwapPublicationDeadline: uint64(swapDeadline.Unix()),
 		AssetInfo:               assetLoopOutInfo,
@@ -273,7 +414,21 @@ func loopOut(ctx *cli.Context) error {
 			defaultSwapWaitTime)
 	}
 
-	limits := getOutLimits(amt, quote)
+	if condition != nil {
+		warning += " The swap is scheduled and will be re-quoted " +
+			"and checked against these limits once its " +
+			"conditions hold."
+	}
+
+	if splitSwap {
+		warning += fmt.Sprintf(" The swap is split into %v parts of "+
+			"up to %v. The quote is for a single part, while the "+
+			"limits apply to all parts together.", parts, quoteAmt)
+	}
+
+	limits := getOutLimits(quoteAmt, quote)
+	limits.scale(parts)
+
 	// If configured, use the specified maximum swap routing fee.
 	if ctx.IsSet("max_swap_routing_fee") {
 		limits.maxSwapRoutingFee = btcutil.Amount(
@@ -310,12 +465,13 @@ func loopOut(ctx *cli.Context) error {
 		}
 	}
 
-	resp, err := client.LoopOut(context.Background(), &looprpc.LoopOutRequest{
+	req := &looprpc.LoopOutRequest{
 		Amt:                     int64(amt),
 		Dest:                    destAddr,
 		IsExternalAddr:          destAddr != "",
 		Account:                 account,
 		AccountAddrType:         accountAddrType,
+		DestDescriptor:          destDescriptor,
 		MaxMinerFee:             int64(limits.maxMinerFee),
 		MaxPrepayAmt:            int64(limits.maxPrepayAmt),
 		MaxSwapFee:              int64(limits.maxSwapFee),
@@ -330,12 +486,47 @@ func loopOut(ctx *cli.Context) error {
 		PaymentTimeout:          uint32(paymentTimeout),
 		AssetInfo:               assetLoopOutInfo,
 		AssetRfqInfo:            quote.AssetRfqInfo,
-	})
+		ChannelOpen:             channelOpen,
+	}
+
+	if splitSwap {
+		req.Split = &looprpc.LoopOutSplit{
+			Sequential: ctx.Bool("sequential"),
+			MaxPartAmt: int64(ctx.Uint64("max_part_amt")),
+		}
+	}
+
+	if condition != nil {
+		scheduled, err := client.ScheduleLoopOut(
+			context.Background(), &looprpc.ScheduleLoopOutRequest{
+				Request:   req,
+				Condition: condition,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Swap scheduled\n")
+		fmt.Printf("ID:             %v\n", scheduled.Id)
+		fmt.Println()
+		fmt.Printf("Run `loop scheduled list` to view scheduled " +
+			"swaps.\n")
+
+		return nil
+	}
+
+	resp, err := client.LoopOut(context.Background(), req)
 	if err != nil {
 		return err
 	}
 
 	fmt.Printf("Swap initiated\n")
+	if resp.SplitGroupId != 0 {
+		fmt.Printf("Split group:    %v (%v parts)\n", resp.SplitGroupId,
+			resp.SplitParts)
+		fmt.Printf("First part:\n")
+	}
 	fmt.Printf("ID:             %x\n", resp.IdBytes)
 	fmt.Printf("HTLC address:   %v\n", resp.HtlcAddress) // nolint:staticcheck
 	if resp.ServerMessage != "" {
@@ -346,3 +537,68 @@ func loopOut(ctx *cli.Context) error {
 
 	return nil
 }
+
+// parseLoopOutCondition returns the condition that a loop out is scheduled
+// with, or nil if none of the condition flags are set.
+func parseLoopOutCondition(ctx *cli.Context) (*looprpc.LoopOutCondition,
+	error) {
+
+	if !ctx.IsSet("at") && !ctx.IsSet("height") &&
+		!ctx.IsSet("max_sweep_feerate") {
+
+		return nil, nil
+	}
+
+	condition := &looprpc.LoopOutCondition{
+		MaxSweepFeeRateSatPerVbyte: ctx.Uint64("max_sweep_feerate"),
+	}
+
+	if ctx.IsSet("at") {
+		at, err := time.Parse(time.RFC3339, ctx.String("at"))
+		if err != nil {
+			return nil, fmt.Errorf("could not parse time: %w", err)
+		}
+
+		condition.DispatchTime = at.Unix()
+	}
+
+	if ctx.IsSet("height") {
+		height := ctx.Uint64("height")
+		if height > math.MaxInt32 {
+			return nil, fmt.Errorf("height is too large")
+		}
+
+		condition.DispatchHeight = int32(height)
+	}
+
+	return condition, nil
+}
+
+// splitPartAmount returns the amount of the largest part of a split loop out
+// and the number of parts that the daemon splits it into. Like the daemon, we
+// split the amount into the smallest number of equal parts that don't exceed
+// the server's maximum swap amount or the maximum part amount, if set.
+func splitPartAmount(client looprpc.SwapClientClient, amt,
+	maxPartAmt btcutil.Amount) (btcutil.Amount, int, error) {
+
+	terms, err := client.LoopOutTerms(
+		context.Background(), &looprpc.TermsRequest{},
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	maxAmt := btcutil.Amount(terms.MaxSwapAmount)
+	if maxPartAmt > 0 {
+		maxAmt = min(maxAmt, maxPartAmt)
+	}
+
+	if maxAmt <= 0 {
+		return 0, 0, fmt.Errorf("invalid maximum part amount")
+	}
+
+	parts := max((amt+maxAmt-1)/maxAmt, 1)
+	partAmt := (amt + parts - 1) / parts
+
+	return partAmt, int(parts), nil
+}
@@ -87,7 +87,8 @@ var (
 		listSwapsCommand, swapInfoCommand, getLiquidityParamsCommand,
 		setLiquidityRuleCommand, suggestSwapCommand, setParamsCommand,
 		getInfoCommand, abandonSwapCommand, reservationsCommands,
-		instantOutCommand, listInstantOutsCommand,
+		instantOutCommand, listInstantOutsCommand, liquidityCommands,
+		scheduledCommands, scheduleCommands,
 	}
 )
 
@@ -297,6 +298,16 @@ func getOutLimits(amt btcutil.Amount,
 	}
 }
 
+// scale multiplies the limits by the number of parts of a split loop out, so
+// that they apply to all parts together.
+func (l *outLimits) scale(parts int) {
+	l.maxSwapRoutingFee *= btcutil.Amount(parts)
+	l.maxPrepayRoutingFee *= btcutil.Amount(parts)
+	l.maxMinerFee *= btcutil.Amount(parts)
+	l.maxSwapFee *= btcutil.Amount(parts)
+	l.maxPrepayAmt *= btcutil.Amount(parts)
+}
+
 func displayInDetails(req *looprpc.QuoteRequest,
 	resp *looprpc.InQuoteResponse, verbose bool) error {
 
@@ -402,6 +413,14 @@ func logSwap(swap *looprpc.SwapStatus) {
 		)
 	}
 
+	if swap.SplitGroupId != 0 {
+		fmt.Printf(" (split group %v)", swap.SplitGroupId)
+	}
+
+	if swap.ChannelOpenState != looprpc.ChannelOpenState_CHANNEL_OPEN_NONE {
+		fmt.Printf(" (channel open %v)", swap.ChannelOpenState)
+	}
+
 	fmt.Println()
 }
 
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var profilesCommand = cli.Command{
+	Name:  "profiles",
+	Usage: "manage named liquidity profiles",
+	Description: `
+	Liquidity profiles are named, versioned sets of liquidity parameters
+	that can be activated on the liquidity manager, for example to switch
+	between "weekday" and "weekend" configurations. Every change to a
+	profile is kept as a new version, so that changes can be compared and
+	rolled back.
+	`,
+	Subcommands: []cli.Command{
+		listProfilesCommand,
+		saveProfileCommand,
+		activateProfileCommand,
+		rollbackProfileCommand,
+		diffProfilesCommand,
+	},
+}
+
+var listProfilesCommand = cli.Command{
+	Name:  "list",
+	Usage: "list liquidity profiles and their history",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "max_activations",
+			Usage: "the maximum number of activations to list.",
+			Value: 100,
+		},
+	},
+	Action: listProfiles,
+}
+
+func listProfiles(ctx *cli.Context) error {
+	if ctx.NArg() > 0 {
+		return cli.ShowCommandHelp(ctx, "list")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListLiquidityProfiles(
+		context.Background(), &looprpc.ListLiquidityProfilesRequest{
+			MaxActivations: uint32(ctx.Uint64("max_activations")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var saveProfileCommand = cli.Command{
+	Name:      "save",
+	Usage:     "save the current liquidity parameters as a profile",
+	ArgsUsage: "name",
+	Description: `
+	Saves the liquidity manager's current parameters as a new version of
+	the named profile, creating the profile if it does not exist. If the
+	profile is currently active, the new version is activated.
+	`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: "activation_schedule",
+			Usage: "a window in which the profile is " +
+				"automatically activated, expressed as " +
+				"days:hours in UTC, for example " +
+				"\"sat,sun:0-24\". May be repeated to set " +
+				"multiple windows.",
+		},
+	},
+	Action: saveProfile,
+}
+
+func saveProfile(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "save")
+	}
+
+	req := &looprpc.SaveLiquidityProfileRequest{
+		Name: ctx.Args().First(),
+	}
+
+	for _, window := range ctx.StringSlice("activation_schedule") {
+		rpcWindow, err := parseScheduleWindow(window)
+		if err != nil {
+			return err
+		}
+
+		req.ActivationSchedule = append(
+			req.ActivationSchedule, rpcWindow,
+		)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.SaveLiquidityProfile(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Saved profile %v version %v\n", req.Name, resp.Version)
+
+	return nil
+}
+
+var activateProfileCommand = cli.Command{
+	Name:      "activate",
+	Usage:     "set the liquidity parameters to a profile",
+	ArgsUsage: "name",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "version",
+			Usage: "the version of the profile to activate, if " +
+				"not set the latest version is activated.",
+		},
+	},
+	Action: activateProfile,
+}
+
+func activateProfile(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "activate")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = client.ActivateLiquidityProfile(
+		context.Background(), &looprpc.ActivateLiquidityProfileRequest{
+			Name:    ctx.Args().First(),
+			Version: uint32(ctx.Uint64("version")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Activated profile %v\n", ctx.Args().First())
+
+	return nil
+}
+
+var rollbackProfileCommand = cli.Command{
+	Name:      "rollback",
+	Usage:     "roll a profile back to an earlier version",
+	ArgsUsage: "name",
+	Description: `
+	Rolls the named profile back to an earlier version by saving a copy of
+	that version as the profile's latest version. If the profile is
+	currently active, the rolled back version is activated.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "version",
+			Usage: "the version to roll back to, if not set the " +
+				"profile is rolled back to the version before " +
+				"its latest version.",
+		},
+	},
+	Action: rollbackProfile,
+}
+
+func rollbackProfile(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "rollback")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.RollbackLiquidityProfile(
+		context.Background(), &looprpc.RollbackLiquidityProfileRequest{
+			Name:    ctx.Args().First(),
+			Version: uint32(ctx.Uint64("version")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Rolled back profile %v, new version %v\n",
+		ctx.Args().First(), resp.Version)
+
+	return nil
+}
+
+var diffProfilesCommand = cli.Command{
+	Name:      "diff",
+	Usage:     "compare two versions of liquidity profiles",
+	ArgsUsage: "from_name [to_name]",
+	Description: `
+	Lists the parameters that differ between two profile versions. If only
+	one profile is named, the latest version of the profile is compared to
+	the version before it by default.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "from_version",
+			Usage: "the version of the profile to compare from.",
+		},
+		cli.Uint64Flag{
+			Name:  "to_version",
+			Usage: "the version of the profile to compare to.",
+		},
+	},
+	Action: diffProfiles,
+}
+
+func diffProfiles(ctx *cli.Context) error {
+	if ctx.NArg() < 1 || ctx.NArg() > 2 {
+		return cli.ShowCommandHelp(ctx, "diff")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.DiffLiquidityProfiles(
+		context.Background(), &looprpc.DiffLiquidityProfilesRequest{
+			FromName:    ctx.Args().Get(0),
+			FromVersion: uint32(ctx.Uint64("from_version")),
+			ToName:      ctx.Args().Get(1),
+			ToVersion:   uint32(ctx.Uint64("to_version")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/urfave/cli"
+)
+
+var queueCommand = cli.Command{
+	Name:  "queue",
+	Usage: "manage the autoloop approval queue",
+	Description: `
+	When autoloop is enabled with the requireapproval parameter set, the
+	swaps that it suggests are added to an approval queue rather than
+	being dispatched. Queued swaps expire if they are not approved within
+	the approvalexpiry set in the liquidity parameters. Approved swaps are
+	re-quoted and checked against the current autoloop budget before they
+	are dispatched.
+	`,
+	Subcommands: []cli.Command{
+		listQueueCommand,
+		approveQueuedSwapCommand,
+		rejectQueuedSwapCommand,
+	},
+}
+
+var listQueueCommand = cli.Command{
+	Name:  "list",
+	Usage: "list the swaps in the approval queue",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "all",
+			Usage: "include swaps that were approved, " +
+				"rejected or expired.",
+		},
+	},
+	Action: listQueue,
+}
+
+func listQueue(ctx *cli.Context) error {
+	if ctx.NArg() > 0 {
+		return cli.ShowCommandHelp(ctx, "list")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListQueuedSwaps(
+		context.Background(), &looprpc.ListQueuedSwapsRequest{
+			IncludeResolved: ctx.Bool("all"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var approveQueuedSwapCommand = cli.Command{
+	Name:      "approve",
+	Usage:     "approve and dispatch a queued swap",
+	ArgsUsage: "id",
+	Description: `
+	Re-quotes the queued swap and dispatches it if it may still be
+	performed under the current liquidity parameters and autoloop budget.
+	`,
+	Action: approveQueuedSwap,
+}
+
+func approveQueuedSwap(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "approve")
+	}
+
+	id, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse queued swap id: %w", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ApproveQueuedSwap(
+		context.Background(), &looprpc.ApproveQueuedSwapRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	hash, err := lntypes.MakeHash(resp.SwapHash)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Dispatched swap %v for queued swap %v, worst-case "+
+		"fees: %v sat\n", hash, id, resp.FeesSat)
+
+	return nil
+}
+
+var rejectQueuedSwapCommand = cli.Command{
+	Name:      "reject",
+	Usage:     "reject a queued swap",
+	ArgsUsage: "id",
+	Action:    rejectQueuedSwap,
+}
+
+func rejectQueuedSwap(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "reject")
+	}
+
+	id, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse queued swap id: %w", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = client.RejectQueuedSwap(
+		context.Background(), &looprpc.RejectQueuedSwapRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Rejected queued swap %v\n", id)
+
+	return nil
+}
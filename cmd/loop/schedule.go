@@ -0,0 +1,481 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var scheduleCommands = cli.Command{
+	Name:  "schedule",
+	Usage: "manage recurring swap schedules",
+	Description: `
+	Swap schedules dispatch a loop out or loop in of a fixed amount on a
+	recurring schedule, either at a fixed interval or whenever a five
+	field cron expression (evaluated in UTC) matches. Each run is
+	re-quoted and only dispatched if the quote is within the fee limits
+	of the schedule. A schedule is paused after too many consecutive runs
+	failed.
+	`,
+	Subcommands: []cli.Command{
+		addScheduleCommand,
+		listSchedulesCommand,
+		listScheduleRunsCommand,
+		pauseScheduleCommand,
+		resumeScheduleCommand,
+		deleteScheduleCommand,
+	},
+}
+
+var addScheduleCommand = cli.Command{
+	Name:      "add",
+	Usage:     "add a recurring swap schedule",
+	ArgsUsage: "name amt",
+	Description: `
+	Adds a schedule that dispatches a swap of amt satoshis on every run.
+	Exactly one of --interval and --cron must be set. Fee limits that are
+	not set are derived from a current quote for the swap.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "type",
+			Usage: "the type of swap to dispatch, either out or in",
+			Value: "out",
+		},
+		cli.StringFlag{
+			Name: "addr",
+			Usage: "the address to sweep loop outs to. If neither " +
+				"addr nor account are set, loop outs are " +
+				"swept to the wallet of the backing lnd",
+		},
+		cli.StringFlag{
+			Name: "account",
+			Usage: "the name of the account to generate a new " +
+				"address from for every loop out. You can list " +
+				"the names of valid accounts in your backing " +
+				"lnd instance with \"lncli wallet accounts list\"",
+		},
+		cli.StringFlag{
+			Name: "account_addr_type",
+			Usage: "the address type of the extended public key " +
+				"specified in account. Currently only " +
+				"pay-to-taproot-pubkey(p2tr) is supported",
+			Value: "p2tr",
+		},
+		cli.DurationFlag{
+			Name: "interval",
+			Usage: "the interval between runs, for example 168h " +
+				"for a weekly swap",
+		},
+		cli.StringFlag{
+			Name: "cron",
+			Usage: "a five field cron expression in UTC, for " +
+				"example \"0 0 * * 0\" for every Sunday at " +
+				"midnight",
+		},
+		cli.StringFlag{
+			Name: "start",
+			Usage: "the time from which the schedule runs, in " +
+				"RFC3339 format. Interval schedules run at " +
+				"the start time plus a multiple of their " +
+				"interval. If not set, the schedule starts now",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "the sweep confirmation target of loop outs " +
+				"or the htlc confirmation target of loop ins",
+		},
+		cli.Uint64Flag{
+			Name: "max_failures",
+			Usage: "the number of consecutive failed runs after " +
+				"which the schedule is paused",
+		},
+		cli.Int64Flag{
+			Name:  "max_swap_fee",
+			Usage: "the maximum swap fee in satoshis",
+		},
+		cli.Int64Flag{
+			Name:  "max_miner_fee",
+			Usage: "the maximum on-chain fee in satoshis",
+		},
+		cli.Int64Flag{
+			Name:  "max_prepay_amt",
+			Usage: "the maximum prepay amount of loop outs in satoshis",
+		},
+		cli.Int64Flag{
+			Name: "max_swap_routing_fee",
+			Usage: "the maximum routing fee of the swap payment " +
+				"of loop outs in satoshis",
+		},
+		cli.Int64Flag{
+			Name: "max_prepay_routing_fee",
+			Usage: "the maximum routing fee of the prepay payment " +
+				"of loop outs in satoshis",
+		},
+		labelFlag,
+		forceFlag,
+	},
+	Action: addSchedule,
+}
+
+func addSchedule(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return cli.ShowCommandHelp(ctx, "add")
+	}
+
+	args := ctx.Args()
+	amt, err := parseAmt(args.Get(1))
+	if err != nil {
+		return err
+	}
+
+	label := ctx.String(labelFlag.Name)
+	if err := labels.Validate(label); err != nil {
+		return err
+	}
+
+	schedule := &looprpc.SwapSchedule{
+		Name:        args.First(),
+		Amt:         int64(amt),
+		Dest:        ctx.String("addr"),
+		Account:     ctx.String("account"),
+		IntervalSec: uint64(ctx.Duration("interval").Seconds()),
+		Cron:        ctx.String("cron"),
+		ConfTarget:  int32(ctx.Int64("conf_target")),
+		Label:       label,
+		MaxFailures: uint32(ctx.Uint64("max_failures")),
+	}
+
+	if ctx.IsSet("interval") == ctx.IsSet("cron") {
+		return errors.New("exactly one of --interval and --cron " +
+			"must be set")
+	}
+
+	if ctx.IsSet("start") {
+		start, err := time.Parse(time.RFC3339, ctx.String("start"))
+		if err != nil {
+			return fmt.Errorf("could not parse start time: %w", err)
+		}
+
+		schedule.StartTime = start.Unix()
+	}
+
+	if schedule.Account != "" {
+		switch ctx.String("account_addr_type") {
+		case "p2tr":
+			schedule.AccountAddrType =
+				looprpc.AddressType_TAPROOT_PUBKEY
+
+		default:
+			return fmt.Errorf("unknown account address type")
+		}
+	}
+
+	switch ctx.String("type") {
+	case "out":
+		schedule.Type = looprpc.SwapType_LOOP_OUT
+
+	case "in":
+		schedule.Type = looprpc.SwapType_LOOP_IN
+
+	default:
+		return fmt.Errorf("unknown swap type %v, expected out or in",
+			ctx.String("type"))
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if err := setScheduleLimits(ctx, client, schedule); err != nil {
+		return err
+	}
+
+	if !ctx.Bool("force") {
+		if err := displayScheduleLimits(schedule); err != nil {
+			return err
+		}
+	}
+
+	resp, err := client.AddSwapSchedule(
+		context.Background(), &looprpc.AddSwapScheduleRequest{
+			Schedule: schedule,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// setScheduleLimits sets the fee limits of a schedule to the values of their
+// flags. Limits that are not set are derived from a current quote for the
+// schedule's swap.
+func setScheduleLimits(ctx *cli.Context, client looprpc.SwapClientClient,
+	schedule *looprpc.SwapSchedule) error {
+
+	quoteReq := &looprpc.QuoteRequest{
+		Amt:        schedule.Amt,
+		ConfTarget: schedule.ConfTarget,
+	}
+
+	limitOrDefault := func(flag string, value btcutil.Amount) int64 {
+		if ctx.IsSet(flag) {
+			return ctx.Int64(flag)
+		}
+
+		return int64(value)
+	}
+
+	if schedule.Type == looprpc.SwapType_LOOP_IN {
+		quote, err := client.GetLoopInQuote(
+			context.Background(), quoteReq,
+		)
+		if err != nil {
+			return err
+		}
+
+		limits := getInLimits(quote)
+		schedule.MaxSwapFee = limitOrDefault(
+			"max_swap_fee", limits.maxSwapFee,
+		)
+		schedule.MaxMinerFee = limitOrDefault(
+			"max_miner_fee", limits.maxMinerFee,
+		)
+
+		return nil
+	}
+
+	quote, err := client.LoopOutQuote(context.Background(), quoteReq)
+	if err != nil {
+		return err
+	}
+
+	limits := getOutLimits(btcutil.Amount(schedule.Amt), quote)
+	schedule.MaxSwapFee = limitOrDefault("max_swap_fee", limits.maxSwapFee)
+	schedule.MaxMinerFee = limitOrDefault(
+		"max_miner_fee", limits.maxMinerFee,
+	)
+	schedule.MaxPrepayAmt = limitOrDefault(
+		"max_prepay_amt", limits.maxPrepayAmt,
+	)
+	schedule.MaxSwapRoutingFee = limitOrDefault(
+		"max_swap_routing_fee", limits.maxSwapRoutingFee,
+	)
+	schedule.MaxPrepayRoutingFee = limitOrDefault(
+		"max_prepay_routing_fee", limits.maxPrepayRoutingFee,
+	)
+
+	return nil
+}
+
+// displayScheduleLimits displays the fee limits that every run of a schedule
+// is held to and asks the user to confirm them.
+func displayScheduleLimits(schedule *looprpc.SwapSchedule) error {
+	fmt.Printf(satAmtFmt, "Amount per swap:", schedule.Amt)
+	fmt.Printf(satAmtFmt, "Max swap fee:", schedule.MaxSwapFee)
+	fmt.Printf(satAmtFmt, "Max on-chain fee:", schedule.MaxMinerFee)
+
+	if schedule.Type == looprpc.SwapType_LOOP_OUT {
+		fmt.Printf(satAmtFmt, "Max prepay amount:",
+			schedule.MaxPrepayAmt)
+		fmt.Printf(satAmtFmt, "Max off-chain swap routing fee:",
+			schedule.MaxSwapRoutingFee)
+		fmt.Printf(satAmtFmt, "Max off-chain prepay routing fee:",
+			schedule.MaxPrepayRoutingFee)
+	}
+
+	fmt.Printf("\nRuns whose quote exceeds these limits are skipped.\n")
+	fmt.Printf("ADD SCHEDULE? (y/n): ")
+
+	var answer string
+	fmt.Scanln(&answer)
+	if answer == "y" {
+		return nil
+	}
+
+	return errors.New("schedule canceled")
+}
+
+var listSchedulesCommand = cli.Command{
+	Name:   "list",
+	Usage:  "list recurring swap schedules",
+	Action: listSchedules,
+}
+
+func listSchedules(ctx *cli.Context) error {
+	if ctx.NArg() > 0 {
+		return cli.ShowCommandHelp(ctx, "list")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListSwapSchedules(
+		context.Background(), &looprpc.ListSwapSchedulesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var listScheduleRunsCommand = cli.Command{
+	Name:      "runs",
+	Usage:     "list the most recent runs of a swap schedule",
+	ArgsUsage: "name",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "limit",
+			Usage: "the maximum number of runs to list",
+			Value: 50,
+		},
+	},
+	Action: listScheduleRuns,
+}
+
+func listScheduleRuns(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "runs")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListSwapScheduleRuns(
+		context.Background(), &looprpc.ListSwapScheduleRunsRequest{
+			Name:  ctx.Args().First(),
+			Limit: uint32(ctx.Uint64("limit")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var pauseScheduleCommand = cli.Command{
+	Name:      "pause",
+	Usage:     "pause a swap schedule",
+	ArgsUsage: "name",
+	Action:    pauseSchedule,
+}
+
+func pauseSchedule(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "pause")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.PauseSwapSchedule(
+		context.Background(), &looprpc.PauseSwapScheduleRequest{
+			Name: ctx.Args().First(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var resumeScheduleCommand = cli.Command{
+	Name:  "resume",
+	Usage: "resume a paused swap schedule",
+	Description: `
+	Resumes a schedule that was paused by the user or after too many
+	consecutive failed runs. The schedule's failure count is reset and it
+	runs next at its next regular time.
+	`,
+	ArgsUsage: "name",
+	Action:    resumeSchedule,
+}
+
+func resumeSchedule(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "resume")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ResumeSwapSchedule(
+		context.Background(), &looprpc.ResumeSwapScheduleRequest{
+			Name: ctx.Args().First(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var deleteScheduleCommand = cli.Command{
+	Name:      "delete",
+	Usage:     "delete a swap schedule along with its runs",
+	ArgsUsage: "name",
+	Action:    deleteSchedule,
+}
+
+func deleteSchedule(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "delete")
+	}
+
+	name := ctx.Args().First()
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = client.DeleteSwapSchedule(
+		context.Background(), &looprpc.DeleteSwapScheduleRequest{
+			Name: name,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Deleted swap schedule %v\n", name)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var scheduledCommands = cli.Command{
+	Name:  "scheduled",
+	Usage: "manage scheduled loop outs",
+	Description: `
+	Loop outs that are started with any of the --at, --height or
+	--max_sweep_feerate flags are held back by the daemon until all of
+	their conditions hold. They are then re-quoted and dispatched if the
+	new quote is within the limits of the swap. Scheduled loop outs are
+	persisted, so they survive restarts of the daemon.
+	`,
+	Subcommands: []cli.Command{
+		listScheduledCommand,
+		cancelScheduledCommand,
+	},
+}
+
+var listScheduledCommand = cli.Command{
+	Name:  "list",
+	Usage: "list scheduled loop outs",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "all",
+			Usage: "include loop outs that were dispatched, " +
+				"failed or were canceled.",
+		},
+	},
+	Action: listScheduled,
+}
+
+func listScheduled(ctx *cli.Context) error {
+	if ctx.NArg() > 0 {
+		return cli.ShowCommandHelp(ctx, "list")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListScheduledLoopOuts(
+		context.Background(), &looprpc.ListScheduledLoopOutsRequest{
+			IncludeResolved: ctx.Bool("all"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var cancelScheduledCommand = cli.Command{
+	Name:      "cancel",
+	Usage:     "cancel a scheduled loop out",
+	ArgsUsage: "id",
+	Action:    cancelScheduled,
+}
+
+func cancelScheduled(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "cancel")
+	}
+
+	id, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse scheduled loop out id: %w",
+			err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = client.CancelScheduledLoopOut(
+		context.Background(), &looprpc.CancelScheduledLoopOutRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Canceled scheduled loop out %v\n", id)
+
+	return nil
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+// csvSnapshotHeader is the header that we expect csv snapshot files to have.
+var csvSnapshotHeader = []string{
+	"timestamp", "channel_id", "pubkey", "capacity_sat",
+	"local_balance_sat", "remote_balance_sat",
+	"sweep_fee_rate_sat_per_vbyte",
+}
+
+var liquidityCommands = cli.Command{
+	Name:  "liquidity",
+	Usage: "inspect the behavior of the liquidity manager",
+	Subcommands: []cli.Command{
+		simulateCommand,
+		listDecisionsCommand,
+		profilesCommand,
+		queueCommand,
+		exportParamsCommand,
+		importParamsCommand,
+	},
+}
+
+var simulateCommand = cli.Command{
+	Name:  "simulate",
+	Usage: "simulate autoloop over a history of channel balances",
+	Description: `
+	Replays the liquidity manager's suggestion logic over a time series of
+	channel balances and fee rates, and reports the swaps that would have
+	been dispatched, the fees they would have paid and the autoloop budget
+	used over time.
+
+	If no snapshot file is provided, the balances that loopd has recorded
+	since it was started are used. Snapshot files may be JSON, in the
+	format {"snapshots": [{"timestamp": ..., "sweep_fee_rate_sat_per_vbyte":
+	..., "channels": [{"channel_id": ..., "pubkey": ..., "capacity_sat":
+	..., "local_balance_sat": ..., "remote_balance_sat": ...}]}]}, or CSV
+	with one row per channel per snapshot and the header:
+
+	timestamp,channel_id,pubkey,capacity_sat,local_balance_sat,
+	remote_balance_sat,sweep_fee_rate_sat_per_vbyte
+
+	Parameters to simulate may be provided as a JSON file in the format
+	output by getparams. If no parameters are provided, loopd's current
+	parameters are used.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "snapshots",
+			Usage: "the path to a JSON or CSV file containing " +
+				"balance snapshots to replay.",
+		},
+		cli.StringFlag{
+			Name: "params",
+			Usage: "the path to a JSON file containing the " +
+				"liquidity parameters to simulate.",
+		},
+		cli.Uint64Flag{
+			Name: "swapfeeppm",
+			Usage: "the swap fee, in parts per million of the " +
+				"swap amount, quoted for simulated swaps.",
+			Value: 1000,
+		},
+		cli.Uint64Flag{
+			Name: "minerfee",
+			Usage: "the on-chain fee in satoshis quoted for " +
+				"simulated swaps.",
+			Value: 5000,
+		},
+		cli.Uint64Flag{
+			Name: "prepay",
+			Usage: "the no-show prepay in satoshis quoted for " +
+				"simulated loop outs.",
+			Value: 30000,
+		},
+		cli.Uint64Flag{
+			Name: "min_amt",
+			Usage: "the minimum swap amount in satoshis, if not " +
+				"set the server's current limits are used.",
+		},
+		cli.Uint64Flag{
+			Name: "max_amt",
+			Usage: "the maximum swap amount in satoshis, if not " +
+				"set the server's current limits are used.",
+		},
+	},
+	Action: simulate,
+}
+
+func simulate(ctx *cli.Context) error {
+	if ctx.NArg() > 0 {
+		return cli.ShowCommandHelp(ctx, "simulate")
+	}
+
+	if ctx.IsSet("min_amt") != ctx.IsSet("max_amt") {
+		return fmt.Errorf("min_amt and max_amt must be set together")
+	}
+
+	req := &looprpc.SimulateAutoloopRequest{
+		SwapFeePpm:       ctx.Uint64("swapfeeppm"),
+		MinerFeeSat:      ctx.Uint64("minerfee"),
+		PrepayAmtSat:     ctx.Uint64("prepay"),
+		MinSwapAmountSat: ctx.Uint64("min_amt"),
+		MaxSwapAmountSat: ctx.Uint64("max_amt"),
+	}
+
+	if ctx.IsSet("params") {
+		paramsBytes, err := os.ReadFile(ctx.String("params"))
+		if err != nil {
+			return err
+		}
+
+		req.Parameters = &looprpc.LiquidityParameters{}
+		err = lnrpc.ProtoJSONUnmarshalOpts.Unmarshal(
+			paramsBytes, req.Parameters,
+		)
+		if err != nil {
+			return fmt.Errorf("could not parse parameters: %w",
+				err)
+		}
+	}
+
+	if ctx.IsSet("snapshots") {
+		snapshots, err := readSnapshots(ctx.String("snapshots"))
+		if err != nil {
+			return err
+		}
+
+		req.Snapshots = snapshots
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.SimulateAutoloop(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// readSnapshots reads a set of balance snapshots from a JSON or CSV file,
+// based on the file's extension.
+func readSnapshots(path string) ([]*looprpc.BalanceSnapshot, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return parseCSVSnapshots(file)
+	}
+
+	snapshotBytes, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// We parse our JSON snapshots into a simulation request, so that the
+	// file format matches our rpc snapshot format.
+	var req looprpc.SimulateAutoloopRequest
+	err = lnrpc.ProtoJSONUnmarshalOpts.Unmarshal(snapshotBytes, &req)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse snapshots: %w", err)
+	}
+
+	return req.Snapshots, nil
+}
+
+// parseCSVSnapshots parses balance snapshots from CSV, with one row per
+// channel per snapshot. Consecutive rows with the same timestamp are grouped
+// into a single snapshot.
+func parseCSVSnapshots(r io.Reader) ([]*looprpc.BalanceSnapshot, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(csvSnapshotHeader)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read csv header: %w", err)
+	}
+
+	for i, field := range csvSnapshotHeader {
+		if header[i] != field {
+			return nil, fmt.Errorf("expected csv header: %v",
+				strings.Join(csvSnapshotHeader, ","))
+		}
+	}
+
+	var snapshots []*looprpc.BalanceSnapshot
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return snapshots, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		values := make([]uint64, len(record))
+		for i, value := range record {
+			// Our pubkey is the only field that is not numeric.
+			if i == 2 {
+				continue
+			}
+
+			values[i], err = strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %v: %w",
+					csvSnapshotHeader[i], err)
+			}
+		}
+
+		pubkey, err := hex.DecodeString(record[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid pubkey: %w", err)
+		}
+
+		timestamp := int64(values[0])
+
+		var snapshot *looprpc.BalanceSnapshot
+		if len(snapshots) > 0 &&
+			snapshots[len(snapshots)-1].Timestamp == timestamp {
+
+			snapshot = snapshots[len(snapshots)-1]
+		} else {
+			snapshot = &looprpc.BalanceSnapshot{
+				Timestamp:               timestamp,
+				SweepFeeRateSatPerVbyte: values[6],
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+
+		snapshot.Channels = append(
+			snapshot.Channels, &looprpc.ChannelBalance{
+				ChannelId:        values[1],
+				Pubkey:           pubkey,
+				CapacitySat:      values[3],
+				LocalBalanceSat:  values[4],
+				RemoteBalanceSat: values[5],
+			},
+		)
+	}
+}
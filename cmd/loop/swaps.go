@@ -44,6 +44,11 @@ var listSwapsCommand = cli.Command{
 			Usage: "Unix timestamp in nanoseconds to select swaps initiated " +
 				"after this time",
 		},
+		cli.Uint64Flag{
+			Name: "split_group_id",
+			Usage: "only list the parts of the split loop out " +
+				"with this group id",
+		},
 	},
 }
 
@@ -117,6 +122,8 @@ func listSwaps(ctx *cli.Context) error {
 		filter.StartTimestampNs = startTimestamp
 	}
 
+	filter.SplitGroupId = ctx.Uint64("split_group_id")
+
 	resp, err := client.ListSwaps(
 		context.Background(), &looprpc.ListSwapsRequest{
 			ListSwapFilter: filter,
@@ -0,0 +1,170 @@
+package conditional
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrNoCondition is returned when a loop out is scheduled without any
+	// condition to wait for.
+	ErrNoCondition = errors.New("at least one condition must be set")
+
+	// ErrNoRequest is returned when a loop out is scheduled without a
+	// loop out request.
+	ErrNoRequest = errors.New("loop out request required")
+
+	// ErrAssetLoopOut is returned when an asset loop out is scheduled.
+	// Asset loop outs are paid with rfqs that expire long before most
+	// conditions hold, so they can't be scheduled.
+	ErrAssetLoopOut = errors.New("asset loop outs can't be scheduled")
+
+	// ErrSplitLoopOut is returned when a split loop out is scheduled.
+	// Scheduled loop outs are quoted as a whole before they are
+	// dispatched, which fails for amounts that need to be split.
+	ErrSplitLoopOut = errors.New("split loop outs can't be scheduled")
+
+	// ErrInvalidRequest is returned when a loop out request that can't be
+	// dispatched is scheduled.
+	ErrInvalidRequest = errors.New("invalid loop out request")
+
+	// ErrLoopOutNotFound is returned when a scheduled loop out is not
+	// found.
+	ErrLoopOutNotFound = errors.New("scheduled loop out not found")
+
+	// ErrLoopOutResolved is returned when we try to cancel a scheduled
+	// loop out that is no longer pending.
+	ErrLoopOutResolved = errors.New("scheduled loop out is no longer " +
+		"pending")
+
+	// ErrMinerFeeTooHigh is returned when the quoted sweep fee of a
+	// scheduled loop out exceeds the request's maximum miner fee.
+	ErrMinerFeeTooHigh = errors.New("miner fee too high")
+)
+
+// State is an enum which represents the state of a scheduled loop out.
+type State uint8
+
+const (
+	// StatePending indicates that a loop out is waiting for its
+	// conditions to hold.
+	StatePending State = iota
+
+	// StateDispatched indicates that a loop out was dispatched.
+	StateDispatched
+
+	// StateFailed indicates that a loop out could not be dispatched.
+	StateFailed
+
+	// StateCanceled indicates that a loop out was canceled.
+	StateCanceled
+)
+
+// String returns a string representation of a state.
+func (s State) String() string {
+	switch s {
+	case StatePending:
+		return "pending"
+
+	case StateDispatched:
+		return "dispatched"
+
+	case StateFailed:
+		return "failed"
+
+	case StateCanceled:
+		return "canceled"
+
+	default:
+		return "unknown"
+	}
+}
+
+// Condition holds the conditions that must all hold before a loop out is
+// dispatched. Conditions that are not set are ignored.
+type Condition struct {
+	// Time is the time that must be reached, if set.
+	Time time.Time
+
+	// Height is the block height that must be reached, if non-zero.
+	Height int32
+
+	// MaxFeeRate is the sweep fee rate estimate that must be reached, if
+	// non-zero.
+	MaxFeeRate chainfee.SatPerKWeight
+}
+
+// validate checks that at least one condition is set.
+func (c Condition) validate() error {
+	if c.Height < 0 {
+		return fmt.Errorf("height must be positive, got: %v", c.Height)
+	}
+
+	if c.Time.IsZero() && c.Height == 0 && c.MaxFeeRate == 0 {
+		return ErrNoCondition
+	}
+
+	return nil
+}
+
+// String returns a string representation of a condition.
+func (c Condition) String() string {
+	return fmt.Sprintf("time: %v, height: %v, max fee rate: %v", c.Time,
+		c.Height, c.MaxFeeRate)
+}
+
+// LoopOut is a loop out request that is held back until its conditions hold.
+type LoopOut struct {
+	// ID is the unique id of the loop out.
+	ID uint64
+
+	// Request is the loop out request that is dispatched.
+	Request *looprpc.LoopOutRequest
+
+	// Condition holds the conditions that must hold before the loop out
+	// is dispatched.
+	Condition Condition
+
+	// State is the current state of the loop out.
+	State State
+
+	// Created is the time at which the loop out was scheduled.
+	Created time.Time
+
+	// Updated is the time at which the state of the loop out last changed.
+	Updated time.Time
+
+	// SwapHash is the hash of the swap that was dispatched, if any.
+	SwapHash *lntypes.Hash
+
+	// FailureReason describes why the loop out could not be dispatched.
+	FailureReason string
+}
+
+// Store is the interface that persists our scheduled loop outs.
+type Store interface {
+	// AddLoopOut persists a scheduled loop out and returns the id that it
+	// was stored under.
+	AddLoopOut(ctx context.Context, loopOut *LoopOut) (uint64, error)
+
+	// GetLoopOut returns the scheduled loop out with the id provided.
+	GetLoopOut(ctx context.Context, id uint64) (*LoopOut, error)
+
+	// ListLoopOuts returns our scheduled loop outs, ordered by the time
+	// that they were scheduled. If includeResolved is false, only pending
+	// loop outs are returned.
+	ListLoopOuts(ctx context.Context, includeResolved bool) ([]*LoopOut,
+		error)
+
+	// UpdateLoopOut sets the state of a scheduled loop out, along with
+	// the hash of the swap that was dispatched for it or the reason that
+	// it failed, if any.
+	UpdateLoopOut(ctx context.Context, id uint64, state State,
+		updated time.Time, hash *lntypes.Hash, reason string) error
+}
@@ -0,0 +1,405 @@
+package conditional
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"google.golang.org/protobuf/proto"
+)
+
+// pollInterval is the interval at which we check the conditions of our
+// pending loop outs in between blocks.
+const pollInterval = time.Minute
+
+// Config contains the external functionality required by the manager.
+type Config struct {
+	// Store persists our scheduled loop outs.
+	Store Store
+
+	// ChainNotifier is used to track the current block height.
+	ChainNotifier lndclient.ChainNotifierClient
+
+	// WalletKit is used to estimate the sweep fee rate of our loop outs.
+	WalletKit lndclient.WalletKitClient
+
+	// Clock allows easy mocking of time in unit tests.
+	Clock clock.Clock
+
+	// LoopOutQuote quotes a loop out, so that we can check the current
+	// fees of a loop out against its limits before we dispatch it.
+	LoopOutQuote func(ctx context.Context,
+		req *looprpc.QuoteRequest) (*looprpc.OutQuoteResponse, error)
+
+	// ValidateLoopOut checks the parts of a loop out request that don't
+	// change until it is dispatched, such as its destination and the
+	// channel that it opens, so that requests that can't be dispatched
+	// are rejected when they are scheduled.
+	ValidateLoopOut func(req *looprpc.LoopOutRequest) error
+
+	// LoopOut dispatches a loop out. It validates the request in the same
+	// way as requests that are not scheduled.
+	LoopOut func(ctx context.Context,
+		req *looprpc.LoopOutRequest) (*looprpc.SwapResponse, error)
+}
+
+// Manager holds loop outs back until their conditions hold, and then
+// dispatches them.
+type Manager struct {
+	cfg *Config
+
+	// height is the best block height that we know of, or zero if we
+	// have not received a block yet.
+	height atomic.Int32
+
+	// mu ensures that a loop out can't be canceled while we are
+	// dispatching it.
+	mu sync.Mutex
+
+	// newLoopOut is signaled when a loop out is scheduled, so that we
+	// check its conditions right away.
+	newLoopOut chan struct{}
+}
+
+// NewManager creates a new conditional loop out manager.
+func NewManager(cfg *Config) *Manager {
+	return &Manager{
+		cfg:        cfg,
+		newLoopOut: make(chan struct{}, 1),
+	}
+}
+
+// Run checks the conditions of our pending loop outs on every block, when a
+// loop out is scheduled and at a regular interval in between, and dispatches
+// the loop outs whose conditions hold. Since pending loop outs are loaded
+// from our store, loop outs that were scheduled before a restart are picked
+// up again.
+func (m *Manager) Run(ctx context.Context) error {
+	blockChan, errChan, err := m.cfg.ChainNotifier.RegisterBlockEpochNtfn(
+		ctx,
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case height := <-blockChan:
+			m.height.Store(height)
+
+		case <-m.newLoopOut:
+
+		case <-m.cfg.Clock.TickAfter(pollInterval):
+
+		case err := <-errChan:
+			return err
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if err := m.dispatchLoopOuts(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			log.Errorf("could not dispatch scheduled loop outs: %v",
+				err)
+		}
+	}
+}
+
+// ScheduleLoopOut persists a loop out that is dispatched once all of the
+// conditions provided hold, and returns its id.
+func (m *Manager) ScheduleLoopOut(ctx context.Context,
+	req *looprpc.LoopOutRequest, condition Condition) (uint64, error) {
+
+	if req == nil {
+		return 0, ErrNoRequest
+	}
+
+	if req.AssetInfo != nil || req.AssetRfqInfo != nil {
+		return 0, ErrAssetLoopOut
+	}
+
+	if req.Split != nil {
+		return 0, ErrSplitLoopOut
+	}
+
+	if err := m.cfg.ValidateLoopOut(req); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+	}
+
+	if err := condition.validate(); err != nil {
+		return 0, err
+	}
+
+	now := m.cfg.Clock.Now()
+	id, err := m.cfg.Store.AddLoopOut(ctx, &LoopOut{
+		Request:   req,
+		Condition: condition,
+		State:     StatePending,
+		Created:   now,
+		Updated:   now,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	log.Infof("Scheduled loop out %v for %v sats (%v)", id, req.Amt,
+		condition)
+
+	// Check our new loop out's conditions right away, in case they
+	// already hold. If a check is already signaled, we don't need to
+	// signal again.
+	select {
+	case m.newLoopOut <- struct{}{}:
+	default:
+	}
+
+	return id, nil
+}
+
+// ListLoopOuts returns our scheduled loop outs. If includeResolved is false,
+// only pending loop outs are returned.
+func (m *Manager) ListLoopOuts(ctx context.Context,
+	includeResolved bool) ([]*LoopOut, error) {
+
+	return m.cfg.Store.ListLoopOuts(ctx, includeResolved)
+}
+
+// CancelLoopOut cancels a pending scheduled loop out.
+func (m *Manager) CancelLoopOut(ctx context.Context, id uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	loopOut, err := m.cfg.Store.GetLoopOut(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if loopOut.State != StatePending {
+		return fmt.Errorf("%w: %v", ErrLoopOutResolved, loopOut.State)
+	}
+
+	log.Infof("Canceling scheduled loop out %v", id)
+
+	return m.cfg.Store.UpdateLoopOut(
+		ctx, id, StateCanceled, m.cfg.Clock.Now(), nil, "",
+	)
+}
+
+// dispatchLoopOuts dispatches each of our pending loop outs whose conditions
+// hold.
+func (m *Manager) dispatchLoopOuts(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	loopOuts, err := m.cfg.Store.ListLoopOuts(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	// Our loop outs are likely to share a sweep confirmation target, so
+	// we only estimate the fee rate once for each target.
+	feeRates := make(map[int32]chainfee.SatPerKWeight)
+
+	// A loop out that we fail to check or dispatch doesn't hold up the
+	// others, it is retried at our next check.
+	for _, loopOut := range loopOuts {
+		err := m.checkAndDispatch(ctx, loopOut, feeRates)
+		if err == nil {
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		log.Errorf("Could not dispatch scheduled loop out %v: %v",
+			loopOut.ID, err)
+	}
+
+	return nil
+}
+
+// checkAndDispatch dispatches a loop out if its conditions hold.
+func (m *Manager) checkAndDispatch(ctx context.Context, loopOut *LoopOut,
+	feeRates map[int32]chainfee.SatPerKWeight) error {
+
+	ok, err := m.conditionHolds(ctx, loopOut, feeRates)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return nil
+	}
+
+	return m.dispatch(ctx, loopOut)
+}
+
+// conditionHolds returns a boolean that indicates whether all of the
+// conditions of a loop out hold.
+func (m *Manager) conditionHolds(ctx context.Context, loopOut *LoopOut,
+	feeRates map[int32]chainfee.SatPerKWeight) (bool, error) {
+
+	condition := loopOut.Condition
+
+	now := m.cfg.Clock.Now()
+	if !condition.Time.IsZero() && now.Before(condition.Time) {
+		return false, nil
+	}
+
+	// If we have not received a block yet, we can't know whether we
+	// have reached the height.
+	if condition.Height != 0 && m.height.Load() < condition.Height {
+		return false, nil
+	}
+
+	if condition.MaxFeeRate == 0 {
+		return true, nil
+	}
+
+	confTarget := loopOut.Request.SweepConfTarget
+	if confTarget == 0 {
+		confTarget = loop.DefaultSweepConfTarget
+	}
+
+	feeRate, ok := feeRates[confTarget]
+	if !ok {
+		var err error
+		feeRate, err = m.cfg.WalletKit.EstimateFeeRate(ctx, confTarget)
+		if err != nil {
+			return false, err
+		}
+
+		feeRates[confTarget] = feeRate
+	}
+
+	return feeRate <= condition.MaxFeeRate, nil
+}
+
+// dispatch re-quotes a loop out whose conditions hold, checks the quote
+// against the limits of the loop out request and dispatches it. If we can't
+// get a quote, the loop out stays pending so that it is retried. If the
+// quote exceeds the request's limits or the loop out fails to start, it is
+// marked as failed, because our conditions may no longer hold once the
+// limits allow it.
+func (m *Manager) dispatch(ctx context.Context, loopOut *LoopOut) error {
+	req := dispatchRequest(loopOut, m.cfg.Clock.Now())
+
+	quote, err := m.cfg.LoopOutQuote(ctx, &looprpc.QuoteRequest{
+		Amt:                     req.Amt,
+		ConfTarget:              req.SweepConfTarget,
+		SwapPublicationDeadline: req.SwapPublicationDeadline,
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		log.Warnf("Could not quote scheduled loop out %v, will "+
+			"retry: %v", loopOut.ID, err)
+
+		return nil
+	}
+
+	if err := checkQuote(req, quote); err != nil {
+		return m.fail(ctx, loopOut, err)
+	}
+
+	log.Infof("Dispatching scheduled loop out %v", loopOut.ID)
+
+	resp, err := m.cfg.LoopOut(ctx, req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		return m.fail(ctx, loopOut, err)
+	}
+
+	hash, err := lntypes.MakeHash(resp.IdBytes)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Scheduled loop out %v dispatched as swap %v", loopOut.ID,
+		hash)
+
+	return m.cfg.Store.UpdateLoopOut(
+		ctx, loopOut.ID, StateDispatched, m.cfg.Clock.Now(), &hash, "",
+	)
+}
+
+// fail marks a loop out as failed with the reason provided.
+func (m *Manager) fail(ctx context.Context, loopOut *LoopOut,
+	reason error) error {
+
+	log.Warnf("Scheduled loop out %v failed: %v", loopOut.ID, reason)
+
+	return m.cfg.Store.UpdateLoopOut(
+		ctx, loopOut.ID, StateFailed, m.cfg.Clock.Now(), nil,
+		reason.Error(),
+	)
+}
+
+// dispatchRequest returns the request that a loop out is dispatched with.
+// The request's swap publication deadline is set relative to the time that
+// the loop out was scheduled, so we move it forward by the time that the loop
+// out waited for its conditions. Otherwise, every scheduled loop out would be
+// dispatched as a fast swap.
+func dispatchRequest(loopOut *LoopOut, now time.Time) *looprpc.LoopOutRequest {
+	req := proto.Clone(loopOut.Request).(*looprpc.LoopOutRequest)
+
+	deadline := req.SwapPublicationDeadline
+	if deadline == 0 || !now.After(loopOut.Created) {
+		return req
+	}
+
+	// The deadline may be set in seconds or in milliseconds, timestamps
+	// in milliseconds have at least 13 digits.
+	waited := uint64(now.Sub(loopOut.Created).Seconds())
+	if deadline >= 1e12 {
+		waited = uint64(now.Sub(loopOut.Created).Milliseconds())
+	}
+
+	req.SwapPublicationDeadline = deadline + waited
+
+	return req
+}
+
+// checkQuote checks that the fees of a quote are within the limits of a loop
+// out request.
+func checkQuote(req *looprpc.LoopOutRequest,
+	quote *looprpc.OutQuoteResponse) error {
+
+	if quote.SwapFeeSat > req.MaxSwapFee {
+		return fmt.Errorf("%w: quoted %v sat, maximum %v sat",
+			loop.ErrSwapFeeTooHigh, quote.SwapFeeSat,
+			req.MaxSwapFee)
+	}
+
+	if quote.PrepayAmtSat > req.MaxPrepayAmt {
+		return fmt.Errorf("%w: quoted %v sat, maximum %v sat",
+			loop.ErrPrepayAmountTooHigh, quote.PrepayAmtSat,
+			req.MaxPrepayAmt)
+	}
+
+	if quote.HtlcSweepFeeSat > req.MaxMinerFee {
+		return fmt.Errorf("%w: quoted %v sat, maximum %v sat",
+			ErrMinerFeeTooHigh, quote.HtlcSweepFeeSat,
+			req.MaxMinerFee)
+	}
+
+	return nil
+}
@@ -0,0 +1,412 @@
+package conditional
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testTime = time.Unix(1700000000, 0).UTC()
+
+	testHash = lntypes.Hash{1}
+
+	// testQuote is the quote that our loop outs are dispatched with
+	// unless a test sets its own.
+	testQuote = &looprpc.OutQuoteResponse{
+		SwapFeeSat:      100,
+		PrepayAmtSat:    50,
+		HtlcSweepFeeSat: 200,
+	}
+)
+
+// testRequest returns a loop out request with limits that allow our test
+// quote.
+func testRequest() *looprpc.LoopOutRequest {
+	return &looprpc.LoopOutRequest{
+		Amt:          100000,
+		MaxSwapFee:   100,
+		MaxPrepayAmt: 50,
+		MaxMinerFee:  200,
+	}
+}
+
+// dispatchHarness drives a manager with a test clock and mock chain, and
+// records the loop outs that it dispatches instead of sending them to the
+// server.
+type dispatchHarness struct {
+	manager *Manager
+	lnd     *test.LndMockServices
+	clock   *clock.TestClock
+
+	// quote is the quote that is returned to the manager, and quoteErr
+	// is the error that is returned when quoting.
+	quote    *looprpc.OutQuoteResponse
+	quoteErr error
+
+	// validateErr is the error that loop out requests fail validation
+	// with.
+	validateErr error
+
+	// dispatched holds the loop out requests that were dispatched.
+	dispatched []*looprpc.LoopOutRequest
+}
+
+// newDispatchHarness returns a harness whose quotes are within the limits of
+// testRequest and whose requests pass validation.
+func newDispatchHarness(t *testing.T) *dispatchHarness {
+	c := &dispatchHarness{
+		lnd:   test.NewMockLnd(),
+		clock: clock.NewTestClock(testTime),
+		quote: testQuote,
+	}
+
+	c.manager = NewManager(&Config{
+		Store:         newTestStore(t),
+		ChainNotifier: c.lnd.ChainNotifier,
+		WalletKit:     c.lnd.WalletKit,
+		Clock:         c.clock,
+		LoopOutQuote: func(_ context.Context,
+			_ *looprpc.QuoteRequest) (*looprpc.OutQuoteResponse,
+			error) {
+
+			return c.quote, c.quoteErr
+		},
+		ValidateLoopOut: func(*looprpc.LoopOutRequest) error {
+			return c.validateErr
+		},
+		LoopOut: func(_ context.Context,
+			req *looprpc.LoopOutRequest) (*looprpc.SwapResponse,
+			error) {
+
+			c.dispatched = append(c.dispatched, req)
+
+			return &looprpc.SwapResponse{
+				IdBytes: testHash[:],
+			}, nil
+		},
+	})
+
+	return c
+}
+
+// requireState asserts that the loop out with the id provided is in the
+// state provided.
+func (c *dispatchHarness) requireState(t *testing.T, id uint64,
+	state State) *LoopOut {
+
+	loopOut, err := c.manager.cfg.Store.GetLoopOut(
+		context.Background(), id,
+	)
+	require.NoError(t, err)
+	require.Equal(t, state, loopOut.State)
+
+	return loopOut
+}
+
+// TestScheduleLoopOut tests validation of the loop outs that we schedule.
+func TestScheduleLoopOut(t *testing.T) {
+	ctx := context.Background()
+	c := newDispatchHarness(t)
+
+	_, err := c.manager.ScheduleLoopOut(ctx, nil, Condition{Height: 1})
+	require.ErrorIs(t, err, ErrNoRequest)
+
+	_, err = c.manager.ScheduleLoopOut(ctx, testRequest(), Condition{})
+	require.ErrorIs(t, err, ErrNoCondition)
+
+	req := testRequest()
+	req.AssetInfo = &looprpc.AssetLoopOutRequest{}
+	_, err = c.manager.ScheduleLoopOut(ctx, req, Condition{Height: 1})
+	require.ErrorIs(t, err, ErrAssetLoopOut)
+
+	req = testRequest()
+	req.Split = &looprpc.LoopOutSplit{}
+	_, err = c.manager.ScheduleLoopOut(ctx, req, Condition{Height: 1})
+	require.ErrorIs(t, err, ErrSplitLoopOut)
+
+	// Requests that can't be dispatched, for example because their
+	// channel open or destination descriptor is invalid, are rejected
+	// right away.
+	c.validateErr = errors.New("invalid descriptor")
+	_, err = c.manager.ScheduleLoopOut(
+		ctx, testRequest(), Condition{Height: 1},
+	)
+	require.ErrorIs(t, err, ErrInvalidRequest)
+	require.ErrorContains(t, err, "invalid descriptor")
+
+	c.validateErr = nil
+	id, err := c.manager.ScheduleLoopOut(
+		ctx, testRequest(), Condition{Height: 1},
+	)
+	require.NoError(t, err)
+	c.requireState(t, id, StatePending)
+}
+
+// TestDispatchConditions tests that loop outs are only dispatched once all
+// of their conditions hold.
+func TestDispatchConditions(t *testing.T) {
+	ctx := context.Background()
+	c := newDispatchHarness(t)
+
+	c.lnd.SetFeeEstimate(loop.DefaultSweepConfTarget, 1000)
+
+	condition := Condition{
+		Time:       testTime.Add(time.Hour),
+		Height:     100,
+		MaxFeeRate: 500,
+	}
+	id, err := c.manager.ScheduleLoopOut(ctx, testRequest(), condition)
+	require.NoError(t, err)
+
+	// None of our conditions hold yet.
+	require.NoError(t, c.manager.dispatchLoopOuts(ctx))
+	c.requireState(t, id, StatePending)
+
+	// Reach our time and height, our fee rate is still too high.
+	c.clock.SetTime(condition.Time)
+	c.manager.height.Store(condition.Height)
+
+	require.NoError(t, c.manager.dispatchLoopOuts(ctx))
+	c.requireState(t, id, StatePending)
+	require.Empty(t, c.dispatched)
+
+	// Once our fee rate drops, the loop out is dispatched.
+	c.lnd.SetFeeEstimate(
+		loop.DefaultSweepConfTarget, chainfee.SatPerKWeight(500),
+	)
+
+	require.NoError(t, c.manager.dispatchLoopOuts(ctx))
+	loopOut := c.requireState(t, id, StateDispatched)
+	require.Equal(t, &testHash, loopOut.SwapHash)
+	require.Len(t, c.dispatched, 1)
+
+	// A dispatched loop out is not dispatched again.
+	require.NoError(t, c.manager.dispatchLoopOuts(ctx))
+	require.Len(t, c.dispatched, 1)
+}
+
+// TestDispatchFailure tests that a loop out that we fail to check doesn't hold
+// up the other loop outs.
+func TestDispatchFailure(t *testing.T) {
+	ctx := context.Background()
+	c := newDispatchHarness(t)
+	c.manager.height.Store(10)
+
+	// Our fee estimate fails for the conf target of our first loop out.
+	req := testRequest()
+	req.SweepConfTarget = 1
+
+	failing, err := c.manager.ScheduleLoopOut(
+		ctx, req, Condition{MaxFeeRate: 500},
+	)
+	require.NoError(t, err)
+
+	id, err := c.manager.ScheduleLoopOut(
+		ctx, testRequest(), Condition{Height: 10},
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, c.manager.dispatchLoopOuts(ctx))
+	c.requireState(t, failing, StatePending)
+	c.requireState(t, id, StateDispatched)
+	require.Len(t, c.dispatched, 1)
+}
+
+// TestDispatchQuote tests that loop outs are re-quoted and checked against
+// their limits before they are dispatched.
+func TestDispatchQuote(t *testing.T) {
+	tests := []struct {
+		name     string
+		quote    *looprpc.OutQuoteResponse
+		quoteErr error
+		state    State
+		err      error
+	}{
+		{
+			name:  "within limits",
+			quote: testQuote,
+			state: StateDispatched,
+		},
+		{
+			name:     "quote failed",
+			quoteErr: errors.New("server unavailable"),
+			state:    StatePending,
+		},
+		{
+			name: "swap fee too high",
+			quote: &looprpc.OutQuoteResponse{
+				SwapFeeSat: testQuote.SwapFeeSat + 1,
+			},
+			state: StateFailed,
+			err:   loop.ErrSwapFeeTooHigh,
+		},
+		{
+			name: "prepay too high",
+			quote: &looprpc.OutQuoteResponse{
+				PrepayAmtSat: testQuote.PrepayAmtSat + 1,
+			},
+			state: StateFailed,
+			err:   loop.ErrPrepayAmountTooHigh,
+		},
+		{
+			name: "miner fee too high",
+			quote: &looprpc.OutQuoteResponse{
+				HtlcSweepFeeSat: testQuote.HtlcSweepFeeSat + 1,
+			},
+			state: StateFailed,
+			err:   ErrMinerFeeTooHigh,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := newDispatchHarness(t)
+			c.quote = testCase.quote
+			c.quoteErr = testCase.quoteErr
+			c.manager.height.Store(10)
+
+			id, err := c.manager.ScheduleLoopOut(
+				ctx, testRequest(), Condition{Height: 10},
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, c.manager.dispatchLoopOuts(ctx))
+			loopOut := c.requireState(t, id, testCase.state)
+
+			if testCase.err != nil {
+				require.Contains(
+					t, loopOut.FailureReason,
+					testCase.err.Error(),
+				)
+				require.Empty(t, c.dispatched)
+			}
+		})
+	}
+}
+
+// TestCancelLoopOut tests cancellation of scheduled loop outs.
+func TestCancelLoopOut(t *testing.T) {
+	ctx := context.Background()
+	c := newDispatchHarness(t)
+
+	err := c.manager.CancelLoopOut(ctx, 1)
+	require.ErrorIs(t, err, ErrLoopOutNotFound)
+
+	id, err := c.manager.ScheduleLoopOut(
+		ctx, testRequest(), Condition{Height: 10},
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, c.manager.CancelLoopOut(ctx, id))
+	c.requireState(t, id, StateCanceled)
+
+	// A canceled loop out can't be canceled again, and is not
+	// dispatched once its conditions hold.
+	err = c.manager.CancelLoopOut(ctx, id)
+	require.ErrorIs(t, err, ErrLoopOutResolved)
+
+	c.manager.height.Store(10)
+	require.NoError(t, c.manager.dispatchLoopOuts(ctx))
+	require.Empty(t, c.dispatched)
+}
+
+// TestRun tests that our manager dispatches loop outs when blocks arrive.
+func TestRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := newDispatchHarness(t)
+
+	id, err := c.manager.ScheduleLoopOut(
+		ctx, testRequest(), Condition{Height: 10},
+	)
+	require.NoError(t, err)
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- c.manager.Run(ctx)
+	}()
+
+	// Wait for our manager to subscribe to blocks before we notify it
+	// of a block.
+	require.Eventually(t, func() bool {
+		return c.lnd.EpochSubscribers() == 1
+	}, test.Timeout, time.Millisecond*10)
+
+	require.NoError(t, c.lnd.NotifyHeight(10))
+
+	require.Eventually(t, func() bool {
+		loopOut, err := c.manager.cfg.Store.GetLoopOut(ctx, id)
+		require.NoError(t, err)
+
+		return loopOut.State == StateDispatched
+	}, test.Timeout, time.Millisecond*10)
+
+	cancel()
+	require.ErrorIs(t, <-errChan, context.Canceled)
+}
+
+// TestDispatchRequest tests that the swap publication deadline of a loop out
+// is moved forward by the time that it waited for its conditions.
+func TestDispatchRequest(t *testing.T) {
+	now := testTime.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		deadline uint64
+		expected uint64
+	}{
+		{
+			name: "no deadline",
+		},
+		{
+			name:     "seconds",
+			deadline: uint64(testTime.Unix()),
+			expected: uint64(now.Unix()),
+		},
+		{
+			name:     "milliseconds",
+			deadline: uint64(testTime.UnixMilli()),
+			expected: uint64(now.UnixMilli()),
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			req := testRequest()
+			req.SwapPublicationDeadline = testCase.deadline
+
+			loopOut := &LoopOut{
+				Request: req,
+				Created: testTime,
+			}
+
+			dispatched := dispatchRequest(loopOut, now)
+			require.Equal(
+				t, testCase.expected,
+				dispatched.SwapPublicationDeadline,
+			)
+
+			// The scheduled request itself is not changed.
+			require.Equal(
+				t, testCase.deadline,
+				req.SwapPublicationDeadline,
+			)
+		})
+	}
+}
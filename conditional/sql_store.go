@@ -0,0 +1,218 @@
+package conditional
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"google.golang.org/protobuf/proto"
+)
+
+// Querier is the set of conditional_loop_outs queries that the store needs.
+// Scheduled requests are kept as serialized rpc messages, so these queries
+// never look inside them.
+type Querier interface {
+	// InsertConditionalLoopOut inserts a scheduled loop out and returns
+	// its id.
+	InsertConditionalLoopOut(ctx context.Context,
+		arg sqlc.InsertConditionalLoopOutParams) (int32, error)
+
+	// GetConditionalLoopOut returns the scheduled loop out with the id
+	// provided.
+	GetConditionalLoopOut(ctx context.Context,
+		id int32) (sqlc.ConditionalLoopOut, error)
+
+	// ListConditionalLoopOuts returns all scheduled loop outs.
+	ListConditionalLoopOuts(ctx context.Context) (
+		[]sqlc.ConditionalLoopOut, error)
+
+	// ListConditionalLoopOutsByState returns the scheduled loop outs that
+	// are in the state provided.
+	ListConditionalLoopOutsByState(ctx context.Context,
+		state int32) ([]sqlc.ConditionalLoopOut, error)
+
+	// UpdateConditionalLoopOut updates the state of a scheduled loop
+	// out.
+	UpdateConditionalLoopOut(ctx context.Context,
+		arg sqlc.UpdateConditionalLoopOutParams) error
+}
+
+// BaseDB is the database that scheduled loop outs are stored in.
+type BaseDB interface {
+	Querier
+
+	// ExecTx allows for executing a function in the context of a database
+	// transaction.
+	ExecTx(ctx context.Context, txOptions loopdb.TxOptions,
+		txBody func(Querier) error) error
+}
+
+// SQLStore stores scheduled loop outs along with the condition that they are
+// waiting on and the outcome of their dispatch.
+type SQLStore struct {
+	baseDb BaseDB
+}
+
+// A compile-time check that SQLStore implements Store.
+var _ Store = (*SQLStore)(nil)
+
+// NewSQLStore returns a store for scheduled loop outs that is backed by the
+// database provided.
+func NewSQLStore(db BaseDB) *SQLStore {
+	return &SQLStore{
+		baseDb: db,
+	}
+}
+
+// AddLoopOut persists a scheduled loop out and returns the id that it was
+// stored under.
+func (s *SQLStore) AddLoopOut(ctx context.Context,
+	loopOut *LoopOut) (uint64, error) {
+
+	request, err := proto.Marshal(loopOut.Request)
+	if err != nil {
+		return 0, err
+	}
+
+	args := sqlc.InsertConditionalLoopOutParams{
+		CreatedAt:      loopOut.Created.UTC(),
+		UpdatedAt:      loopOut.Updated.UTC(),
+		Request:        request,
+		DispatchHeight: loopOut.Condition.Height,
+		MaxFeeRate:     int64(loopOut.Condition.MaxFeeRate),
+		State:          int32(loopOut.State),
+	}
+	if !loopOut.Condition.Time.IsZero() {
+		args.DispatchTime = sql.NullTime{
+			Time:  loopOut.Condition.Time.UTC(),
+			Valid: true,
+		}
+	}
+
+	id, err := s.baseDb.InsertConditionalLoopOut(ctx, args)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(id), nil
+}
+
+// GetLoopOut returns the scheduled loop out with the id provided.
+func (s *SQLStore) GetLoopOut(ctx context.Context,
+	id uint64) (*LoopOut, error) {
+
+	if id > math.MaxInt32 {
+		return nil, ErrLoopOutNotFound
+	}
+
+	row, err := s.baseDb.GetConditionalLoopOut(ctx, int32(id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrLoopOutNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sqlLoopOut(row)
+}
+
+// ListLoopOuts returns our scheduled loop outs, ordered by the time that
+// they were scheduled. If includeResolved is false, only pending loop outs
+// are returned.
+func (s *SQLStore) ListLoopOuts(ctx context.Context,
+	includeResolved bool) ([]*LoopOut, error) {
+
+	var (
+		rows []sqlc.ConditionalLoopOut
+		err  error
+	)
+	if includeResolved {
+		rows, err = s.baseDb.ListConditionalLoopOuts(ctx)
+	} else {
+		rows, err = s.baseDb.ListConditionalLoopOutsByState(
+			ctx, int32(StatePending),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	loopOuts := make([]*LoopOut, 0, len(rows))
+	for _, row := range rows {
+		loopOut, err := sqlLoopOut(row)
+		if err != nil {
+			return nil, err
+		}
+
+		loopOuts = append(loopOuts, loopOut)
+	}
+
+	return loopOuts, nil
+}
+
+// UpdateLoopOut sets the state of a scheduled loop out, along with the hash
+// of the swap that was dispatched for it or the reason that it failed, if
+// any.
+func (s *SQLStore) UpdateLoopOut(ctx context.Context, id uint64,
+	state State, updated time.Time, hash *lntypes.Hash,
+	reason string) error {
+
+	if id > math.MaxInt32 {
+		return ErrLoopOutNotFound
+	}
+
+	args := sqlc.UpdateConditionalLoopOutParams{
+		ID:            int32(id),
+		State:         int32(state),
+		UpdatedAt:     updated.UTC(),
+		FailureReason: reason,
+	}
+	if hash != nil {
+		args.SwapHash = hash[:]
+	}
+
+	return s.baseDb.UpdateConditionalLoopOut(ctx, args)
+}
+
+// sqlLoopOut converts a scheduled loop out from the database.
+func sqlLoopOut(row sqlc.ConditionalLoopOut) (*LoopOut, error) {
+	request := &looprpc.LoopOutRequest{}
+	if err := proto.Unmarshal(row.Request, request); err != nil {
+		return nil, err
+	}
+
+	loopOut := &LoopOut{
+		ID:      uint64(row.ID),
+		Request: request,
+		Condition: Condition{
+			Height:     row.DispatchHeight,
+			MaxFeeRate: chainfee.SatPerKWeight(row.MaxFeeRate),
+		},
+		State:         State(row.State),
+		Created:       row.CreatedAt,
+		Updated:       row.UpdatedAt,
+		FailureReason: row.FailureReason,
+	}
+
+	if row.DispatchTime.Valid {
+		loopOut.Condition.Time = row.DispatchTime.Time
+	}
+
+	if len(row.SwapHash) != 0 {
+		hash, err := lntypes.MakeHash(row.SwapHash)
+		if err != nil {
+			return nil, err
+		}
+
+		loopOut.SwapHash = &hash
+	}
+
+	return loopOut, nil
+}
@@ -0,0 +1,135 @@
+package conditional
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestStore returns a store backed by a test database that is closed when
+// the test completes.
+func newTestStore(t *testing.T) *SQLStore {
+	testDb := loopdb.NewTestDB(t)
+	t.Cleanup(func() {
+		testDb.Close()
+	})
+
+	return NewSQLStore(loopdb.NewTypedStore[Querier](testDb))
+}
+
+// TestSQLStore tests persisting, listing and updating scheduled loop outs.
+func TestSQLStore(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	_, err := store.GetLoopOut(ctx, 1)
+	require.ErrorIs(t, err, ErrLoopOutNotFound)
+
+	created := time.Unix(1000, 0).UTC()
+	timeLoopOut := &LoopOut{
+		Request: &looprpc.LoopOutRequest{
+			Amt:             100000,
+			Dest:            "dest",
+			MaxSwapFee:      1000,
+			OutgoingChanSet: []uint64{1, 2},
+		},
+		Condition: Condition{
+			Time: created.Add(time.Hour),
+		},
+		State:   StatePending,
+		Created: created,
+		Updated: created,
+	}
+
+	feeLoopOut := &LoopOut{
+		Request: &looprpc.LoopOutRequest{
+			Amt: 200000,
+		},
+		Condition: Condition{
+			Height:     500,
+			MaxFeeRate: 253,
+		},
+		State:   StatePending,
+		Created: created,
+		Updated: created,
+	}
+
+	id1, err := store.AddLoopOut(ctx, timeLoopOut)
+	require.NoError(t, err)
+
+	id2, err := store.AddLoopOut(ctx, feeLoopOut)
+	require.NoError(t, err)
+
+	// requireLoopOut asserts that the loop out stored under the id
+	// provided matches the loop out that we expect.
+	requireLoopOut := func(expected *LoopOut, id uint64) {
+		actual, err := store.GetLoopOut(ctx, id)
+		require.NoError(t, err)
+
+		require.True(t, proto.Equal(expected.Request, actual.Request))
+		require.Equal(t, id, actual.ID)
+		require.Equal(t, expected.Condition, actual.Condition)
+		require.Equal(t, expected.State, actual.State)
+		require.Equal(t, expected.Created, actual.Created)
+		require.Equal(t, expected.Updated, actual.Updated)
+		require.Equal(t, expected.SwapHash, actual.SwapHash)
+		require.Equal(t, expected.FailureReason, actual.FailureReason)
+	}
+
+	requireLoopOut(timeLoopOut, id1)
+	requireLoopOut(feeLoopOut, id2)
+
+	// Dispatch our first loop out and fail the second.
+	updated := created.Add(time.Minute)
+	hash := lntypes.Hash{1, 2, 3}
+	err = store.UpdateLoopOut(
+		ctx, id1, StateDispatched, updated, &hash, "",
+	)
+	require.NoError(t, err)
+
+	err = store.UpdateLoopOut(ctx, id2, StateFailed, updated, nil, "fee")
+	require.NoError(t, err)
+
+	timeLoopOut.State = StateDispatched
+	timeLoopOut.Updated = updated
+	timeLoopOut.SwapHash = &hash
+	requireLoopOut(timeLoopOut, id1)
+
+	feeLoopOut.State = StateFailed
+	feeLoopOut.Updated = updated
+	feeLoopOut.FailureReason = "fee"
+	requireLoopOut(feeLoopOut, id2)
+
+	// Add a pending loop out and check that only it is listed when we
+	// exclude resolved loop outs.
+	id3, err := store.AddLoopOut(ctx, &LoopOut{
+		Request: &looprpc.LoopOutRequest{
+			Amt: 300000,
+		},
+		Condition: Condition{
+			Height: 600,
+		},
+		State:   StatePending,
+		Created: updated,
+		Updated: updated,
+	})
+	require.NoError(t, err)
+
+	pending, err := store.ListLoopOuts(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, id3, pending[0].ID)
+
+	all, err := store.ListLoopOuts(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, id1, all[0].ID)
+	require.Equal(t, id2, all[1].ID)
+	require.Equal(t, id3, all[2].ID)
+}
@@ -0,0 +1,488 @@
+package descriptor
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+var (
+	// ErrEmptyDescriptor is returned when an empty descriptor is provided.
+	ErrEmptyDescriptor = errors.New("empty descriptor")
+
+	// ErrUnsupportedDescriptor is returned when a descriptor is not one of
+	// the single key descriptors that we can derive addresses from.
+	ErrUnsupportedDescriptor = errors.New("unsupported descriptor, " +
+		"expected pkh, sh(wpkh), wpkh or key path only tr")
+
+	// ErrInvalidChecksum is returned when the checksum of a descriptor
+	// does not match the descriptor.
+	ErrInvalidChecksum = errors.New("invalid descriptor checksum")
+
+	// ErrPrivateKey is returned when a descriptor holds an extended
+	// private key. We only derive addresses, so watch-only descriptors
+	// should be used instead.
+	ErrPrivateKey = errors.New("descriptor must not hold private keys")
+
+	// ErrWrongNetwork is returned when the extended key of a descriptor
+	// is not for the network that we are running on.
+	ErrWrongNetwork = errors.New("extended key is for a different " +
+		"network")
+
+	// ErrNotRanged is returned when a descriptor does not end in a
+	// wildcard, which means that it only describes a single address.
+	ErrNotRanged = errors.New("descriptor must end in an unhardened " +
+		"wildcard (/*)")
+
+	// ErrHardenedPath is returned when the derivation path of a descriptor
+	// holds hardened steps, which can't be derived from public keys.
+	ErrHardenedPath = errors.New("hardened derivation from an extended " +
+		"public key")
+)
+
+// AddrType is the type of address that a descriptor derives.
+type AddrType uint8
+
+const (
+	// AddrTypeP2PKH derives legacy pay to public key hash addresses.
+	AddrTypeP2PKH AddrType = iota
+
+	// AddrTypeNestedP2WPKH derives pay to witness public key hash
+	// addresses nested in pay to script hash addresses.
+	AddrTypeNestedP2WPKH
+
+	// AddrTypeP2WPKH derives pay to witness public key hash addresses.
+	AddrTypeP2WPKH
+
+	// AddrTypeP2TR derives BIP86 taproot addresses, which can only be
+	// spent using the key path.
+	AddrTypeP2TR
+)
+
+// String returns a string representation of an address type.
+func (a AddrType) String() string {
+	switch a {
+	case AddrTypeP2PKH:
+		return "p2pkh"
+
+	case AddrTypeNestedP2WPKH:
+		return "np2wpkh"
+
+	case AddrTypeP2WPKH:
+		return "p2wpkh"
+
+	case AddrTypeP2TR:
+		return "p2tr"
+
+	default:
+		return "unknown"
+	}
+}
+
+// keyVersion describes the version bytes of an extended public key as
+// registered in SLIP-132.
+type keyVersion struct {
+	addrType AddrType
+	mainnet  bool
+}
+
+// keyVersions maps the version bytes of the extended public keys that we
+// accept on their own to the type of address that they are used for.
+var keyVersions = map[[4]byte]keyVersion{
+	// xpub.
+	{0x04, 0x88, 0xb2, 0x1e}: {AddrTypeP2PKH, true},
+
+	// ypub.
+	{0x04, 0x9d, 0x7c, 0xb2}: {AddrTypeNestedP2WPKH, true},
+
+	// zpub.
+	{0x04, 0xb2, 0x47, 0x46}: {AddrTypeP2WPKH, true},
+
+	// tpub.
+	{0x04, 0x35, 0x87, 0xcf}: {AddrTypeP2PKH, false},
+
+	// upub.
+	{0x04, 0x4a, 0x52, 0x62}: {AddrTypeNestedP2WPKH, false},
+
+	// vpub.
+	{0x04, 0x5f, 0x1c, 0xf6}: {AddrTypeP2WPKH, false},
+}
+
+// scriptFuncs are the script expressions of the descriptors that we support,
+// from the outermost to the innermost function.
+var scriptFuncs = []struct {
+	funcs    []string
+	addrType AddrType
+}{
+	{[]string{"pkh"}, AddrTypeP2PKH},
+	{[]string{"sh", "wpkh"}, AddrTypeNestedP2WPKH},
+	{[]string{"wpkh"}, AddrTypeP2WPKH},
+	{[]string{"tr"}, AddrTypeP2TR},
+}
+
+// Descriptor is a watch-only source of addresses, which is either a single
+// key output descriptor or an extended public key.
+type Descriptor struct {
+	// str is the normalized string representation of the descriptor.
+	str string
+
+	// addrType is the type of address that we derive.
+	addrType AddrType
+
+	// key is the extended public key at the end of the descriptor's
+	// derivation path, so that only the index of an address remains to
+	// be derived.
+	key *hdkeychain.ExtendedKey
+
+	// params are the parameters of the network that we derive addresses
+	// for.
+	params *chaincfg.Params
+}
+
+// Parse parses a descriptor for the network provided. Output descriptors of
+// the form pkh(KEY), sh(wpkh(KEY)), wpkh(KEY) and tr(KEY) are supported, with
+// an optional checksum, where KEY is an extended public key with an optional
+// key origin that is followed by an unhardened derivation path ending in a
+// wildcard, for example wpkh([d34db33f/84h/0h/0h]xpub.../0/*). An extended
+// public key on its own is treated as an account key of the address type that
+// its SLIP-132 version (xpub, ypub, zpub or their testnet equivalents)
+// implies, and addresses are derived from its external chain.
+func Parse(desc string, params *chaincfg.Params) (*Descriptor, error) {
+	desc = strings.TrimSpace(desc)
+	if desc == "" {
+		return nil, ErrEmptyDescriptor
+	}
+
+	if !strings.Contains(desc, "(") {
+		return parseExtendedKey(desc, params)
+	}
+
+	body, err := stripChecksum(desc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, script := range scriptFuncs {
+		keyExpr, ok := unwrap(body, script.funcs)
+		if !ok {
+			continue
+		}
+
+		key, err := parseKeyExpression(keyExpr, params)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Descriptor{
+			str:      body,
+			addrType: script.addrType,
+			key:      key,
+			params:   params,
+		}, nil
+	}
+
+	return nil, ErrUnsupportedDescriptor
+}
+
+// String returns the normalized representation of the descriptor, which is
+// the descriptor without its checksum or the extended key that it consists
+// of.
+func (d *Descriptor) String() string {
+	return d.str
+}
+
+// AddrType returns the type of address that the descriptor derives.
+func (d *Descriptor) AddrType() AddrType {
+	return d.addrType
+}
+
+// Derive derives the address at the index provided. Note that
+// hdkeychain.ErrInvalidChild is returned for the rare indexes that no key
+// can be derived at, in which case the next index should be used.
+func (d *Descriptor) Derive(index uint32) (btcutil.Address, error) {
+	if index >= hdkeychain.HardenedKeyStart {
+		return nil, ErrHardenedPath
+	}
+
+	child, err := d.key.Derive(index)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := child.ECPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	switch d.addrType {
+	case AddrTypeP2PKH:
+		return btcutil.NewAddressPubKeyHash(
+			btcutil.Hash160(pubKey.SerializeCompressed()), d.params,
+		)
+
+	case AddrTypeNestedP2WPKH:
+		witnessAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+			btcutil.Hash160(pubKey.SerializeCompressed()), d.params,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		witnessScript, err := txscript.PayToAddrScript(witnessAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		return btcutil.NewAddressScriptHash(witnessScript, d.params)
+
+	case AddrTypeP2WPKH:
+		return btcutil.NewAddressWitnessPubKeyHash(
+			btcutil.Hash160(pubKey.SerializeCompressed()), d.params,
+		)
+
+	case AddrTypeP2TR:
+		taprootKey := txscript.ComputeTaprootKeyNoScript(pubKey)
+
+		return btcutil.NewAddressTaproot(
+			schnorr.SerializePubKey(taprootKey), d.params,
+		)
+
+	default:
+		return nil, fmt.Errorf("unknown address type: %v", d.addrType)
+	}
+}
+
+// parseExtendedKey parses an extended public key that is provided on its own.
+func parseExtendedKey(str string, params *chaincfg.Params) (*Descriptor,
+	error) {
+
+	key, err := hdkeychain.NewKeyFromString(str)
+	if err != nil {
+		return nil, fmt.Errorf("invalid extended key: %w", err)
+	}
+
+	if key.IsPrivate() {
+		return nil, ErrPrivateKey
+	}
+
+	version, ok := keyVersions[[4]byte(key.Version())]
+	if !ok {
+		return nil, fmt.Errorf("unknown extended key version: %x",
+			key.Version())
+	}
+
+	// Simnet has extended keys of its own, so we check the standard
+	// version against the network directly.
+	switch {
+	case version.addrType == AddrTypeP2PKH:
+		if !key.IsForNet(params) {
+			return nil, ErrWrongNetwork
+		}
+
+	case version.mainnet != (params.Net == wire.MainNet):
+		return nil, ErrWrongNetwork
+	}
+
+	// Addresses that are handed out to others are derived from the
+	// external chain of the account.
+	external, err := key.Derive(0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Descriptor{
+		str:      str,
+		addrType: version.addrType,
+		key:      external,
+		params:   params,
+	}, nil
+}
+
+// unwrap strips the script functions provided off an expression, and returns
+// false if the expression does not consist of these functions.
+func unwrap(expr string, funcs []string) (string, bool) {
+	for _, name := range funcs {
+		prefix := name + "("
+		if !strings.HasPrefix(expr, prefix) ||
+			!strings.HasSuffix(expr, ")") {
+
+			return "", false
+		}
+
+		expr = expr[len(prefix) : len(expr)-1]
+	}
+
+	// Nested functions or taproot script trees are not supported.
+	if strings.ContainsAny(expr, "(),") {
+		return "", false
+	}
+
+	return expr, true
+}
+
+// parseKeyExpression parses a key expression with an optional origin, and
+// returns the extended public key at the end of its derivation path without
+// the wildcard step.
+func parseKeyExpression(expr string, params *chaincfg.Params) (
+	*hdkeychain.ExtendedKey, error) {
+
+	// The origin of a key only describes how the key was derived from its
+	// master key, so we only check that it is well formed.
+	if strings.HasPrefix(expr, "[") {
+		end := strings.Index(expr, "]")
+		if end == -1 {
+			return nil, errors.New("unterminated key origin")
+		}
+
+		fingerprint, _, _ := strings.Cut(expr[1:end], "/")
+		if _, err := hex.DecodeString(fingerprint); err != nil ||
+			len(fingerprint) != 8 {
+
+			return nil, fmt.Errorf("invalid key origin "+
+				"fingerprint: %v", fingerprint)
+		}
+
+		expr = expr[end+1:]
+	}
+
+	steps := strings.Split(expr, "/")
+	if len(steps) < 2 || steps[len(steps)-1] != "*" {
+		return nil, ErrNotRanged
+	}
+
+	key, err := hdkeychain.NewKeyFromString(steps[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid extended key: %w", err)
+	}
+
+	if key.IsPrivate() {
+		return nil, ErrPrivateKey
+	}
+
+	if !key.IsForNet(params) {
+		return nil, ErrWrongNetwork
+	}
+
+	for _, step := range steps[1 : len(steps)-1] {
+		index, err := strconv.ParseUint(step, 10, 32)
+		if err != nil {
+			// Hardened steps are suffixed with h or '.
+			if strings.ContainsAny(step, "h'") {
+				return nil, ErrHardenedPath
+			}
+
+			return nil, fmt.Errorf("invalid derivation step: %v",
+				step)
+		}
+
+		if index >= hdkeychain.HardenedKeyStart {
+			return nil, ErrHardenedPath
+		}
+
+		key, err = key.Derive(uint32(index))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+// inputCharset is the character set of descriptors that their checksum is
+// defined over, as specified in BIP380.
+const inputCharset = "0123456789()[],'/*abcdefgh@:$%{}" +
+	"IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~" +
+	"ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+
+// checksumCharset is the character set that checksums are encoded in.
+const checksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// checksumGenerator holds the generator of the checksum's BCH code.
+var checksumGenerator = [5]uint64{
+	0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a, 0x644d626ffd,
+}
+
+// stripChecksum verifies the checksum of a descriptor if it has one, and
+// returns the descriptor without it.
+func stripChecksum(desc string) (string, error) {
+	body, checksum, ok := strings.Cut(desc, "#")
+	if !ok {
+		return desc, nil
+	}
+
+	expected, err := Checksum(body)
+	if err != nil {
+		return "", err
+	}
+
+	if checksum != expected {
+		return "", ErrInvalidChecksum
+	}
+
+	return body, nil
+}
+
+// Checksum calculates the BIP380 checksum of a descriptor.
+func Checksum(desc string) (string, error) {
+	polymod := func(chk uint64, value uint64) uint64 {
+		top := chk >> 35
+		chk = (chk&0x7ffffffff)<<5 ^ value
+		for i, generator := range checksumGenerator {
+			if (top>>i)&1 == 1 {
+				chk ^= generator
+			}
+		}
+
+		return chk
+	}
+
+	var (
+		chk       uint64 = 1
+		groups    uint64
+		numGroups int
+	)
+	for _, char := range desc {
+		pos := strings.IndexRune(inputCharset, char)
+		if pos == -1 {
+			return "", fmt.Errorf("invalid descriptor character: "+
+				"%q", char)
+		}
+
+		// The lower five bits of each character are added as a
+		// symbol, and the upper bits are added for each group of
+		// three characters.
+		chk = polymod(chk, uint64(pos&31))
+		groups = groups*3 + uint64(pos>>5)
+		numGroups++
+
+		if numGroups == 3 {
+			chk = polymod(chk, groups)
+			groups, numGroups = 0, 0
+		}
+	}
+
+	if numGroups > 0 {
+		chk = polymod(chk, groups)
+	}
+
+	for i := 0; i < 8; i++ {
+		chk = polymod(chk, 0)
+	}
+	chk ^= 1
+
+	checksum := make([]byte, 8)
+	for i := range checksum {
+		checksum[i] = checksumCharset[(chk>>(5*(7-i)))&31]
+	}
+
+	return string(checksum), nil
+}
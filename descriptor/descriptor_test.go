@@ -0,0 +1,221 @@
+package descriptor
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// The account keys below are derived from the "abandon abandon ...
+	// about" test mnemonic, and the addresses are the first receiving
+	// addresses that BIP44, BIP49, BIP84 and BIP86 list for them.
+	bip44Key = "xpub6BosfCnifzxcFwrSzQiqu2DBVTshkCXacvNsWGYJVVhhawA7d4R5" +
+		"WSWGFNbi8Aw6ZRc1brxMyWMzG3DSSSSoekkudhUd9yLb6qx39T9nMdj"
+	bip44Addr = "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA"
+
+	bip49Key = "ypub6Ww3ibxVfGzLrAH1PNcjyAWenMTbbAosGNB6VvmSEgytSER9azLD" +
+		"WCxoJwW7Ke7icmizBMXrzBx9979FfaHxHcrArf3zbeJJJUZPf663zsP"
+	bip49Addr = "37VucYSaXLCAsxYyAPfbSi9eh4iEcbShgf"
+
+	bip84Key = "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNf" +
+		"E3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs"
+	bip84Addr = "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"
+
+	bip86Key = "xpub6BgBgsespWvERF3LHQu6CnqdvfEvtMcQjYrcRzx53QJjSxarj2af" +
+		"YWcLteoGVky7D3UKDP9QyrLprQ3VCECoY49yfdDEHGCtMMj92pReUsQ"
+	bip86Addr = "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwud" +
+		"pxqkedrcr"
+)
+
+// toXpub returns the xpub encoding of an extended key, which descriptors
+// use regardless of the address type.
+func toXpub(t *testing.T, str string) string {
+	key, err := hdkeychain.NewKeyFromString(str)
+	require.NoError(t, err)
+
+	key, err = key.CloneWithVersion(
+		chaincfg.MainNetParams.HDPublicKeyID[:],
+	)
+	require.NoError(t, err)
+
+	return key.String()
+}
+
+// withChecksum appends the checksum of a descriptor to it.
+func withChecksum(t *testing.T, desc string) string {
+	checksum, err := Checksum(desc)
+	require.NoError(t, err)
+
+	return desc + "#" + checksum
+}
+
+// TestChecksum tests calculating descriptor checksums.
+func TestChecksum(t *testing.T) {
+	// This is the test vector of BIP380.
+	checksum, err := Checksum("raw(deadbeef)")
+	require.NoError(t, err)
+	require.Equal(t, "89f8spxm", checksum)
+
+	_, err = Checksum("raw(deadbeef)\n")
+	require.Error(t, err)
+}
+
+// TestDerive tests deriving addresses from descriptors and extended keys.
+func TestDerive(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	wpkh := "wpkh([73c5da0a/84h/0h/0h]" + toXpub(t, bip84Key) + "/0/*)"
+
+	tests := []struct {
+		name     string
+		desc     string
+		addrType AddrType
+		addr     string
+		str      string
+	}{
+		{
+			name:     "pkh",
+			desc:     "pkh(" + bip44Key + "/0/*)",
+			addrType: AddrTypeP2PKH,
+			addr:     bip44Addr,
+		},
+		{
+			name: "sh(wpkh)",
+			desc: "sh(wpkh(" + toXpub(t, bip49Key) +
+				"/0/*))",
+			addrType: AddrTypeNestedP2WPKH,
+			addr:     bip49Addr,
+		},
+		{
+			name:     "wpkh with origin and checksum",
+			desc:     withChecksum(t, wpkh),
+			addrType: AddrTypeP2WPKH,
+			addr:     bip84Addr,
+			str:      wpkh,
+		},
+		{
+			name:     "tr",
+			desc:     "tr(" + bip86Key + "/0/*)",
+			addrType: AddrTypeP2TR,
+			addr:     bip86Addr,
+		},
+		{
+			name:     "xpub",
+			desc:     bip44Key,
+			addrType: AddrTypeP2PKH,
+			addr:     bip44Addr,
+		},
+		{
+			name:     "ypub",
+			desc:     bip49Key,
+			addrType: AddrTypeNestedP2WPKH,
+			addr:     bip49Addr,
+		},
+		{
+			name:     "zpub",
+			desc:     " " + bip84Key + "\n",
+			addrType: AddrTypeP2WPKH,
+			addr:     bip84Addr,
+			str:      bip84Key,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			desc, err := Parse(testCase.desc, params)
+			require.NoError(t, err)
+			require.Equal(t, testCase.addrType, desc.AddrType())
+
+			str := testCase.str
+			if str == "" {
+				str = testCase.desc
+			}
+			require.Equal(t, str, desc.String())
+
+			addr, err := desc.Derive(0)
+			require.NoError(t, err)
+			require.Equal(t, testCase.addr, addr.String())
+
+			// The next address is a different one.
+			next, err := desc.Derive(1)
+			require.NoError(t, err)
+			require.NotEqual(t, addr.String(), next.String())
+		})
+	}
+}
+
+// TestParseErrors tests that descriptors that we can't derive addresses from
+// are rejected.
+func TestParseErrors(t *testing.T) {
+	xpub := toXpub(t, bip84Key)
+
+	master, err := hdkeychain.NewMaster(
+		make([]byte, hdkeychain.RecommendedSeedLen),
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		desc string
+		err  error
+	}{
+		{
+			name: "empty",
+			desc: " ",
+			err:  ErrEmptyDescriptor,
+		},
+		{
+			name: "invalid checksum",
+			desc: "wpkh(" + xpub + "/0/*)#qqqqqqqq",
+			err:  ErrInvalidChecksum,
+		},
+		{
+			name: "multisig",
+			desc: "wsh(multi(1," + xpub + "/0/*))",
+			err:  ErrUnsupportedDescriptor,
+		},
+		{
+			name: "taproot script tree",
+			desc: "tr(" + xpub + "/0/*,pk(" + xpub + "/1/*))",
+			err:  ErrUnsupportedDescriptor,
+		},
+		{
+			name: "not ranged",
+			desc: "wpkh(" + xpub + "/0/0)",
+			err:  ErrNotRanged,
+		},
+		{
+			name: "hardened step",
+			desc: "wpkh(" + xpub + "/0h/*)",
+			err:  ErrHardenedPath,
+		},
+		{
+			name: "private key",
+			desc: "wpkh(" + master.String() + "/0/*)",
+			err:  ErrPrivateKey,
+		},
+		{
+			name: "plain private key",
+			desc: master.String(),
+			err:  ErrPrivateKey,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Parse(testCase.desc, &chaincfg.MainNetParams)
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+
+	// Mainnet keys are rejected on other networks.
+	_, err = Parse("wpkh("+xpub+"/0/*)", &chaincfg.TestNet3Params)
+	require.ErrorIs(t, err, ErrWrongNetwork)
+
+	_, err = Parse(bip84Key, &chaincfg.RegressionNetParams)
+	require.ErrorIs(t, err, ErrWrongNetwork)
+}
@@ -0,0 +1,169 @@
+package descriptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+// maxSkippedAddrs is the number of consecutive addresses that we skip
+// because they were used before, before we give up on finding an unused
+// address.
+const maxSkippedAddrs = 1000
+
+// ErrNoUnusedAddr is returned when we could not find an unused address of a
+// descriptor.
+var ErrNoUnusedAddr = errors.New("no unused address found")
+
+// Address is an address that was derived from a descriptor and handed out as
+// the destination of a loop out.
+type Address struct {
+	// Descriptor is the normalized descriptor that the address was
+	// derived from.
+	Descriptor string
+
+	// Index is the index that the address was derived at.
+	Index uint32
+
+	// Addr is the derived address.
+	Addr btcutil.Address
+
+	// Created is the time at which the address was handed out.
+	Created time.Time
+}
+
+// Store persists the addresses that we derive from our descriptors.
+type Store interface {
+	// NextIndex returns the index that the next address of a descriptor
+	// is derived at, which is zero for descriptors that we don't know
+	// yet.
+	NextIndex(ctx context.Context, descriptor string) (uint32, error)
+
+	// IsAddrUsed returns whether an address was derived from any of our
+	// descriptors before.
+	IsAddrUsed(ctx context.Context, addr string) (bool, error)
+
+	// IsSwapAddr returns whether any of our loop outs paid to an address.
+	IsSwapAddr(ctx context.Context, addr string) (bool, error)
+
+	// AddAddr persists an address that was derived from a descriptor, and
+	// advances the next index of the descriptor past the address.
+	AddAddr(ctx context.Context, addr *Address) error
+}
+
+// Config contains the external functionality required by the manager.
+type Config struct {
+	// Store persists the addresses that we derive.
+	Store Store
+
+	// ChainParams are the parameters of the network that we derive
+	// addresses for.
+	ChainParams *chaincfg.Params
+
+	// Clock allows easy mocking of time in unit tests.
+	Clock clock.Clock
+}
+
+// Manager hands out fresh addresses of watch-only descriptors as the
+// destinations of loop outs.
+type Manager struct {
+	cfg *Config
+
+	// mu serializes the derivation of addresses, so that an address can't
+	// be handed out twice.
+	mu sync.Mutex
+}
+
+// NewManager creates a new descriptor manager.
+func NewManager(cfg *Config) *Manager {
+	return &Manager{
+		cfg: cfg,
+	}
+}
+
+// Validate checks that a descriptor can be used to derive addresses on our
+// network.
+func (m *Manager) Validate(descriptor string) error {
+	_, err := Parse(descriptor, m.cfg.ChainParams)
+
+	return err
+}
+
+// NextAddr derives the next address of a descriptor and persists its index,
+// so that the address isn't handed out again. Addresses that were derived
+// before, or that past loop outs paid to, are skipped.
+func (m *Manager) NextAddr(ctx context.Context,
+	descriptor string) (btcutil.Address, error) {
+
+	desc, err := Parse(descriptor, m.cfg.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	index, err := m.cfg.Store.NextIndex(ctx, desc.String())
+	if err != nil {
+		return nil, err
+	}
+
+	for skipped := 0; skipped < maxSkippedAddrs; skipped++ {
+		if index >= hdkeychain.HardenedKeyStart {
+			return nil, ErrNoUnusedAddr
+		}
+
+		addr, err := desc.Derive(index)
+		if errors.Is(err, hdkeychain.ErrInvalidChild) {
+			index++
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		used, err := m.cfg.Store.IsAddrUsed(ctx, addr.String())
+		if err != nil {
+			return nil, err
+		}
+
+		paidTo, err := m.cfg.Store.IsSwapAddr(ctx, addr.String())
+		if err != nil {
+			return nil, err
+		}
+
+		if used || paidTo {
+			log.Warnf("Skipping address %v at index %v of "+
+				"descriptor %v, which was used before", addr,
+				index, desc)
+
+			index++
+			continue
+		}
+
+		err = m.cfg.Store.AddAddr(ctx, &Address{
+			Descriptor: desc.String(),
+			Index:      index,
+			Addr:       addr,
+			Created:    m.cfg.Clock.Now(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not persist address: %w",
+				err)
+		}
+
+		log.Infof("Derived address %v at index %v of descriptor %v",
+			addr, index, desc)
+
+		return addr, nil
+	}
+
+	return nil, ErrNoUnusedAddr
+}
@@ -0,0 +1,98 @@
+package descriptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// swapAddrStore is a store that reports the addresses that our loop outs paid
+// to from memory, so that we don't need to store loop outs.
+type swapAddrStore struct {
+	*SQLStore
+
+	swapAddrs map[string]bool
+}
+
+// IsSwapAddr returns whether a loop out paid to an address.
+func (s *swapAddrStore) IsSwapAddr(_ context.Context, addr string) (bool,
+	error) {
+
+	return s.swapAddrs[addr], nil
+}
+
+// TestNextAddr tests handing out fresh addresses of descriptors.
+func TestNextAddr(t *testing.T) {
+	ctx := context.Background()
+	params := &chaincfg.MainNetParams
+
+	testDb := loopdb.NewTestDB(t)
+	defer testDb.Close()
+
+	store := &swapAddrStore{
+		SQLStore:  NewSQLStore(loopdb.NewTypedStore[Querier](testDb)),
+		swapAddrs: make(map[string]bool),
+	}
+	paidTo := func(addr btcutil.Address) {
+		store.swapAddrs[addr.String()] = true
+	}
+
+	manager := NewManager(&Config{
+		Store:       store,
+		ChainParams: params,
+		Clock:       clock.NewTestClock(time.Unix(1000, 0)),
+	})
+
+	wpkh := "wpkh(" + toXpub(t, bip84Key) + "/0/*)"
+	desc, err := Parse(wpkh, params)
+	require.NoError(t, err)
+
+	derive := func(index uint32) btcutil.Address {
+		addr, err := desc.Derive(index)
+		require.NoError(t, err)
+
+		return addr
+	}
+
+	// None of the loop outs in our database paid to the first address of
+	// our descriptor.
+	paid, err := store.SQLStore.IsSwapAddr(ctx, derive(0).String())
+	require.NoError(t, err)
+	require.False(t, paid)
+
+	// A past swap paid to the first address of our descriptor already,
+	// so it is skipped.
+	paidTo(derive(0))
+
+	addr, err := manager.NextAddr(ctx, wpkh)
+	require.NoError(t, err)
+	require.Equal(t, derive(1), addr)
+
+	// Each call hands out a fresh address, and the descriptor is matched
+	// regardless of its checksum.
+	addr, err = manager.NextAddr(ctx, withChecksum(t, wpkh))
+	require.NoError(t, err)
+	require.Equal(t, derive(2), addr)
+
+	// The zpub of the same account derives the same addresses, but the
+	// addresses that we handed out for the descriptor are skipped.
+	addr, err = manager.NextAddr(ctx, bip84Key)
+	require.NoError(t, err)
+	require.Equal(t, derive(3), addr)
+
+	// Our index was persisted, so a new manager continues where we left
+	// off.
+	manager = NewManager(manager.cfg)
+	addr, err = manager.NextAddr(ctx, wpkh)
+	require.NoError(t, err)
+	require.Equal(t, derive(4), addr)
+
+	_, err = manager.NextAddr(ctx, "wpkh("+toXpub(t, bip84Key)+"/0/0)")
+	require.ErrorIs(t, err, ErrNotRanged)
+}
@@ -0,0 +1,142 @@
+package descriptor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+)
+
+// Querier holds the queries that track how far each descriptor has been
+// derived, along with a lookup of the destination addresses of our loop outs
+// so that derived addresses are never reused.
+type Querier interface {
+	// GetDestDescriptor returns the descriptor provided.
+	GetDestDescriptor(ctx context.Context,
+		descriptor string) (sqlc.DestDescriptor, error)
+
+	// InsertDestDescriptor inserts a descriptor and returns its id.
+	InsertDestDescriptor(ctx context.Context,
+		arg sqlc.InsertDestDescriptorParams) (int32, error)
+
+	// InsertDestDescriptorAddress inserts an address that was derived
+	// from a descriptor.
+	InsertDestDescriptorAddress(ctx context.Context,
+		arg sqlc.InsertDestDescriptorAddressParams) error
+
+	// IsDestAddressUsed returns whether an address was derived from any
+	// of our descriptors before.
+	IsDestAddressUsed(ctx context.Context, address string) (bool, error)
+
+	// IsLoopOutDestAddress returns whether a loop out paid to an address.
+	IsLoopOutDestAddress(ctx context.Context,
+		destAddress string) (bool, error)
+
+	// UpdateDestDescriptorIndex updates the next index of a descriptor.
+	UpdateDestDescriptorIndex(ctx context.Context,
+		arg sqlc.UpdateDestDescriptorIndexParams) error
+}
+
+// BaseDB stores a derived address and advances the index of its descriptor
+// atomically.
+type BaseDB interface {
+	Querier
+
+	// ExecTx allows for executing a function in the context of a database
+	// transaction.
+	ExecTx(ctx context.Context, txOptions loopdb.TxOptions,
+		txBody func(Querier) error) error
+}
+
+// SQLStore manages our descriptors and the addresses derived from them in
+// the database.
+type SQLStore struct {
+	baseDb BaseDB
+}
+
+// A compile-time check that SQLStore implements Store.
+var _ Store = (*SQLStore)(nil)
+
+// NewSQLStore returns a descriptor store that uses the database provided.
+func NewSQLStore(db BaseDB) *SQLStore {
+	return &SQLStore{
+		baseDb: db,
+	}
+}
+
+// NextIndex returns the index that the next address of a descriptor is
+// derived at, which is zero for descriptors that we don't know yet.
+func (s *SQLStore) NextIndex(ctx context.Context, descriptor string) (uint32,
+	error) {
+
+	row, err := s.baseDb.GetDestDescriptor(ctx, descriptor)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return 0, nil
+
+	case err != nil:
+		return 0, err
+	}
+
+	return uint32(row.NextIndex), nil
+}
+
+// IsAddrUsed returns whether an address was derived from any of our
+// descriptors before.
+func (s *SQLStore) IsAddrUsed(ctx context.Context, addr string) (bool,
+	error) {
+
+	return s.baseDb.IsDestAddressUsed(ctx, addr)
+}
+
+// IsSwapAddr returns whether any of our loop outs paid to an address.
+func (s *SQLStore) IsSwapAddr(ctx context.Context, addr string) (bool,
+	error) {
+
+	return s.baseDb.IsLoopOutDestAddress(ctx, addr)
+}
+
+// AddAddr persists an address that was derived from a descriptor, and
+// advances the next index of the descriptor past the address.
+func (s *SQLStore) AddAddr(ctx context.Context, addr *Address) error {
+	return s.baseDb.ExecTx(ctx, loopdb.NewSqlWriteOpts(),
+		func(q Querier) error {
+			row, err := q.GetDestDescriptor(ctx, addr.Descriptor)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				row.ID, err = q.InsertDestDescriptor(
+					ctx, sqlc.InsertDestDescriptorParams{
+						Descriptor: addr.Descriptor,
+					},
+				)
+				if err != nil {
+					return err
+				}
+
+			case err != nil:
+				return err
+			}
+
+			err = q.InsertDestDescriptorAddress(
+				ctx, sqlc.InsertDestDescriptorAddressParams{
+					DescriptorID:    row.ID,
+					DerivationIndex: int64(addr.Index),
+					Address:         addr.Addr.String(),
+					CreatedAt:       addr.Created.UTC(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return q.UpdateDestDescriptorIndex(
+				ctx, sqlc.UpdateDestDescriptorIndexParams{
+					ID:        row.ID,
+					NextIndex: int64(addr.Index) + 1,
+				},
+			)
+		},
+	)
+}
@@ -31,6 +31,14 @@ const (
 	// easyAutoIn is the label used for easy loop in swaps that are
 	// automatically dispatched.
 	easyAutoIn = "easy-autoloop-in"
+
+	// recurringOut is the label used for loop out swaps that are
+	// dispatched by a recurring swap schedule.
+	recurringOut = "recurring-out"
+
+	// recurringIn is the label used for loop in swaps that are dispatched
+	// by a recurring swap schedule.
+	recurringIn = "recurring-in"
 )
 
 var (
@@ -62,6 +70,17 @@ func EasyAutoloopLabel(swapType swap.Type) string {
 	return fmt.Sprintf("%v: %v", Reserved, easyAutoIn)
 }
 
+// RecurringLabel returns a label with the reserved prefix that identifies
+// swaps that are dispatched by a recurring swap schedule depending on the type
+// of swap being executed.
+func RecurringLabel(swapType swap.Type) string {
+	if swapType == swap.TypeOut {
+		return fmt.Sprintf("%v: %v", Reserved, recurringOut)
+	}
+
+	return fmt.Sprintf("%v: %v", Reserved, recurringIn)
+}
+
 // Validate checks that a label is of appropriate length and is not in our list
 // of reserved labels.
 func Validate(label string) error {
@@ -0,0 +1,124 @@
+package liquidity
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/swap"
+)
+
+var (
+	// errZeroAmountRule is returned when an amount rule does not have any
+	// minimum set.
+	errZeroAmountRule = errors.New("amount rule must have a minimum " +
+		"incoming or outgoing amount set")
+
+	// errNegativeAmountRule is returned when an amount rule has a
+	// negative minimum.
+	errNegativeAmountRule = errors.New("amount rule minimums must be >= 0")
+
+	// errAmountRuleCapacity is returned when the minimums of an amount
+	// rule are not less than the capacity they are applied to.
+	errAmountRuleCapacity = errors.New("sum of minimum incoming and " +
+		"outgoing amounts must be less than capacity")
+
+	// errMultipleRuleTypes is returned when a swap rule has both a
+	// threshold and an amount rule set.
+	errMultipleRuleTypes = errors.New("only one of threshold and amount " +
+		"rule may be set")
+
+	// errNoRuleType is returned when a swap rule has neither a threshold
+	// nor an amount rule set.
+	errNoRuleType = errors.New("threshold or amount rule required")
+
+	// errOpportunisticAmountRule is returned when an opportunistic band is
+	// set for an amount rule.
+	errOpportunisticAmountRule = errors.New("opportunistic band only " +
+		"supported for threshold rules")
+)
+
+// AmountRule is a liquidity rule that implements minimum incoming and
+// outgoing liquidity targets expressed as absolute amounts.
+type AmountRule struct {
+	// MinimumIncoming is the amount of incoming liquidity that we do not
+	// want to drop below.
+	MinimumIncoming btcutil.Amount
+
+	// MinimumOutgoing is the amount of outgoing liquidity that we do not
+	// want to drop below.
+	MinimumOutgoing btcutil.Amount
+}
+
+// NewAmountRule returns a new amount rule.
+func NewAmountRule(minIncoming, minOutgoing btcutil.Amount) *AmountRule {
+	return &AmountRule{
+		MinimumIncoming: minIncoming,
+		MinimumOutgoing: minOutgoing,
+	}
+}
+
+// String returns a string representation of a rule.
+func (r *AmountRule) String() string {
+	return fmt.Sprintf("amount rule: minimum incoming: %v, minimum "+
+		"outgoing: %v", r.MinimumIncoming, r.MinimumOutgoing)
+}
+
+// validate validates the parameters that a rule was created with.
+func (r *AmountRule) validate() error {
+	if r.MinimumIncoming < 0 || r.MinimumOutgoing < 0 {
+		return errNegativeAmountRule
+	}
+
+	if r.MinimumIncoming == 0 && r.MinimumOutgoing == 0 {
+		return errZeroAmountRule
+	}
+
+	return nil
+}
+
+// validateCapacity checks that the rule's minimums can be satisfied by the
+// capacity provided.
+func (r *AmountRule) validateCapacity(capacity btcutil.Amount) error {
+	if r.MinimumIncoming+r.MinimumOutgoing >= capacity {
+		return errAmountRuleCapacity
+	}
+
+	return nil
+}
+
+// swapAmount suggests a swap based on the liquidity amounts configured,
+// returning zero if no swap is recommended.
+func (r *AmountRule) swapAmount(channel *balances,
+	restrictions *Restrictions, swapType swap.Type) btcutil.Amount {
+
+	// For loop out swaps we target incoming liquidity while reserving
+	// outgoing liquidity, for loop in swaps we do the reverse.
+	var (
+		targetBalance  = channel.incoming
+		targetGoal     = r.MinimumIncoming
+		reserveBalance = channel.outgoing
+		reserveMinimum = r.MinimumOutgoing
+	)
+
+	if swapType == swap.TypeIn {
+		targetBalance = channel.outgoing
+		targetGoal = r.MinimumOutgoing
+		reserveBalance = channel.incoming
+		reserveMinimum = r.MinimumIncoming
+	}
+
+	// If our minimums can't be met by our current capacity, which may
+	// happen if channels have been closed since the rule was set, we do
+	// not suggest any swaps.
+	if r.validateCapacity(channel.capacity) != nil {
+		return 0
+	}
+
+	amount := calculateSwapAmountSat(
+		targetBalance, reserveBalance, channel.capacity, targetGoal,
+		reserveMinimum,
+	)
+
+	return limitSwapAmount(amount, restrictions)
+}
@@ -0,0 +1,220 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidateAmountRule tests validation of amount rules.
+func TestValidateAmountRule(t *testing.T) {
+	tests := []struct {
+		name string
+		rule *AmountRule
+		err  error
+	}{
+		{
+			name: "values ok",
+			rule: NewAmountRule(1000, 2000),
+		},
+		{
+			name: "incoming only",
+			rule: NewAmountRule(1000, 0),
+		},
+		{
+			name: "no minimums",
+			rule: NewAmountRule(0, 0),
+			err:  errZeroAmountRule,
+		},
+		{
+			name: "negative minimum",
+			rule: NewAmountRule(-1, 1000),
+			err:  errNegativeAmountRule,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.rule.validate()
+			require.Equal(t, testCase.err, err)
+		})
+	}
+}
+
+// TestAmountRuleCapacity tests validation of amount rules against the
+// capacity of the channels and peers they apply to.
+func TestAmountRuleCapacity(t *testing.T) {
+	channels := []lndclient.ChannelInfo{
+		{
+			ChannelID:   chanID1.ToUint64(),
+			PubKeyBytes: peer1,
+			Capacity:    10000,
+		},
+		{
+			ChannelID:   chanID2.ToUint64(),
+			PubKeyBytes: peer2,
+			Capacity:    10000,
+		},
+		{
+			ChannelID:   chanID3.ToUint64(),
+			PubKeyBytes: peer2,
+			Capacity:    10000,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		chanRules map[lnwire.ShortChannelID]*SwapRule
+		peerRules map[route.Vertex]*SwapRule
+		err       error
+	}{
+		{
+			name: "channel rule within capacity",
+			chanRules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: {
+					AmountRule: NewAmountRule(5000, 4999),
+					Type:       swap.TypeOut,
+				},
+			},
+		},
+		{
+			name: "channel rule exceeds capacity",
+			chanRules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: {
+					AmountRule: NewAmountRule(5000, 5000),
+					Type:       swap.TypeOut,
+				},
+			},
+			err: errAmountRuleCapacity,
+		},
+		{
+			name: "peer rule uses total capacity",
+			peerRules: map[route.Vertex]*SwapRule{
+				peer2: {
+					AmountRule: NewAmountRule(15000, 0),
+					Type:       swap.TypeOut,
+				},
+			},
+		},
+		{
+			name: "peer rule exceeds capacity",
+			peerRules: map[route.Vertex]*SwapRule{
+				peer2: {
+					AmountRule: NewAmountRule(15000, 5000),
+					Type:       swap.TypeIn,
+				},
+			},
+			err: errAmountRuleCapacity,
+		},
+		{
+			name: "unknown channel not checked",
+			chanRules: map[lnwire.ShortChannelID]*SwapRule{
+				lnwire.NewShortChanIDFromInt(999): {
+					AmountRule: NewAmountRule(50000, 0),
+					Type:       swap.TypeOut,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			params := defaultParameters
+			params.ChannelRules = testCase.chanRules
+			params.PeerRules = testCase.peerRules
+
+			err := params.validate(
+				defaultConfTarget, channels, testRestrictions,
+			)
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+}
+
+// TestAmountRuleSwapAmount tests calculation of swap amounts for amount
+// rules.
+func TestAmountRuleSwapAmount(t *testing.T) {
+	restrictions := NewRestrictions(1000, 100000)
+
+	tests := []struct {
+		name     string
+		rule     *AmountRule
+		swapType swap.Type
+		balances *balances
+		amount   btcutil.Amount
+	}{
+		{
+			name:     "loop out, incoming ok",
+			rule:     NewAmountRule(2000, 0),
+			swapType: swap.TypeOut,
+			balances: &balances{
+				capacity: 10000,
+				incoming: 3000,
+				outgoing: 7000,
+			},
+			amount: 0,
+		},
+		{
+			// Our midpoint between 2000 incoming and a maximum of
+			// 9000 incoming is 5500, so we swap 4500.
+			name:     "loop out, below incoming",
+			rule:     NewAmountRule(2000, 1000),
+			swapType: swap.TypeOut,
+			balances: &balances{
+				capacity: 10000,
+				incoming: 1000,
+				outgoing: 9000,
+			},
+			amount: 4500,
+		},
+		{
+			name:     "loop in, below outgoing",
+			rule:     NewAmountRule(0, 4000),
+			swapType: swap.TypeIn,
+			balances: &balances{
+				capacity: 10000,
+				incoming: 8000,
+				outgoing: 2000,
+			},
+			amount: 5000,
+		},
+		{
+			name:     "capacity reduced below minimums",
+			rule:     NewAmountRule(20000, 0),
+			swapType: swap.TypeOut,
+			balances: &balances{
+				capacity: 10000,
+				incoming: 1000,
+				outgoing: 9000,
+			},
+			amount: 0,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			amount := testCase.rule.swapAmount(
+				testCase.balances, restrictions,
+				testCase.swapType,
+			)
+			require.Equal(t, testCase.amount, amount)
+		})
+	}
+}
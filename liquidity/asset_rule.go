@@ -0,0 +1,449 @@
+package liquidity
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	clientrpc "github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// errAssetRuleID is returned when rules are set for an asset id that
+	// is not a hex encoded 32 byte asset id.
+	errAssetRuleID = errors.New("asset rules require a 32 byte hex " +
+		"encoded asset id")
+
+	// errAssetRuleType is returned when an asset rule is not a loop out
+	// threshold rule.
+	errAssetRuleType = errors.New("asset rules must be loop out " +
+		"threshold rules")
+
+	// errAssetRuleOptions is returned when an asset rule sets options that
+	// are not supported for asset balances.
+	errAssetRuleOptions = errors.New("asset rules do not support " +
+		"opportunistic bands, budgets or forecasts")
+
+	// errAssetNodeRule is returned when a node rule is set for an asset.
+	errAssetNodeRule = errors.New("node rules cannot be set for assets")
+
+	// errAssetRuleEasyAutoloop is returned when rules are set for an asset
+	// that also has easy autoloop enabled.
+	errAssetRuleEasyAutoloop = errors.New("asset rules cannot be set for " +
+		"an asset with easy autoloop enabled")
+
+	// errAssetRuleApproval is returned when asset rules are set while our
+	// swaps require approval, because queued swaps are dispatched without
+	// their asset.
+	errAssetRuleApproval = errors.New("asset rules cannot be set when " +
+		"swaps require approval")
+)
+
+// AssetRules are the liquidity rules that we apply to the balances that a
+// taproot asset has in our asset channels. The thresholds of these rules are
+// applied to balances measured in units of the asset, and the loop outs that
+// they suggest are paid for with the asset.
+type AssetRules struct {
+	// ChannelRules maps asset channels to the rule that is applied to
+	// their balance of the asset.
+	ChannelRules map[lnwire.ShortChannelID]*SwapRule
+
+	// PeerRules maps peers to the rule that is applied to the aggregate
+	// balance of the asset in our asset channels with them.
+	PeerRules map[route.Vertex]*SwapRule
+}
+
+// newAssetRules returns an empty set of asset rules.
+func newAssetRules() *AssetRules {
+	return &AssetRules{
+		ChannelRules: make(map[lnwire.ShortChannelID]*SwapRule),
+		PeerRules:    make(map[route.Vertex]*SwapRule),
+	}
+}
+
+// validate checks that the rules set for an asset are valid. Like our other
+// rules, an asset's peer rules may not overlap with its channel rules.
+func (a *AssetRules) validate(assetID string,
+	openChans []lndclient.ChannelInfo) error {
+
+	if _, err := hex.DecodeString(assetID); err != nil ||
+		len(assetID) != sha256.Size*2 {
+
+		return errAssetRuleID
+	}
+
+	for _, channel := range openChans {
+		if _, ok := a.PeerRules[channel.PubKeyBytes]; !ok {
+			continue
+		}
+
+		shortID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		if _, ok := a.ChannelRules[shortID]; ok {
+			log.Debugf("Asset: %v rules for peer: %v and its "+
+				"channel: %v can't both be set", assetID,
+				channel.PubKeyBytes, shortID)
+
+			return ErrExclusiveRules
+		}
+	}
+
+	for channel, rule := range a.ChannelRules {
+		if channel.ToUint64() == 0 {
+			return ErrZeroChannelID
+		}
+
+		if err := validateAssetRule(rule); err != nil {
+			return fmt.Errorf("asset: %v channel: %v has invalid "+
+				"rule: %w", assetID, channel.ToUint64(), err)
+		}
+	}
+
+	for peer, rule := range a.PeerRules {
+		if err := validateAssetRule(rule); err != nil {
+			return fmt.Errorf("asset: %v peer: %v has invalid "+
+				"rule: %w", assetID, peer, err)
+		}
+	}
+
+	return nil
+}
+
+// validateAssetRule checks that a rule can be applied to asset balances. Only
+// loop out threshold rules are supported, because assets can only be looped
+// out and our other rule options are expressed in satoshis.
+func validateAssetRule(rule *SwapRule) error {
+	if err := rule.validate(); err != nil {
+		return err
+	}
+
+	if rule.Type != swap.TypeOut || rule.ThresholdRule == nil {
+		return errAssetRuleType
+	}
+
+	if rule.Opportunistic != nil || rule.Budget != nil ||
+		rule.Predictive != nil {
+
+		return errAssetRuleOptions
+	}
+
+	return nil
+}
+
+// cloneAssetRules creates a copy of a set of asset rules.
+func cloneAssetRules(rules *AssetRules) *AssetRules {
+	rulesCopy := newAssetRules()
+	for channel, rule := range rules.ChannelRules {
+		rulesCopy.ChannelRules[channel] = cloneSwapRule(rule)
+	}
+
+	for peer, rule := range rules.PeerRules {
+		rulesCopy.PeerRules[peer] = cloneSwapRule(rule)
+	}
+
+	return rulesCopy
+}
+
+// haveAssetChannelRule returns a boolean indicating whether any of our assets
+// have a rule set for the channel provided.
+func (p Parameters) haveAssetChannelRule(channel lnwire.ShortChannelID) bool {
+	for _, rules := range p.AssetRules {
+		if _, ok := rules.ChannelRules[channel]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// haveAssetPeerRule returns a boolean indicating whether any of our assets
+// have a rule set for the peer provided.
+func (p Parameters) haveAssetPeerRule(peer route.Vertex) bool {
+	for _, rules := range p.AssetRules {
+		if _, ok := rules.PeerRules[peer]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addAssetRule adds a rule that was set over rpc for an asset to our asset
+// rules.
+func (p *Parameters) addAssetRule(rule *clientrpc.LiquidityRule,
+	swapRule *SwapRule) error {
+
+	if p.AssetRules == nil {
+		p.AssetRules = make(map[string]*AssetRules)
+	}
+
+	rules, ok := p.AssetRules[rule.AssetId]
+	if !ok {
+		rules = newAssetRules()
+		p.AssetRules[rule.AssetId] = rules
+	}
+
+	peerRule := rule.Pubkey != nil
+	chanRule := rule.ChannelId != 0
+
+	switch {
+	case rule.Node:
+		return errAssetNodeRule
+
+	case peerRule && chanRule:
+		return fmt.Errorf("cannot set channel: %v and peer: %v "+
+			"fields in rule", rule.ChannelId, rule.Pubkey)
+
+	case peerRule:
+		pubkey, err := route.NewVertexFromBytes(rule.Pubkey)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := rules.PeerRules[pubkey]; ok {
+			return fmt.Errorf("multiple rules set for asset: %v "+
+				"peer: %v", rule.AssetId, pubkey)
+		}
+
+		rules.PeerRules[pubkey] = swapRule
+
+	case chanRule:
+		shortID := lnwire.NewShortChanIDFromInt(rule.ChannelId)
+
+		if _, ok := rules.ChannelRules[shortID]; ok {
+			return fmt.Errorf("multiple rules set for asset: %v "+
+				"channel: %v", rule.AssetId, shortID)
+		}
+
+		rules.ChannelRules[shortID] = swapRule
+
+	default:
+		return errors.New("please set channel id or pubkey for rule")
+	}
+
+	return nil
+}
+
+// suggestAssetSwaps suggests loop outs for our asset rules. Our rules are
+// applied to the balances that each asset has in our asset channels, and the
+// channels and peers that are disqualified are recorded in the suggestions
+// provided. Asset channels that are swapped for have the custom channel data
+// reason that our other rules report for them removed.
+func (m *Manager) suggestAssetSwaps(ctx context.Context, traffic *swapTraffic,
+	channels []lndclient.ChannelInfo, filter *channelFilter,
+	restrictions *Restrictions, events []lndclient.ForwardingEvent,
+	now time.Time, resp *Suggestions) ([]swapSuggestion, error) {
+
+	// We consider our assets in a deterministic order, so that our
+	// suggestions are stable.
+	assetIDs := make([]string, 0, len(m.params.AssetRules))
+	for assetID := range m.params.AssetRules {
+		assetIDs = append(assetIDs, assetID)
+	}
+	sort.Strings(assetIDs)
+
+	var suggestions []swapSuggestion
+
+	// suggest suggests a swap for a rule, returning the reason that the
+	// target was disqualified if we do not suggest a swap.
+	suggest := func(assetID string, balance *balances,
+		rule *SwapRule) (Reason, error) {
+
+		suggestion, err := m.suggestAssetSwap(
+			ctx, traffic, assetID, balance, rule, restrictions,
+		)
+		if err == nil {
+			err = m.params.ROI.check(events, now, suggestion)
+		}
+
+		var reasonErr *reasonError
+		switch {
+		case errors.As(err, &reasonErr):
+			return reasonErr.reason, nil
+
+		case err != nil:
+			return ReasonNone, err
+		}
+
+		suggestions = append(suggestions, suggestion)
+
+		return ReasonNone, nil
+	}
+
+	for _, assetID := range assetIDs {
+		rules := m.params.AssetRules[assetID]
+
+		peerBalances := make(map[route.Vertex]*balances)
+		peerExcluded := make(map[route.Vertex]Reason)
+
+		for _, channel := range channels {
+			assetData := getCustomAssetData(channel, assetID)
+			if assetData == nil {
+				continue
+			}
+
+			channelID := lnwire.NewShortChanIDFromInt(
+				channel.ChannelID,
+			)
+
+			rule, haveChanRule := rules.ChannelRules[channelID]
+			_, havePeerRule := rules.PeerRules[channel.PubKeyBytes]
+			if !haveChanRule && !havePeerRule {
+				continue
+			}
+
+			if reason := filter.check(channel); reason != ReasonNone {
+				if haveChanRule {
+					resp.DisqualifiedChans[channelID] = reason
+				} else {
+					peerExcluded[channel.PubKeyBytes] = reason
+				}
+
+				continue
+			}
+
+			balance := newAssetBalances(channel, assetData)
+
+			if !haveChanRule {
+				peerBalance, ok := peerBalances[balance.pubkey]
+				if !ok {
+					peerBalance = &balances{
+						pubkey: balance.pubkey,
+					}
+					peerBalances[balance.pubkey] = peerBalance
+				}
+
+				peerBalance.addAssetChannel(channel, assetData)
+
+				continue
+			}
+
+			reason, err := suggest(assetID, balance, rule)
+			if err != nil {
+				return nil, err
+			}
+
+			if reason == ReasonNone {
+				delete(resp.DisqualifiedChans, channelID)
+				continue
+			}
+
+			resp.DisqualifiedChans[channelID] = reason
+			if reason == ReasonFailureBackoff {
+				resp.addChanBackoff(channelID,
+					traffic.targetBackoff(
+						rule.Type, balance.pubkey,
+						balance.channels,
+					),
+				)
+			}
+		}
+
+		for peer, reason := range peerExcluded {
+			if _, ok := peerBalances[peer]; !ok {
+				resp.DisqualifiedPeers[peer] = reason
+			}
+		}
+
+		peers := make([]route.Vertex, 0, len(peerBalances))
+		for peer := range peerBalances {
+			peers = append(peers, peer)
+		}
+		sort.Slice(peers, func(i, j int) bool {
+			return bytes.Compare(peers[i][:], peers[j][:]) < 0
+		})
+
+		for _, peer := range peers {
+			balance := peerBalances[peer]
+			rule := rules.PeerRules[peer]
+
+			reason, err := suggest(assetID, balance, rule)
+			if err != nil {
+				return nil, err
+			}
+
+			if reason == ReasonNone {
+				continue
+			}
+
+			resp.DisqualifiedPeers[peer] = reason
+			if reason == ReasonFailureBackoff {
+				resp.addPeerBackoff(peer, traffic.targetBackoff(
+					rule.Type, peer, balance.channels,
+				))
+			}
+		}
+	}
+
+	return suggestions, nil
+}
+
+// suggestAssetSwap checks whether we can currently loop out over the asset
+// balances provided, and creates a loop out that is paid for with the asset if
+// the rule provided requires one. The amount of asset that our rule requires
+// us to swap is priced in satoshis with an rfq from the balances' peer, so that
+// our swap restrictions and fee limits are applied to the swap's value in
+// satoshis.
+func (m *Manager) suggestAssetSwap(ctx context.Context, traffic *swapTraffic,
+	assetID string, balance *balances, rule *SwapRule,
+	restrictions *Restrictions) (swapSuggestion, error) {
+
+	builder := newLoopOutBuilder(m.cfg)
+
+	if err := builder.maySwap(ctx, m.params); err != nil {
+		return nil, err
+	}
+
+	err := builder.inUse(traffic, balance.pubkey, balance.channels)
+	if err != nil {
+		return nil, err
+	}
+
+	// Our balances are measured in units of the asset, so the amount that
+	// our threshold suggests is an asset amount. We only apply our swap
+	// restrictions once we know its value in satoshis.
+	assetAmount := calculateSwapAmount(
+		balance.incoming, balance.outgoing, balance.capacity,
+		uint64(rule.MinimumIncoming), uint64(rule.MinimumOutgoing),
+	)
+	if assetAmount == 0 {
+		return nil, newReasonError(ReasonLiquidityOk)
+	}
+
+	// As with easy asset autoloop, we use the midpoint of our swap
+	// restrictions as the satoshi amount for our price request.
+	priceRequestAmt := (restrictions.Minimum + restrictions.Maximum) / 2
+
+	satAmount, err := m.cfg.GetAssetPrice(
+		ctx, assetID, balance.pubkey[:], uint64(assetAmount),
+		priceRequestAmt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := limitSwapAmount(satAmount, restrictions)
+	if amount == 0 {
+		return nil, newReasonError(ReasonLiquidityOk)
+	}
+
+	log.Debugf("Asset %v rule: loop out of %v assets over channels %v "+
+		"priced at %v, swapping %v", assetID[:8], assetAmount,
+		balance.channels, satAmount, amount)
+
+	return builder.buildSwap(
+		ctx, balance.pubkey, balance.channels, amount, m.params,
+		withAssetSwapInfo(&assetSwapInfo{
+			assetID:    assetID,
+			peerPubkey: balance.pubkey[:],
+		}),
+	)
+}
@@ -0,0 +1,361 @@
+package liquidity
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	clientrpc "github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testAssetID    = [32]byte{1}
+	testAssetIDStr = hex.EncodeToString(testAssetID[:])
+)
+
+// newAssetChannel returns a channel with the peer provided that holds the
+// asset balances provided for our test asset.
+func newAssetChannel(t *testing.T, chanID lnwire.ShortChannelID,
+	peer route.Vertex, local, remote uint64) lndclient.ChannelInfo {
+
+	assetData := rfqmsg.JsonAssetChannel{
+		FundingAssets: []rfqmsg.JsonAssetUtxo{
+			{
+				AssetGenesis: rfqmsg.JsonAssetGenesis{
+					AssetID: testAssetIDStr,
+				},
+			},
+		},
+		LocalBalance:  local,
+		RemoteBalance: remote,
+		Capacity:      local + remote,
+	}
+
+	customData, err := json.Marshal(assetData)
+	require.NoError(t, err)
+
+	return lndclient.ChannelInfo{
+		Active:            true,
+		ChannelID:         chanID.ToUint64(),
+		PubKeyBytes:       peer,
+		LocalBalance:      10000,
+		RemoteBalance:     10000,
+		Capacity:          20000,
+		CustomChannelData: customData,
+	}
+}
+
+// TestAssetRulesValidate tests validation of the rules set for assets.
+func TestAssetRulesValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		assetID  string
+		chanRule *SwapRule
+		peerRule *SwapRule
+		err      error
+	}{
+		{
+			name:     "valid",
+			assetID:  testAssetIDStr,
+			chanRule: chanRule,
+		},
+		{
+			name:     "invalid asset id",
+			assetID:  "asset",
+			chanRule: chanRule,
+			err:      errAssetRuleID,
+		},
+		{
+			name:    "loop in rule",
+			assetID: testAssetIDStr,
+			peerRule: &SwapRule{
+				ThresholdRule: NewThresholdRule(0, 50),
+				Type:          swap.TypeIn,
+			},
+			err: errAssetRuleType,
+		},
+		{
+			name:    "amount rule",
+			assetID: testAssetIDStr,
+			chanRule: &SwapRule{
+				AmountRule: NewAmountRule(1000, 0),
+				Type:       swap.TypeOut,
+			},
+			err: errAssetRuleType,
+		},
+		{
+			name:    "budget",
+			assetID: testAssetIDStr,
+			chanRule: &SwapRule{
+				ThresholdRule: NewThresholdRule(50, 0),
+				Type:          swap.TypeOut,
+				Budget: &TargetBudget{
+					Amount:        1000,
+					RefreshPeriod: time.Hour,
+				},
+			},
+			err: errAssetRuleOptions,
+		},
+		{
+			name:     "channel and peer rule overlap",
+			assetID:  testAssetIDStr,
+			chanRule: chanRule,
+			peerRule: chanRule,
+			err:      ErrExclusiveRules,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			rules := newAssetRules()
+			if testCase.chanRule != nil {
+				rules.ChannelRules[chanID1] = testCase.chanRule
+			}
+
+			if testCase.peerRule != nil {
+				rules.PeerRules[peer1] = testCase.peerRule
+			}
+
+			err := rules.validate(
+				testCase.assetID,
+				[]lndclient.ChannelInfo{channel1},
+			)
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+}
+
+// TestAssetRulesRpc tests conversion of asset rules to and from rpc rules.
+func TestAssetRulesRpc(t *testing.T) {
+	params := defaultParameters
+	params.AssetRules = map[string]*AssetRules{
+		testAssetIDStr: {
+			ChannelRules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			},
+			PeerRules: map[route.Vertex]*SwapRule{
+				peer2: chanRule,
+			},
+		},
+	}
+
+	rpcParams, err := ParametersToRpc(params)
+	require.NoError(t, err)
+	require.Len(t, rpcParams.Rules, 2)
+
+	for _, rule := range rpcParams.Rules {
+		require.Equal(t, testAssetIDStr, rule.AssetId)
+	}
+
+	converted, err := RpcToParameters(rpcParams)
+	require.NoError(t, err)
+	require.Equal(t, params.AssetRules, converted.AssetRules)
+	require.Empty(t, converted.ChannelRules)
+	require.Empty(t, converted.PeerRules)
+
+	// Node rules may not be set for an asset.
+	rpcParams.Rules = []*clientrpc.LiquidityRule{
+		{
+			Type:              clientrpc.LiquidityRuleType_THRESHOLD,
+			IncomingThreshold: 50,
+			Node:              true,
+			AssetId:           testAssetIDStr,
+		},
+	}
+	_, err = RpcToParameters(rpcParams)
+	require.ErrorIs(t, err, errAssetNodeRule)
+}
+
+// TestSuggestAssetSwaps tests that our asset rules are applied to the asset
+// balances of our asset channels, and that the loop outs they suggest are
+// priced and paid for with the asset.
+func TestSuggestAssetSwaps(t *testing.T) {
+	var (
+		// assetChan1 is an asset channel with peer1 that has no
+		// incoming asset liquidity. A 50% incoming threshold requires
+		// us to swap 7500 assets.
+		assetChan1 = newAssetChannel(t, chanID1, peer1, 10000, 0)
+
+		// assetChan2 and assetChan3 are asset channels with peer2 that
+		// have 2000 of 20000 assets incoming in aggregate.
+		assetChan2 = newAssetChannel(t, chanID2, peer2, 8000, 2000)
+		assetChan3 = newAssetChannel(t, chanID3, peer2, 10000, 0)
+
+		rfq = &loop.LoopOutRfq{
+			PrepayRfqId: []byte("prepay"),
+			SwapRfqId:   []byte("swap"),
+		}
+	)
+
+	tests := []struct {
+		name  string
+		rules *AssetRules
+
+		// expectedChans is the set of outgoing channels that we
+		// expect a loop out over, if any.
+		expectedChans loopdb.ChannelSet
+
+		// expectedAmount is the amount that we expect to loop out.
+		expectedAmount btcutil.Amount
+
+		// expectedPeer is the peer that we expect our rfq from.
+		expectedPeer route.Vertex
+
+		disqualifiedChans map[lnwire.ShortChannelID]Reason
+		disqualifiedPeers map[route.Vertex]Reason
+	}{
+		{
+			name: "channel rule",
+			rules: &AssetRules{
+				ChannelRules: map[lnwire.ShortChannelID]*SwapRule{
+					chanID1: chanRule,
+				},
+			},
+			expectedChans:  loopdb.ChannelSet{chanID1.ToUint64()},
+			expectedAmount: 3750,
+			expectedPeer:   peer1,
+			disqualifiedChans: map[lnwire.ShortChannelID]Reason{
+				chanID2: ReasonCustomChannelData,
+				chanID3: ReasonCustomChannelData,
+			},
+			disqualifiedPeers: noPeersDisqualified,
+		},
+		{
+			name: "peer rule",
+			rules: &AssetRules{
+				PeerRules: map[route.Vertex]*SwapRule{
+					peer2: chanRule,
+				},
+			},
+			expectedChans: loopdb.ChannelSet{
+				chanID2.ToUint64(), chanID3.ToUint64(),
+			},
+			expectedAmount: 6500,
+			expectedPeer:   peer2,
+			disqualifiedChans: map[lnwire.ShortChannelID]Reason{
+				chanID1: ReasonCustomChannelData,
+				chanID2: ReasonCustomChannelData,
+				chanID3: ReasonCustomChannelData,
+			},
+			disqualifiedPeers: noPeersDisqualified,
+		},
+		{
+			name: "liquidity ok",
+			rules: &AssetRules{
+				ChannelRules: map[lnwire.ShortChannelID]*SwapRule{
+					chanID2: {
+						ThresholdRule: NewThresholdRule(
+							10, 0,
+						),
+						Type: swap.TypeOut,
+					},
+				},
+			},
+			disqualifiedChans: map[lnwire.ShortChannelID]Reason{
+				chanID1: ReasonCustomChannelData,
+				chanID2: ReasonLiquidityOk,
+				chanID3: ReasonCustomChannelData,
+			},
+			disqualifiedPeers: noPeersDisqualified,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{
+				assetChan1, assetChan2, assetChan3,
+			}
+
+			var quoteReqs []*loop.LoopOutQuoteRequest
+			cfg.LoopOutQuote = func(_ context.Context,
+				req *loop.LoopOutQuoteRequest) (
+				*loop.LoopOutQuote, error) {
+
+				quoteReqs = append(quoteReqs, req)
+
+				quote := *testQuote
+				quote.LoopOutRfq = rfq
+
+				return &quote, nil
+			}
+
+			// Each of our assets is priced at half a satoshi.
+			cfg.GetAssetPrice = func(_ context.Context,
+				assetID string, peer []byte, assetAmt uint64,
+				_ btcutil.Amount) (btcutil.Amount, error) {
+
+				require.Equal(t, testAssetIDStr, assetID)
+
+				return btcutil.Amount(assetAmt / 2), nil
+			}
+
+			params := defaultParameters
+			params.AutoloopBudgetLastRefresh = testBudgetStart
+			params.AssetRules = map[string]*AssetRules{
+				testAssetIDStr: testCase.rules,
+			}
+
+			manager := NewManager(cfg)
+			err := manager.setParameters(context.Background(), params)
+			require.NoError(t, err)
+
+			suggestions, err := manager.SuggestSwaps(
+				context.Background(),
+			)
+			require.NoError(t, err)
+
+			require.Equal(
+				t, testCase.disqualifiedChans,
+				suggestions.DisqualifiedChans,
+			)
+			require.Equal(
+				t, testCase.disqualifiedPeers,
+				suggestions.DisqualifiedPeers,
+			)
+
+			if testCase.expectedChans == nil {
+				require.Empty(t, suggestions.OutSwaps)
+				return
+			}
+
+			require.Len(t, suggestions.OutSwaps, 1)
+			out := suggestions.OutSwaps[0]
+
+			require.Equal(t, testCase.expectedAmount, out.Amount)
+			require.ElementsMatch(
+				t, testCase.expectedChans, out.OutgoingChanSet,
+			)
+			require.Equal(t, testAssetID[:], out.AssetId)
+			require.Equal(t, rfq.SwapRfqId, out.AssetSwapRfqId)
+			require.Equal(t, rfq.PrepayRfqId, out.AssetPrepayRfqId)
+
+			// Our swap should be quoted with an rfq for our asset
+			// from the peer that we swap with.
+			require.Len(t, quoteReqs, 1)
+			require.Equal(
+				t, testAssetID[:],
+				quoteReqs[0].AssetRFQRequest.AssetId,
+			)
+			require.Equal(
+				t, testCase.expectedPeer[:],
+				quoteReqs[0].AssetRFQRequest.AssetEdgeNode,
+			)
+		})
+	}
+}
@@ -1489,6 +1489,151 @@ func TestEasyAutoloop(t *testing.T) {
 	c.stop()
 }
 
+// TestEasyAutoloopIn tests that easy autoloop dispatches loop ins when our
+// total local balance drops below our loop in target, picking the peer with
+// the most remote balance that is not already used by a loop in.
+func TestEasyAutoloopIn(t *testing.T) {
+	defer test.Guard(t)
+
+	var (
+		easyChannel1 = lndclient.ChannelInfo{
+			Active:        true,
+			ChannelID:     chanID1.ToUint64(),
+			PubKeyBytes:   peer1,
+			LocalBalance:  10000,
+			RemoteBalance: 90000,
+			Capacity:      100000,
+		}
+
+		easyChannel2 = lndclient.ChannelInfo{
+			Active:        true,
+			ChannelID:     chanID2.ToUint64(),
+			PubKeyBytes:   peer2,
+			LocalBalance:  5000,
+			RemoteBalance: 40000,
+			Capacity:      45000,
+		}
+
+		channels = []lndclient.ChannelInfo{
+			easyChannel1, easyChannel2,
+		}
+
+		// Our loop in target is below our loop out target, and well
+		// above our current total local balance of 15000.
+		params = Parameters{
+			Autoloop:                  true,
+			AutoFeeBudget:             36000,
+			AutoFeeRefreshPeriod:      time.Hour * 3,
+			AutoloopBudgetLastRefresh: testBudgetStart,
+			MaxAutoInFlight:           2,
+			FailureBackOff:            time.Hour,
+			SweepConfTarget:           10,
+			HtlcConfTarget:            defaultHtlcConfTarget,
+			EasyAutoloop:              true,
+			EasyAutoloopTarget:        150000,
+			EasyAutoloopInTarget:      50000,
+			FeeLimit:                  defaultFeePortion(),
+			FastSwapPublication:       true,
+		}
+
+		amount = btcutil.Amount(35000)
+
+		quote = &loop.LoopInQuote{
+			SwapFee:  1,
+			MinerFee: 1,
+		}
+
+		// inRequest returns the loop in that we expect to be
+		// dispatched for a last hop.
+		inRequest = func(lastHop route.Vertex) *loop.LoopInRequest {
+			return &loop.LoopInRequest{
+				Amount:         amount,
+				MaxSwapFee:     quote.SwapFee,
+				MaxMinerFee:    quote.MinerFee,
+				HtlcConfTarget: defaultHtlcConfTarget,
+				LastHop:        &lastHop,
+				Label:          labels.EasyAutoloopLabel(swap.TypeIn),
+				Initiator:      getInitiator(params),
+			}
+		}
+
+		// step returns the easy autoloop step in which we expect a loop
+		// in over a last hop.
+		step = func(lastHop route.Vertex,
+			existingIn []*loopdb.LoopIn) *easyAutoloopStep {
+
+			return &easyAutoloopStep{
+				minAmt:     1,
+				maxAmt:     50000,
+				existingIn: existingIn,
+				quotesIn: []quoteInRequestResp{
+					{
+						request: &loop.LoopInQuoteRequest{
+							Amount:  amount,
+							LastHop: &lastHop,
+						},
+						quote: quote,
+					},
+				},
+				expectedIn: []loopInRequestResp{
+					{
+						request: inRequest(lastHop),
+						response: &loop.LoopInSwapInfo{
+							SwapHash: lntypes.Hash{1},
+						},
+					},
+				},
+			}
+		}
+	)
+
+	// We expect a loop in over the peer with the most remote balance.
+	c := newAutoloopTestCtx(t, params, channels, testRestrictions)
+	c.start()
+	c.easyautoloop(step(peer1, nil), false)
+	c.stop()
+
+	// If that peer is already used by a loop in, we expect our next best
+	// peer to be used.
+	existing := []*loopdb.LoopIn{
+		existingInFromRequest(inRequest(peer1), testTime, nil),
+	}
+
+	c = newAutoloopTestCtx(t, params, channels, testRestrictions)
+	c.start()
+	c.easyautoloop(step(peer2, existing), false)
+	c.stop()
+
+	// If we need to loop in more than the remote balance of that peer,
+	// and its remote balance is below the minimum swap size, we don't
+	// expect a loop in for the smaller amount that it can take.
+	bigTarget := params
+	bigTarget.EasyAutoloopInTarget = 60000
+
+	c = newAutoloopTestCtx(t, bigTarget, channels, testRestrictions)
+	c.start()
+	c.easyautoloop(&easyAutoloopStep{
+		minAmt:     easyChannel2.RemoteBalance + 1,
+		maxAmt:     50000,
+		existingIn: existing,
+		restrictIn: true,
+	}, false)
+	c.stop()
+
+	// Once our local balance is above our loop in target, and below our
+	// loop out target, we don't expect any action.
+	easyChannel1.LocalBalance += amount
+	easyChannel1.RemoteBalance -= amount
+	channels = []lndclient.ChannelInfo{
+		easyChannel1, easyChannel2,
+	}
+
+	c = newAutoloopTestCtx(t, params, channels, testRestrictions)
+	c.start()
+	c.easyautoloop(&easyAutoloopStep{minAmt: 1, maxAmt: 50000}, true)
+	c.stop()
+}
+
 // existingSwapFromRequest is a helper function which returns the db
 // representation of a loop out request with the event set provided.
 func existingSwapFromRequest(request *loop.OutRequest, initTime time.Time,
@@ -285,7 +285,13 @@ type easyAutoloopStep struct {
 	existingOut []*loopdb.LoopOut
 	existingIn  []*loopdb.LoopIn
 	quotesOut   []quoteRequestResp
+	quotesIn    []quoteInRequestResp
 	expectedOut []loopOutRequestResp
+	expectedIn  []loopInRequestResp
+
+	// restrictIn indicates that loop in restrictions are queried even
+	// though no loop in is quoted.
+	restrictIn bool
 }
 
 // autoloop walks our test context through the process of triggering our
@@ -398,8 +404,26 @@ func (c *autoloopTestCtx) easyautoloop(step *easyAutoloopStep, noop bool) {
 	// If easy autoloop is not meant to be triggered we skip sending the
 	// mock response for restrictions, as this is never called.
 	if !noop {
-		// Send a mocked response from the server with the swap size limits.
-		c.loopOutRestrictions <- NewRestrictions(step.minAmt, step.maxAmt)
+		// Send a mocked response from the server with the swap size
+		// limits for the type of swap that we expect.
+		restrictions := NewRestrictions(step.minAmt, step.maxAmt)
+		if len(step.quotesIn) != 0 || step.restrictIn {
+			c.loopInRestrictions <- restrictions
+		} else {
+			c.loopOutRestrictions <- restrictions
+		}
+	}
+
+	for _, expected := range step.quotesIn {
+		request := <-c.quoteRequestIn
+		require.Equal(
+			c.t, expected.request.Amount, request.Amount,
+		)
+		require.Equal(
+			c.t, expected.request.LastHop, request.LastHop,
+		)
+
+		c.quotesIn <- expected.quote
 	}
 
 	for _, expected := range step.quotesOut {
@@ -426,6 +450,8 @@ func (c *autoloopTestCtx) easyautoloop(step *easyAutoloopStep, noop bool) {
 		}
 	}
 
+	require.True(c.t, c.matchLoopIns(step.expectedIn))
+
 	// Since we're checking if any false-positive swaps were dispatched we
 	// need to give some time to autoloop to possibly dispatch them.
 	select {
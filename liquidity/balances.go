@@ -3,12 +3,15 @@ package liquidity
 import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
 )
 
-// balances summarizes the state of the balances of a channel. Channel reserve,
-// fees and pending htlc balances are not included in these balances.
+// balances summarizes the state of the balances of a channel. Channel reserve
+// and fees are not included in these balances. Pending liquidity is only
+// included if we count pending balances, in which case it is also tracked
+// separately.
 type balances struct {
 	// capacity is the total capacity of the channel.
 	capacity btcutil.Amount
@@ -26,6 +29,14 @@ type balances struct {
 
 	// pubkey is the public key of the peer we have this balances set with.
 	pubkey route.Vertex
+
+	// pendingIncoming is the amount of pending liquidity that is included
+	// in our incoming balance.
+	pendingIncoming btcutil.Amount
+
+	// pendingOutgoing is the amount of pending liquidity that is included
+	// in our outgoing balance.
+	pendingOutgoing btcutil.Amount
 }
 
 // newBalances creates a balances struct from lndclient channel information.
@@ -40,3 +51,50 @@ func newBalances(info lndclient.ChannelInfo) *balances {
 		pubkey: info.PubKeyBytes,
 	}
 }
+
+// addChannel adds the balances of a channel to our balances.
+func (b *balances) addChannel(info lndclient.ChannelInfo) {
+	b.channels = append(
+		b.channels, lnwire.NewShortChanIDFromInt(info.ChannelID),
+	)
+	b.capacity += info.Capacity
+	b.incoming += info.RemoteBalance
+	b.outgoing += info.LocalBalance
+}
+
+// addPending adds pending liquidity to our balances.
+func (b *balances) addPending(incoming, outgoing btcutil.Amount) {
+	b.incoming += incoming
+	b.outgoing += outgoing
+	b.pendingIncoming += incoming
+	b.pendingOutgoing += outgoing
+}
+
+// newAssetBalances creates a balances struct from the balances that an asset
+// has in an asset channel. These balances are measured in units of the asset.
+func newAssetBalances(info lndclient.ChannelInfo,
+	assetData *rfqmsg.JsonAssetChannel) *balances {
+
+	return &balances{
+		capacity: btcutil.Amount(assetData.Capacity),
+		incoming: btcutil.Amount(assetData.RemoteBalance),
+		outgoing: btcutil.Amount(assetData.LocalBalance),
+		channels: []lnwire.ShortChannelID{
+			lnwire.NewShortChanIDFromInt(info.ChannelID),
+		},
+		pubkey: info.PubKeyBytes,
+	}
+}
+
+// addAssetChannel adds the balances that an asset has in an asset channel to
+// our balances.
+func (b *balances) addAssetChannel(info lndclient.ChannelInfo,
+	assetData *rfqmsg.JsonAssetChannel) {
+
+	b.channels = append(
+		b.channels, lnwire.NewShortChanIDFromInt(info.ChannelID),
+	)
+	b.capacity += btcutil.Amount(assetData.Capacity)
+	b.incoming += btcutil.Amount(assetData.RemoteBalance)
+	b.outgoing += btcutil.Amount(assetData.LocalBalance)
+}
@@ -0,0 +1,443 @@
+package liquidity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// noSwapIndex is the swap index that we store for targets that did not have
+// a swap suggested.
+const noSwapIndex = -1
+
+// maxDecisionTime is the upper bound that we use for decision queries that do
+// not have an end time set.
+var maxDecisionTime = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Querier is the interface that contains all the queries generated by sqlc
+// for the autoloop decision, liquidity profile and approval queue tables.
+type Querier interface {
+	// InsertAutoloopDecision stores an autoloop decision and returns its
+	// id.
+	InsertAutoloopDecision(ctx context.Context,
+		arg sqlc.InsertAutoloopDecisionParams) (int32, error)
+
+	// InsertAutoloopDecisionTarget stores a target that was considered by
+	// an autoloop decision.
+	InsertAutoloopDecisionTarget(ctx context.Context,
+		arg sqlc.InsertAutoloopDecisionTargetParams) error
+
+	// SetAutoloopDecisionSwapHash sets the hash of the swap that was
+	// dispatched for a decision's suggestion.
+	SetAutoloopDecisionSwapHash(ctx context.Context,
+		arg sqlc.SetAutoloopDecisionSwapHashParams) error
+
+	// ListAutoloopDecisions lists the decisions that match a query.
+	ListAutoloopDecisions(ctx context.Context,
+		arg sqlc.ListAutoloopDecisionsParams) ([]sqlc.AutoloopDecision,
+		error)
+
+	// GetAutoloopDecisionTargets fetches the targets that were considered
+	// by a decision.
+	GetAutoloopDecisionTargets(ctx context.Context,
+		decisionID int32) ([]sqlc.AutoloopDecisionTarget, error)
+
+	// DeleteAutoloopDecisionTargetsBefore deletes the targets of all
+	// decisions made before the time provided.
+	DeleteAutoloopDecisionTargetsBefore(ctx context.Context,
+		decisionTime time.Time) error
+
+	// DeleteAutoloopDecisionsBefore deletes all decisions made before the
+	// time provided.
+	DeleteAutoloopDecisionsBefore(ctx context.Context,
+		decisionTime time.Time) error
+
+	// InsertLiquidityProfile stores a new liquidity profile and returns
+	// its id.
+	InsertLiquidityProfile(ctx context.Context,
+		arg sqlc.InsertLiquidityProfileParams) (int32, error)
+
+	// GetLiquidityProfile fetches a liquidity profile by name.
+	GetLiquidityProfile(ctx context.Context,
+		name string) (sqlc.LiquidityProfile, error)
+
+	// ListLiquidityProfiles lists all liquidity profiles, ordered by
+	// name.
+	ListLiquidityProfiles(ctx context.Context) ([]sqlc.LiquidityProfile,
+		error)
+
+	// InsertLiquidityProfileVersion stores a new version of a liquidity
+	// profile and returns its id.
+	InsertLiquidityProfileVersion(ctx context.Context,
+		arg sqlc.InsertLiquidityProfileVersionParams) (int32, error)
+
+	// GetLiquidityProfileVersion fetches a version of a liquidity
+	// profile.
+	GetLiquidityProfileVersion(ctx context.Context,
+		arg sqlc.GetLiquidityProfileVersionParams) (
+		sqlc.LiquidityProfileVersion, error)
+
+	// GetLatestLiquidityProfileVersion fetches the latest version of a
+	// liquidity profile.
+	GetLatestLiquidityProfileVersion(ctx context.Context,
+		profileID int32) (sqlc.LiquidityProfileVersion, error)
+
+	// ListLiquidityProfileVersions lists the versions of a liquidity
+	// profile, ordered by version.
+	ListLiquidityProfileVersions(ctx context.Context,
+		profileID int32) ([]sqlc.LiquidityProfileVersion, error)
+
+	// InsertLiquidityProfileSchedule stores an activation window of a
+	// liquidity profile version.
+	InsertLiquidityProfileSchedule(ctx context.Context,
+		arg sqlc.InsertLiquidityProfileScheduleParams) error
+
+	// GetLiquidityProfileSchedules fetches the activation windows of a
+	// liquidity profile version.
+	GetLiquidityProfileSchedules(ctx context.Context,
+		profileVersionID int32) ([]sqlc.LiquidityProfileSchedule, error)
+
+	// InsertLiquidityProfileActivation records the activation of a
+	// liquidity profile.
+	InsertLiquidityProfileActivation(ctx context.Context,
+		arg sqlc.InsertLiquidityProfileActivationParams) error
+
+	// ListLiquidityProfileActivations lists the most recent liquidity
+	// profile activations, ordered from newest to oldest.
+	ListLiquidityProfileActivations(ctx context.Context,
+		limit int32) ([]sqlc.ListLiquidityProfileActivationsRow, error)
+
+	// InsertAutoloopQueueSwap stores a queued swap and returns its id.
+	InsertAutoloopQueueSwap(ctx context.Context,
+		arg sqlc.InsertAutoloopQueueSwapParams) (int32, error)
+
+	// InsertAutoloopQueueChannel stores an outgoing channel of a queued
+	// swap.
+	InsertAutoloopQueueChannel(ctx context.Context,
+		arg sqlc.InsertAutoloopQueueChannelParams) error
+
+	// GetAutoloopQueueSwap fetches a queued swap by id.
+	GetAutoloopQueueSwap(ctx context.Context,
+		id int32) (sqlc.AutoloopQueue, error)
+
+	// GetAutoloopQueueChannels fetches the outgoing channels of a queued
+	// swap.
+	GetAutoloopQueueChannels(ctx context.Context,
+		queueID int32) ([]sqlc.AutoloopQueueChannel, error)
+
+	// ListAutoloopQueueSwaps lists all queued swaps, ordered by id.
+	ListAutoloopQueueSwaps(ctx context.Context) ([]sqlc.AutoloopQueue,
+		error)
+
+	// ListAutoloopQueueSwapsByState lists the queued swaps in a state,
+	// ordered by id.
+	ListAutoloopQueueSwapsByState(ctx context.Context,
+		state int32) ([]sqlc.AutoloopQueue, error)
+
+	// UpdateAutoloopQueueSwap sets the state of a queued swap.
+	UpdateAutoloopQueueSwap(ctx context.Context,
+		arg sqlc.UpdateAutoloopQueueSwapParams) error
+
+	// ExpireAutoloopQueueSwaps marks pending queued swaps that have
+	// reached their expiry as expired.
+	ExpireAutoloopQueueSwaps(ctx context.Context,
+		arg sqlc.ExpireAutoloopQueueSwapsParams) error
+}
+
+// BaseDB is the interface that contains all the queries generated by sqlc
+// for the autoloop decision, liquidity profile and approval queue tables and
+// transaction functionality.
+type BaseDB interface {
+	Querier
+
+	// ExecTx allows for executing a function in the context of a database
+	// transaction.
+	ExecTx(ctx context.Context, txOptions loopdb.TxOptions,
+		txBody func(Querier) error) error
+}
+
+// SQLStore manages the autoloop decision journal, our liquidity profiles and
+// our approval queue in the database.
+type SQLStore struct {
+	baseDb BaseDB
+}
+
+// A compile-time check that SQLStore implements DecisionStore.
+var _ DecisionStore = (*SQLStore)(nil)
+
+// NewSQLStore creates a new SQLStore.
+func NewSQLStore(db BaseDB) *SQLStore {
+	return &SQLStore{
+		baseDb: db,
+	}
+}
+
+// AddDecision persists a decision and returns the id that it was stored
+// under.
+func (s *SQLStore) AddDecision(ctx context.Context,
+	decision *Decision) (uint64, error) {
+
+	args := sqlc.InsertAutoloopDecisionParams{
+		DecisionTime:      decision.Timestamp.UTC(),
+		Budget:            int64(decision.Budget),
+		BudgetLastRefresh: decision.BudgetLastRefresh.UTC(),
+		SpentFees:         int64(decision.SpentFees),
+		PendingFees:       int64(decision.PendingFees),
+	}
+
+	var id int32
+	err := s.baseDb.ExecTx(ctx, loopdb.NewSqlWriteOpts(),
+		func(q Querier) error {
+			var err error
+			id, err = q.InsertAutoloopDecision(ctx, args)
+			if err != nil {
+				return err
+			}
+
+			insertTarget := func(target DecisionTarget,
+				swapIndex int, swapType swap.Type,
+				amount btcutil.Amount) error {
+
+				var peer []byte
+				if target.Peer != (route.Vertex{}) {
+					peer = target.Peer[:]
+				}
+
+				chanID := target.Channel.ToUint64()
+				args := sqlc.InsertAutoloopDecisionTargetParams{
+					DecisionID: id,
+					ChannelID:  int64(chanID),
+					Peer:       peer,
+					Reason:     int32(target.Reason),
+					SwapIndex:  int32(swapIndex),
+					SwapType:   int32(swapType),
+					Amount:     int64(amount),
+				}
+
+				return q.InsertAutoloopDecisionTarget(ctx, args)
+			}
+
+			for i, suggested := range decision.Swaps {
+				// We always store at least one target for a
+				// swap so that the swap itself is recorded.
+				targets := suggested.Targets
+				if len(targets) == 0 {
+					targets = []DecisionTarget{{}}
+				}
+
+				for _, target := range targets {
+					err := insertTarget(
+						target, i, suggested.Type,
+						suggested.Amount,
+					)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			for _, target := range decision.Disqualified {
+				err := insertTarget(target, noSwapIndex, 0, 0)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(id), nil
+}
+
+// SetDecisionSwapHash records the hash of the swap that was dispatched for
+// one of a decision's suggested swaps.
+func (s *SQLStore) SetDecisionSwapHash(ctx context.Context, decisionID uint64,
+	swapIndex int, hash lntypes.Hash) error {
+
+	return s.baseDb.SetAutoloopDecisionSwapHash(
+		ctx, sqlc.SetAutoloopDecisionSwapHashParams{
+			DecisionID: int32(decisionID),
+			SwapIndex:  int32(swapIndex),
+			SwapHash:   hash[:],
+		},
+	)
+}
+
+// ListDecisions returns the set of decisions that match a query, ordered by
+// id.
+func (s *SQLStore) ListDecisions(ctx context.Context,
+	query *DecisionQuery) ([]*Decision, error) {
+
+	if query.IndexOffset > math.MaxInt32 {
+		return nil, nil
+	}
+
+	args := sqlc.ListAutoloopDecisionsParams{
+		IndexOffset:  int32(query.IndexOffset),
+		StartTime:    query.Start.UTC(),
+		EndTime:      maxDecisionTime,
+		MaxDecisions: defaultMaxDecisions,
+	}
+
+	if !query.End.IsZero() {
+		args.EndTime = query.End.UTC()
+	}
+
+	if query.MaxDecisions != 0 && query.MaxDecisions <= math.MaxInt32 {
+		args.MaxDecisions = int32(query.MaxDecisions)
+	}
+
+	if query.Channel != nil {
+		args.ChannelID = sql.NullInt64{
+			Int64: int64(query.Channel.ToUint64()),
+			Valid: true,
+		}
+	}
+
+	if query.Peer != nil {
+		args.Peer = query.Peer[:]
+	}
+
+	var decisions []*Decision
+	err := s.baseDb.ExecTx(ctx, loopdb.NewSqlReadOpts(),
+		func(q Querier) error {
+			rows, err := q.ListAutoloopDecisions(ctx, args)
+			if err != nil {
+				return err
+			}
+
+			for _, row := range rows {
+				targets, err := q.GetAutoloopDecisionTargets(
+					ctx, row.ID,
+				)
+				if err != nil {
+					return err
+				}
+
+				decision, err := sqlDecisionToDecision(
+					row, targets,
+				)
+				if err != nil {
+					return err
+				}
+
+				decisions = append(decisions, decision)
+			}
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return decisions, nil
+}
+
+// PruneDecisions deletes all decisions that were made before the time
+// provided.
+func (s *SQLStore) PruneDecisions(ctx context.Context, before time.Time) error {
+	return s.baseDb.ExecTx(ctx, loopdb.NewSqlWriteOpts(),
+		func(q Querier) error {
+			err := q.DeleteAutoloopDecisionTargetsBefore(
+				ctx, before.UTC(),
+			)
+			if err != nil {
+				return err
+			}
+
+			return q.DeleteAutoloopDecisionsBefore(
+				ctx, before.UTC(),
+			)
+		})
+}
+
+// sqlDecisionToDecision converts a decision and its targets from the database
+// to a decision.
+func sqlDecisionToDecision(row sqlc.AutoloopDecision,
+	targets []sqlc.AutoloopDecisionTarget) (*Decision, error) {
+
+	decision := &Decision{
+		ID:                uint64(row.ID),
+		Timestamp:         row.DecisionTime,
+		Budget:            btcutil.Amount(row.Budget),
+		BudgetLastRefresh: row.BudgetLastRefresh,
+		SpentFees:         btcutil.Amount(row.SpentFees),
+		PendingFees:       btcutil.Amount(row.PendingFees),
+	}
+
+	for _, target := range targets {
+		decisionTarget := DecisionTarget{
+			Channel: lnwire.NewShortChanIDFromInt(
+				uint64(target.ChannelID),
+			),
+			Reason: Reason(target.Reason),
+		}
+
+		if target.Peer != nil {
+			peer, err := route.NewVertexFromBytes(target.Peer)
+			if err != nil {
+				return nil, err
+			}
+
+			decisionTarget.Peer = peer
+		}
+
+		if target.SwapIndex == noSwapIndex {
+			decision.Disqualified = append(
+				decision.Disqualified, decisionTarget,
+			)
+
+			continue
+		}
+
+		// Our targets are stored in order, so each target either
+		// belongs to our last swap or starts the next one.
+		swapIndex := int(target.SwapIndex)
+		switch {
+		case swapIndex == len(decision.Swaps)-1:
+
+		case swapIndex == len(decision.Swaps):
+			decision.Swaps = append(decision.Swaps, &DecisionSwap{
+				Type:   swap.Type(target.SwapType),
+				Amount: btcutil.Amount(target.Amount),
+			})
+
+		default:
+			return nil, fmt.Errorf("decision %v: unexpected swap "+
+				"index %v", row.ID, swapIndex)
+		}
+
+		// Swaps without targets are stored with a single empty
+		// target, which we do not include in our swap's targets.
+		decisionSwap := decision.Swaps[swapIndex]
+		if decisionTarget != (DecisionTarget{}) {
+			decisionSwap.Targets = append(
+				decisionSwap.Targets, decisionTarget,
+			)
+		}
+
+		if target.SwapHash != nil {
+			hash, err := lntypes.MakeHash(target.SwapHash)
+			if err != nil {
+				return nil, err
+			}
+
+			decisionSwap.SwapHash = &hash
+		}
+	}
+
+	return decision, nil
+}
@@ -0,0 +1,156 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDecisionStore tests storing, querying and pruning autoloop decisions.
+func TestDecisionStore(t *testing.T) {
+	ctx := context.Background()
+	testDb := loopdb.NewTestDB(t)
+	defer testDb.Close()
+
+	store := NewSQLStore(loopdb.NewTypedStore[Querier](testDb))
+
+	var (
+		t1 = testTime.Add(time.Hour)
+		t2 = testTime.Add(time.Hour * 2)
+	)
+
+	// Our first decision suggests a loop out over two channels and a loop
+	// in for a peer, and disqualifies a third channel.
+	decision1 := &Decision{
+		Timestamp:         testTime,
+		Budget:            10000,
+		BudgetLastRefresh: testTime.Add(time.Hour * -24),
+		SpentFees:         100,
+		PendingFees:       200,
+		Swaps: []*DecisionSwap{
+			{
+				Type:   swap.TypeOut,
+				Amount: 5000,
+				Targets: []DecisionTarget{
+					{Channel: chanID1, Peer: peer1},
+					{Channel: chanID2, Peer: peer1},
+				},
+			},
+			{
+				Type:   swap.TypeIn,
+				Amount: 6000,
+				Targets: []DecisionTarget{
+					{Peer: peer2},
+				},
+			},
+		},
+		Disqualified: []DecisionTarget{
+			{
+				Channel: chanID3,
+				Reason:  ReasonLiquidityOk,
+			},
+		},
+	}
+
+	// Our second decision disqualifies the peer of our first decision's
+	// loop in.
+	decision2 := &Decision{
+		Timestamp:         t1,
+		Budget:            10000,
+		BudgetLastRefresh: testTime.Add(time.Hour * -24),
+		Disqualified: []DecisionTarget{
+			{
+				Peer:   peer2,
+				Reason: ReasonBudgetElapsed,
+			},
+		},
+	}
+
+	// Our third decision does not consider any targets.
+	decision3 := &Decision{
+		Timestamp:         t2,
+		Budget:            10000,
+		BudgetLastRefresh: testTime.Add(time.Hour * -24),
+	}
+
+	for _, decision := range []*Decision{decision1, decision2, decision3} {
+		id, err := store.AddDecision(ctx, decision)
+		require.NoError(t, err)
+
+		decision.ID = id
+	}
+
+	// Record the swap dispatched for our first decision's loop in.
+	hash := lntypes.Hash{1, 2, 3}
+	err := store.SetDecisionSwapHash(ctx, decision1.ID, 1, hash)
+	require.NoError(t, err)
+	decision1.Swaps[1].SwapHash = &hash
+
+	// requireDecisions queries our store and asserts that the decisions
+	// returned match our expected set.
+	requireDecisions := func(query *DecisionQuery, expected ...*Decision) {
+		t.Helper()
+
+		decisions, err := store.ListDecisions(ctx, query)
+		require.NoError(t, err)
+		require.Len(t, decisions, len(expected))
+
+		for i, decision := range decisions {
+			require.Equal(t, expected[i].ID, decision.ID)
+			require.True(
+				t, expected[i].Timestamp.Equal(
+					decision.Timestamp,
+				),
+			)
+			require.True(
+				t, expected[i].BudgetLastRefresh.Equal(
+					decision.BudgetLastRefresh,
+				),
+			)
+			require.Equal(t, expected[i].Budget, decision.Budget)
+			require.Equal(
+				t, expected[i].SpentFees, decision.SpentFees,
+			)
+			require.Equal(
+				t, expected[i].PendingFees,
+				decision.PendingFees,
+			)
+			require.Equal(t, expected[i].Swaps, decision.Swaps)
+			require.Equal(
+				t, expected[i].Disqualified,
+				decision.Disqualified,
+			)
+		}
+	}
+
+	requireDecisions(&DecisionQuery{}, decision1, decision2, decision3)
+
+	// Paginate through our decisions.
+	requireDecisions(&DecisionQuery{MaxDecisions: 2}, decision1, decision2)
+	requireDecisions(
+		&DecisionQuery{IndexOffset: decision2.ID, MaxDecisions: 2},
+		decision3,
+	)
+
+	// Query by channel, including channels that were disqualified.
+	requireDecisions(&DecisionQuery{Channel: &chanID2}, decision1)
+	requireDecisions(&DecisionQuery{Channel: &chanID3}, decision1)
+
+	// Query by peer, which matches both suggested and disqualified
+	// targets.
+	requireDecisions(&DecisionQuery{Peer: &peer2}, decision1, decision2)
+
+	// Query by time range, where our end time is exclusive.
+	requireDecisions(
+		&DecisionQuery{Start: t1, End: t2}, decision2,
+	)
+
+	// Prune all decisions before our last decision.
+	require.NoError(t, store.PruneDecisions(ctx, t2))
+	requireDecisions(&DecisionQuery{}, decision3)
+}
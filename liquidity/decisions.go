@@ -0,0 +1,325 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// DefaultDecisionRetention is the default period that we keep autoloop
+	// decisions in our journal for.
+	DefaultDecisionRetention = time.Hour * 24 * 30
+
+	// defaultMaxDecisions is the number of decisions that we return when
+	// a query does not specify a maximum.
+	defaultMaxDecisions = 100
+)
+
+// ErrNoDecisionStore is returned when the autoloop decision journal is
+// queried, but no store is configured.
+var ErrNoDecisionStore = errors.New("autoloop decision journal not enabled")
+
+// DecisionStore is the interface required to persist a journal of the
+// decisions made by autoloop.
+type DecisionStore interface {
+	// AddDecision persists a decision and returns the id that it was
+	// stored under.
+	AddDecision(ctx context.Context, decision *Decision) (uint64, error)
+
+	// SetDecisionSwapHash records the hash of the swap that was dispatched
+	// for one of a decision's suggested swaps.
+	SetDecisionSwapHash(ctx context.Context, decisionID uint64,
+		swapIndex int, hash lntypes.Hash) error
+
+	// ListDecisions returns the set of decisions that match a query.
+	ListDecisions(ctx context.Context, query *DecisionQuery) ([]*Decision,
+		error)
+
+	// PruneDecisions deletes all decisions that were made before the time
+	// provided.
+	PruneDecisions(ctx context.Context, before time.Time) error
+}
+
+// Decision is a record of a single autoloop run, containing the swaps that
+// were suggested, the targets that were disqualified and the state of our
+// budget at the time.
+type Decision struct {
+	// ID is the unique identifier that the decision is stored under. This
+	// value is set by the decision store.
+	ID uint64
+
+	// Timestamp is the time at which autoloop ran.
+	Timestamp time.Time
+
+	// Budget is the total autoloop fee budget.
+	Budget btcutil.Amount
+
+	// BudgetLastRefresh is the start of the budget period that the
+	// decision was made in.
+	BudgetLastRefresh time.Time
+
+	// SpentFees is the amount of fees that completed autoloop swaps have
+	// spent in the current budget period.
+	SpentFees btcutil.Amount
+
+	// PendingFees is the worst-case amount of fees that in flight autoloop
+	// swaps may spend.
+	PendingFees btcutil.Amount
+
+	// Swaps is the set of swaps that autoloop suggested.
+	Swaps []*DecisionSwap
+
+	// Disqualified is the set of targets that autoloop did not suggest
+	// swaps for, along with the reason that they were disqualified.
+	Disqualified []DecisionTarget
+}
+
+// DecisionSwap describes a swap that was suggested by an autoloop decision.
+type DecisionSwap struct {
+	// Type is the type of swap that was suggested.
+	Type swap.Type
+
+	// Amount is the amount of the suggested swap.
+	Amount btcutil.Amount
+
+	// Targets is the set of channels or peers that the swap was suggested
+	// for. Loop out targets are the swap's outgoing channels, loop in
+	// targets are the swap's last hop.
+	Targets []DecisionTarget
+
+	// SwapHash is the hash of the swap that was dispatched for the
+	// suggestion, if any. If a loop out was retried with a smaller
+	// amount, this is the hash of the last attempt.
+	SwapHash *lntypes.Hash
+}
+
+// DecisionTarget is a channel or peer that was considered by an autoloop
+// decision.
+type DecisionTarget struct {
+	// Channel is the channel that was considered. This value is zero for
+	// peer-level targets.
+	Channel lnwire.ShortChannelID
+
+	// Peer is the peer that was considered, or the peer of the channel
+	// that was considered. This value is zero if the channel's peer is not
+	// known.
+	Peer route.Vertex
+
+	// Reason is the reason that the target was disqualified, or
+	// ReasonNone if a swap was suggested for the target.
+	Reason Reason
+}
+
+// DecisionQuery describes the set of decisions to return from the journal.
+type DecisionQuery struct {
+	// Channel restricts our query to decisions that considered this
+	// channel, if set.
+	Channel *lnwire.ShortChannelID
+
+	// Peer restricts our query to decisions that considered this peer, or
+	// one of its channels, if set.
+	Peer *route.Vertex
+
+	// Start is the earliest decision time to include. If this value is
+	// zero, there is no lower bound.
+	Start time.Time
+
+	// End is the time before which decisions must have been made to be
+	// included. If this value is zero, there is no upper bound.
+	End time.Time
+
+	// IndexOffset is the id of the last decision that was returned by a
+	// previous query. Only decisions with larger ids are returned.
+	IndexOffset uint64
+
+	// MaxDecisions is the maximum number of decisions to return. If this
+	// value is zero, a default of 100 is used.
+	MaxDecisions uint32
+}
+
+// newDecision creates a journal entry for a set of suggestions.
+func newDecision(now time.Time, params Parameters, suggestions *Suggestions,
+	summary *existingAutoLoopSummary,
+	channelPeers map[lnwire.ShortChannelID]route.Vertex) *Decision {
+
+	decision := &Decision{
+		Timestamp:         now,
+		Budget:            params.AutoFeeBudget,
+		BudgetLastRefresh: params.AutoloopBudgetLastRefresh,
+	}
+
+	if summary != nil {
+		decision.SpentFees = summary.spentFees
+		decision.PendingFees = summary.pendingFees
+	}
+
+	chanTarget := func(channel lnwire.ShortChannelID,
+		reason Reason) DecisionTarget {
+
+		return DecisionTarget{
+			Channel: channel,
+			Peer:    channelPeers[channel],
+			Reason:  reason,
+		}
+	}
+
+	for _, out := range suggestions.OutSwaps {
+		decisionSwap := &DecisionSwap{
+			Type:   swap.TypeOut,
+			Amount: out.Amount,
+		}
+
+		for _, channel := range out.OutgoingChanSet {
+			decisionSwap.Targets = append(
+				decisionSwap.Targets, chanTarget(
+					lnwire.NewShortChanIDFromInt(channel),
+					ReasonNone,
+				),
+			)
+		}
+
+		decision.Swaps = append(decision.Swaps, decisionSwap)
+	}
+
+	for _, in := range suggestions.InSwaps {
+		decisionSwap := &DecisionSwap{
+			Type:   swap.TypeIn,
+			Amount: in.Amount,
+		}
+
+		if in.LastHop != nil {
+			decisionSwap.Targets = []DecisionTarget{{
+				Peer: *in.LastHop,
+			}}
+		}
+
+		decision.Swaps = append(decision.Swaps, decisionSwap)
+	}
+
+	for _, in := range suggestions.StaticInSwaps {
+		decisionSwap := &DecisionSwap{
+			Type:   swap.TypeIn,
+			Amount: in.Amount,
+		}
+
+		if in.LastHop != nil {
+			decisionSwap.Targets = []DecisionTarget{{
+				Peer: *in.LastHop,
+			}}
+		}
+
+		decision.Swaps = append(decision.Swaps, decisionSwap)
+	}
+
+	for channel, reason := range suggestions.DisqualifiedChans {
+		decision.Disqualified = append(
+			decision.Disqualified, chanTarget(channel, reason),
+		)
+	}
+
+	for peer, reason := range suggestions.DisqualifiedPeers {
+		decision.Disqualified = append(
+			decision.Disqualified, DecisionTarget{
+				Peer:   peer,
+				Reason: reason,
+			},
+		)
+	}
+
+	// Sort our disqualified targets so that the order in which they are
+	// stored is deterministic.
+	sort.Slice(decision.Disqualified, func(i, j int) bool {
+		a, b := decision.Disqualified[i], decision.Disqualified[j]
+		if a.Channel != b.Channel {
+			return a.Channel.ToUint64() < b.Channel.ToUint64()
+		}
+
+		return string(a.Peer[:]) < string(b.Peer[:])
+	})
+
+	return decision
+}
+
+// recordDecision writes a journal entry for a set of suggestions, pruning any
+// entries that have passed our retention period. It returns the id of the
+// decision and a boolean indicating whether it was recorded. Failures are
+// logged rather than returned, so that our journal does not interfere with
+// dispatching swaps.
+func (m *Manager) recordDecision(ctx context.Context, suggestions *Suggestions,
+	summary *existingAutoLoopSummary) (uint64, bool) {
+
+	if m.cfg.DecisionStore == nil {
+		return 0, false
+	}
+
+	channels, err := m.cfg.Lnd.Client.ListChannels(ctx, false, false)
+	if err != nil {
+		log.Errorf("could not list channels for autoloop decision: %v",
+			err)
+
+		return 0, false
+	}
+
+	channelPeers := make(map[lnwire.ShortChannelID]route.Vertex)
+	for _, channel := range channels {
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		channelPeers[chanID] = channel.PubKeyBytes
+	}
+
+	now := m.cfg.Clock.Now()
+	decision := newDecision(
+		now, m.params, suggestions, summary, channelPeers,
+	)
+
+	id, err := m.cfg.DecisionStore.AddDecision(ctx, decision)
+	if err != nil {
+		log.Errorf("could not record autoloop decision: %v", err)
+		return 0, false
+	}
+
+	if m.cfg.DecisionRetention > 0 {
+		err := m.cfg.DecisionStore.PruneDecisions(
+			ctx, now.Add(-m.cfg.DecisionRetention),
+		)
+		if err != nil {
+			log.Errorf("could not prune autoloop decisions: %v",
+				err)
+		}
+	}
+
+	return id, true
+}
+
+// recordDecisionSwap records the hash of a swap that was dispatched for one
+// of a decision's suggestions.
+func (m *Manager) recordDecisionSwap(ctx context.Context, decisionID uint64,
+	swapIndex int, hash lntypes.Hash) {
+
+	err := m.cfg.DecisionStore.SetDecisionSwapHash(
+		ctx, decisionID, swapIndex, hash,
+	)
+	if err != nil {
+		log.Errorf("could not record swap %v for autoloop decision "+
+			"%v: %v", hash, decisionID, err)
+	}
+}
+
+// ListDecisions returns the autoloop decisions in our journal that match the
+// query provided.
+func (m *Manager) ListDecisions(ctx context.Context,
+	query *DecisionQuery) ([]*Decision, error) {
+
+	if m.cfg.DecisionStore == nil {
+		return nil, ErrNoDecisionStore
+	}
+
+	return m.cfg.DecisionStore.ListDecisions(ctx, query)
+}
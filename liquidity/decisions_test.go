@@ -0,0 +1,89 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewDecision tests creation of a journal entry from a set of
+// suggestions.
+func TestNewDecision(t *testing.T) {
+	params := defaultParameters
+	params.AutoFeeBudget = 20000
+	params.AutoloopBudgetLastRefresh = testTime.Add(time.Hour * -1)
+
+	suggestions := &Suggestions{
+		OutSwaps: []loop.OutRequest{
+			{
+				Amount: 5000,
+				OutgoingChanSet: loopdb.ChannelSet{
+					chanID1.ToUint64(), chanID2.ToUint64(),
+				},
+			},
+		},
+		InSwaps: []loop.LoopInRequest{
+			{
+				Amount:  6000,
+				LastHop: &peer2,
+			},
+		},
+		DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+			chanID3: ReasonLiquidityOk,
+		},
+		DisqualifiedPeers: map[route.Vertex]Reason{
+			peer1: ReasonInFlight,
+		},
+	}
+
+	summary := &existingAutoLoopSummary{
+		spentFees:   100,
+		pendingFees: 200,
+	}
+
+	channelPeers := map[lnwire.ShortChannelID]route.Vertex{
+		chanID1: peer1,
+		chanID2: peer1,
+	}
+
+	decision := newDecision(
+		testTime, params, suggestions, summary, channelPeers,
+	)
+
+	require.Equal(t, &Decision{
+		Timestamp:         testTime,
+		Budget:            20000,
+		BudgetLastRefresh: testTime.Add(time.Hour * -1),
+		SpentFees:         100,
+		PendingFees:       200,
+		Swaps: []*DecisionSwap{
+			{
+				Type:   swap.TypeOut,
+				Amount: 5000,
+				Targets: []DecisionTarget{
+					{Channel: chanID1, Peer: peer1},
+					{Channel: chanID2, Peer: peer1},
+				},
+			},
+			{
+				Type:   swap.TypeIn,
+				Amount: 6000,
+				Targets: []DecisionTarget{
+					{Peer: peer2},
+				},
+			},
+		},
+		// Our peer-level target sorts before our channel, and we do
+		// not know the peer of our disqualified channel.
+		Disqualified: []DecisionTarget{
+			{Peer: peer1, Reason: ReasonInFlight},
+			{Channel: chanID3, Reason: ReasonLiquidityOk},
+		},
+	}, decision)
+}
@@ -0,0 +1,418 @@
+package liquidity
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/lightninglabs/lndclient"
+	clientrpc "github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrNoDocumentParams is returned when a document that we import does
+	// not contain any parameters.
+	ErrNoDocumentParams = errors.New("liquidity document parameters " +
+		"required")
+
+	// ErrDocumentParamsRules is returned when a document that we import
+	// sets rules in its parameters rather than in its rules.
+	ErrDocumentParamsRules = errors.New("liquidity document rules must " +
+		"be set in the document's rules, not its parameters")
+
+	// ErrNoDocumentRule is returned when a document rule does not contain
+	// a rule.
+	ErrNoDocumentRule = errors.New("liquidity document rule required")
+
+	// ErrDocumentRuleTarget is returned when a document rule sets the
+	// target of its rule in the rule itself.
+	ErrDocumentRuleTarget = errors.New("liquidity document rule target " +
+		"must be set in the document rule, not its rule")
+
+	// ErrNoDocumentRuleTarget is returned when a document rule does not
+	// set a channel, peer or node target.
+	ErrNoDocumentRuleTarget = errors.New("liquidity document rule " +
+		"requires a channel, peer or node target")
+
+	// ErrDocumentNodeTarget is returned when a document rule for our node
+	// sets a channel or peer target as well.
+	ErrDocumentNodeTarget = errors.New("liquidity document node rule " +
+		"cannot set a channel or peer")
+
+	// ErrDocumentAllChannels is returned when a document rule applies to
+	// all of a peer's channels without setting only a peer.
+	ErrDocumentAllChannels = errors.New("liquidity document rule for " +
+		"all channels requires a peer and no channel")
+)
+
+// ExportParams returns our current parameters as a document that can be
+// imported on other nodes. Channel rules are exported with the pubkey of
+// their peer, so that the channel's peer is checked if the document is
+// imported. Budget refresh times are specific to our node, so they are not
+// exported.
+func (m *Manager) ExportParams(
+	ctx context.Context) (*clientrpc.LiquidityParamsDocument, error) {
+
+	req, err := ParametersToRpc(m.GetParameters())
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := m.cfg.Lnd.Client.ListChannels(ctx, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	chanPeers := make(map[uint64]route.Vertex, len(channels))
+	for _, channel := range channels {
+		chanPeers[channel.ChannelID] = channel.PubKeyBytes
+	}
+
+	rules := req.Rules
+	sortRules(rules)
+
+	req.Rules = nil
+	req.AutoloopBudgetLastRefresh = 0
+
+	doc := &clientrpc.LiquidityParamsDocument{
+		Parameters: req,
+	}
+	for _, rule := range rules {
+		docRule := &clientrpc.LiquidityDocumentRule{
+			ChannelId: rule.ChannelId,
+			Node:      rule.Node,
+			Rule:      rule,
+		}
+
+		switch {
+		case rule.Pubkey != nil:
+			docRule.Peer = hex.EncodeToString(rule.Pubkey)
+
+		case rule.ChannelId != 0:
+			if peer, ok := chanPeers[rule.ChannelId]; ok {
+				docRule.Peer = peer.String()
+			}
+		}
+
+		rule.ChannelId = 0
+		rule.Pubkey = nil
+		rule.Node = false
+		rule.BudgetLastRefresh = 0
+		rule.BudgetRemainingSat = 0
+
+		doc.Rules = append(doc.Rules, docRule)
+	}
+
+	return doc, nil
+}
+
+// ImportParams validates the parameters of a document against our current
+// swap restrictions and channels, and returns the parameters that it changes.
+// If this is not a dry run, the document's parameters are then set. The
+// budget state of our current parameters is carried over, so that importing
+// a document does not reset our budgets.
+func (m *Manager) ImportParams(ctx context.Context,
+	doc *clientrpc.LiquidityParamsDocument,
+	dryRun bool) ([]ProfileChange, error) {
+
+	req, err := m.documentToRpc(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := RpcToParameters(req)
+	if err != nil {
+		return nil, err
+	}
+
+	current := m.GetParameters()
+	params.carryBudgetState(current, m.cfg.Clock.Now())
+
+	if err := m.validateParameters(ctx, *params); err != nil {
+		return nil, err
+	}
+
+	currentReq, err := ParametersToRpc(current)
+	if err != nil {
+		return nil, err
+	}
+	sortRules(currentReq.Rules)
+
+	req, err = ParametersToRpc(*params)
+	if err != nil {
+		return nil, err
+	}
+	sortRules(req.Rules)
+
+	changes, err := diffProfileVersions(
+		&ProfileVersion{Params: currentReq}, &ProfileVersion{Params: req},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		return changes, nil
+	}
+
+	if err := m.SetParameters(ctx, req); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Imported liquidity parameters, %v changes", len(changes))
+
+	return changes, nil
+}
+
+// documentToRpc converts a document to a set of rpc parameters, resolving
+// the targets of its rules against our current channels.
+func (m *Manager) documentToRpc(ctx context.Context,
+	doc *clientrpc.LiquidityParamsDocument) (*clientrpc.LiquidityParameters,
+	error) {
+
+	if doc == nil || doc.Parameters == nil {
+		return nil, ErrNoDocumentParams
+	}
+
+	if len(doc.Parameters.Rules) != 0 {
+		return nil, ErrDocumentParamsRules
+	}
+
+	req, ok := proto.Clone(doc.Parameters).(*clientrpc.LiquidityParameters)
+	if !ok {
+		return nil, fmt.Errorf("unexpected document params type: %T",
+			doc.Parameters)
+	}
+
+	channels, err := m.cfg.Lnd.Client.ListChannels(ctx, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := &peerResolver{
+		lnd:      m.cfg.Lnd.Client,
+		channels: channels,
+	}
+	for _, docRule := range doc.Rules {
+		rules, err := resolver.resolveRule(ctx, docRule)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Rules = append(req.Rules, rules...)
+	}
+
+	return req, nil
+}
+
+// peerResolver resolves the targets of document rules against our channels.
+type peerResolver struct {
+	lnd      lndclient.LightningClient
+	channels []lndclient.ChannelInfo
+
+	// aliases maps the aliases of our channel peers to their pubkeys. It
+	// is only populated once a rule is keyed by alias.
+	aliases map[string][]route.Vertex
+}
+
+// resolveRule returns the rpc rules that a document rule sets. A rule for all
+// of a peer's channels is set as a channel rule for each of them.
+func (p *peerResolver) resolveRule(ctx context.Context,
+	docRule *clientrpc.LiquidityDocumentRule) ([]*clientrpc.LiquidityRule,
+	error) {
+
+	rule := docRule.Rule
+	if rule == nil {
+		return nil, ErrNoDocumentRule
+	}
+
+	if rule.ChannelId != 0 || rule.Pubkey != nil || rule.Node {
+		return nil, ErrDocumentRuleTarget
+	}
+
+	newRule := func(channelID uint64, peer []byte,
+		node bool) *clientrpc.LiquidityRule {
+
+		rpcRule, _ := proto.Clone(rule).(*clientrpc.LiquidityRule)
+		rpcRule.ChannelId = channelID
+		rpcRule.Pubkey = peer
+		rpcRule.Node = node
+
+		return rpcRule
+	}
+
+	if docRule.Node {
+		if docRule.Peer != "" || docRule.ChannelId != 0 ||
+			docRule.AllChannels {
+
+			return nil, ErrDocumentNodeTarget
+		}
+
+		return []*clientrpc.LiquidityRule{
+			newRule(0, nil, true),
+		}, nil
+	}
+
+	if docRule.AllChannels &&
+		(docRule.Peer == "" || docRule.ChannelId != 0) {
+
+		return nil, ErrDocumentAllChannels
+	}
+
+	if docRule.Peer == "" {
+		if docRule.ChannelId == 0 {
+			return nil, ErrNoDocumentRuleTarget
+		}
+
+		return []*clientrpc.LiquidityRule{
+			newRule(docRule.ChannelId, nil, false),
+		}, nil
+	}
+
+	peer, err := p.resolvePeer(ctx, docRule.Peer)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case docRule.AllChannels:
+		var rules []*clientrpc.LiquidityRule
+		for _, channel := range p.channels {
+			if channel.PubKeyBytes != peer {
+				continue
+			}
+
+			rules = append(
+				rules, newRule(channel.ChannelID, nil, false),
+			)
+		}
+
+		if len(rules) == 0 {
+			return nil, fmt.Errorf("no channels with peer: %v",
+				docRule.Peer)
+		}
+
+		return rules, nil
+
+	case docRule.ChannelId != 0:
+		for _, channel := range p.channels {
+			if channel.ChannelID != docRule.ChannelId {
+				continue
+			}
+
+			if channel.PubKeyBytes != peer {
+				return nil, fmt.Errorf("channel: %v does not "+
+					"belong to peer: %v", docRule.ChannelId,
+					docRule.Peer)
+			}
+
+			return []*clientrpc.LiquidityRule{
+				newRule(docRule.ChannelId, nil, false),
+			}, nil
+		}
+
+		return nil, fmt.Errorf("unknown channel: %v", docRule.ChannelId)
+
+	default:
+		return []*clientrpc.LiquidityRule{
+			newRule(0, peer[:], false),
+		}, nil
+	}
+}
+
+// resolvePeer returns the pubkey of a peer that is identified by a hex
+// encoded pubkey or by the alias of one of our channel peers.
+func (p *peerResolver) resolvePeer(ctx context.Context,
+	peer string) (route.Vertex, error) {
+
+	if pubkey, err := route.NewVertexFromStr(peer); err == nil {
+		return pubkey, nil
+	}
+
+	if p.aliases == nil {
+		if err := p.loadAliases(ctx); err != nil {
+			return route.Vertex{}, err
+		}
+	}
+
+	pubkeys := p.aliases[peer]
+	switch len(pubkeys) {
+	case 0:
+		return route.Vertex{}, fmt.Errorf("no channel peer with "+
+			"pubkey or alias: %v", peer)
+
+	case 1:
+		return pubkeys[0], nil
+
+	default:
+		return route.Vertex{}, fmt.Errorf("alias: %v matches "+
+			"multiple channel peers, please use a pubkey", peer)
+	}
+}
+
+// loadAliases looks up the aliases of our channel peers.
+func (p *peerResolver) loadAliases(ctx context.Context) error {
+	p.aliases = make(map[string][]route.Vertex)
+
+	seen := make(map[route.Vertex]bool)
+	for _, channel := range p.channels {
+		if seen[channel.PubKeyBytes] {
+			continue
+		}
+		seen[channel.PubKeyBytes] = true
+
+		info, err := p.lnd.GetNodeInfo(ctx, channel.PubKeyBytes, false)
+		if err != nil {
+			return err
+		}
+
+		if info.Node == nil || info.Alias == "" {
+			continue
+		}
+
+		p.aliases[info.Alias] = append(
+			p.aliases[info.Alias], channel.PubKeyBytes,
+		)
+	}
+
+	return nil
+}
+
+// sortRules sorts a set of rpc rules so that they can be compared and
+// exported in a stable order. Channel rules are listed first, followed by
+// peer rules and then our node rule.
+func sortRules(rules []*clientrpc.LiquidityRule) {
+	rank := func(rule *clientrpc.LiquidityRule) int {
+		switch {
+		case rule.ChannelId != 0:
+			return 0
+
+		case rule.Pubkey != nil:
+			return 1
+
+		default:
+			return 2
+		}
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		if rank(rules[i]) != rank(rules[j]) {
+			return rank(rules[i]) < rank(rules[j])
+		}
+
+		if rules[i].ChannelId != rules[j].ChannelId {
+			return rules[i].ChannelId < rules[j].ChannelId
+		}
+
+		if !bytes.Equal(rules[i].Pubkey, rules[j].Pubkey) {
+			return bytes.Compare(rules[i].Pubkey, rules[j].Pubkey) < 0
+		}
+
+		return rules[i].AssetId < rules[j].AssetId
+	})
+}
@@ -0,0 +1,270 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	clientrpc "github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestExportImportParams tests that parameters exported from one node can be
+// imported on another, and that only the parameters that change are reported.
+func TestExportImportParams(t *testing.T) {
+	ctx := context.Background()
+
+	newManager := func() *Manager {
+		cfg, lnd := newTestConfig()
+		lnd.Channels = []lndclient.ChannelInfo{channel1, channel2}
+		cfg.PutLiquidityParams = func(context.Context, []byte) error {
+			return nil
+		}
+
+		return NewManager(cfg)
+	}
+
+	exporter := newManager()
+
+	params := defaultParameters
+	params.AutoloopBudgetLastRefresh = testBudgetStart
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+	}
+	params.PeerRules = map[route.Vertex]*SwapRule{
+		peer2: chanRule,
+	}
+	require.NoError(t, exporter.setParameters(ctx, params))
+
+	doc, err := exporter.ExportParams(ctx)
+	require.NoError(t, err)
+
+	// Our rules should be listed in the document rather than its
+	// parameters, with channel rules listed first along with their peer.
+	require.Empty(t, doc.Parameters.Rules)
+	require.Zero(t, doc.Parameters.AutoloopBudgetLastRefresh)
+	require.Len(t, doc.Rules, 2)
+
+	require.Equal(t, chanID1.ToUint64(), doc.Rules[0].ChannelId)
+	require.Equal(t, peer1.String(), doc.Rules[0].Peer)
+	require.Zero(t, doc.Rules[0].Rule.ChannelId)
+
+	require.Zero(t, doc.Rules[1].ChannelId)
+	require.Equal(t, peer2.String(), doc.Rules[1].Peer)
+	require.Nil(t, doc.Rules[1].Rule.Pubkey)
+
+	// A dry run import on a node with default parameters should report
+	// our rules as changed, without setting them.
+	importer := newManager()
+	changes, err := importer.ImportParams(ctx, doc, true)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, "rules", changes[0].Field)
+	require.Empty(t, importer.GetParameters().ChannelRules)
+
+	// Once we import the document, our rules should be set.
+	_, err = importer.ImportParams(ctx, doc, false)
+	require.NoError(t, err)
+
+	imported := importer.GetParameters()
+	require.Equal(t, params.ChannelRules, imported.ChannelRules)
+	require.Equal(t, params.PeerRules, imported.PeerRules)
+
+	// Importing the same document again does not change anything.
+	changes, err = importer.ImportParams(ctx, doc, true)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
+
+// TestResolveDocumentRule tests resolution of the targets of document rules
+// against our channels.
+func TestResolveDocumentRule(t *testing.T) {
+	channel3 := lndclient.ChannelInfo{
+		ChannelID:   chanID3.ToUint64(),
+		PubKeyBytes: peer1,
+	}
+
+	rule := &clientrpc.LiquidityRule{
+		Type:              clientrpc.LiquidityRuleType_THRESHOLD,
+		IncomingThreshold: 50,
+	}
+
+	targetRule := func(channel uint64, peer []byte,
+		node bool) *clientrpc.LiquidityRule {
+
+		rpcRule, _ := proto.Clone(rule).(*clientrpc.LiquidityRule)
+		rpcRule.ChannelId = channel
+		rpcRule.Pubkey = peer
+		rpcRule.Node = node
+
+		return rpcRule
+	}
+
+	tests := []struct {
+		name    string
+		docRule *clientrpc.LiquidityDocumentRule
+		rules   []*clientrpc.LiquidityRule
+		err     error
+
+		// fails is set for failures that do not have an error
+		// that we can compare to.
+		fails bool
+	}{
+		{
+			name: "no rule",
+			docRule: &clientrpc.LiquidityDocumentRule{
+				Node: true,
+			},
+			err: ErrNoDocumentRule,
+		},
+		{
+			name: "target in rule",
+			docRule: &clientrpc.LiquidityDocumentRule{
+				Rule: targetRule(1, nil, false),
+			},
+			err: ErrDocumentRuleTarget,
+		},
+		{
+			name: "no target",
+			docRule: &clientrpc.LiquidityDocumentRule{
+				Rule: rule,
+			},
+			err: ErrNoDocumentRuleTarget,
+		},
+		{
+			name: "node rule with peer",
+			docRule: &clientrpc.LiquidityDocumentRule{
+				Node: true,
+				Peer: peer1.String(),
+				Rule: rule,
+			},
+			err: ErrDocumentNodeTarget,
+		},
+		{
+			name: "all channels without peer",
+			docRule: &clientrpc.LiquidityDocumentRule{
+				AllChannels: true,
+				Rule:        rule,
+			},
+			err: ErrDocumentAllChannels,
+		},
+		{
+			name: "node rule",
+			docRule: &clientrpc.LiquidityDocumentRule{
+				Node: true,
+				Rule: rule,
+			},
+			rules: []*clientrpc.LiquidityRule{
+				targetRule(0, nil, true),
+			},
+		},
+		{
+			name: "channel rule",
+			docRule: &clientrpc.LiquidityDocumentRule{
+				ChannelId: chanID2.ToUint64(),
+				Rule:      rule,
+			},
+			rules: []*clientrpc.LiquidityRule{
+				targetRule(chanID2.ToUint64(), nil, false),
+			},
+		},
+		{
+			name: "peer rule by pubkey",
+			docRule: &clientrpc.LiquidityDocumentRule{
+				Peer: peer2.String(),
+				Rule: rule,
+			},
+			rules: []*clientrpc.LiquidityRule{
+				targetRule(0, peer2[:], false),
+			},
+		},
+		{
+			name: "peer rule by alias",
+			docRule: &clientrpc.LiquidityDocumentRule{
+				Peer: "bob",
+				Rule: rule,
+			},
+			rules: []*clientrpc.LiquidityRule{
+				targetRule(0, peer2[:], false),
+			},
+		},
+		{
+			name: "unknown alias",
+			docRule: &clientrpc.LiquidityDocumentRule{
+				Peer: "carol",
+				Rule: rule,
+			},
+			fails: true,
+		},
+		{
+			name: "all of peer's channels",
+			docRule: &clientrpc.LiquidityDocumentRule{
+				Peer:        "alice",
+				AllChannels: true,
+				Rule:        rule,
+			},
+			rules: []*clientrpc.LiquidityRule{
+				targetRule(chanID1.ToUint64(), nil, false),
+				targetRule(chanID3.ToUint64(), nil, false),
+			},
+		},
+		{
+			name: "channel of peer",
+			docRule: &clientrpc.LiquidityDocumentRule{
+				Peer:      "alice",
+				ChannelId: chanID3.ToUint64(),
+				Rule:      rule,
+			},
+			rules: []*clientrpc.LiquidityRule{
+				targetRule(chanID3.ToUint64(), nil, false),
+			},
+		},
+		{
+			name: "channel of other peer",
+			docRule: &clientrpc.LiquidityDocumentRule{
+				Peer:      "bob",
+				ChannelId: chanID3.ToUint64(),
+				Rule:      rule,
+			},
+			fails: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			_, lnd := newTestConfig()
+			lnd.NodeAliases = map[route.Vertex]string{
+				peer1: "alice",
+				peer2: "bob",
+			}
+
+			resolver := &peerResolver{
+				lnd: lnd.Client,
+				channels: []lndclient.ChannelInfo{
+					channel1, channel2, channel3,
+				},
+			}
+
+			rules, err := resolver.resolveRule(
+				context.Background(), testCase.docRule,
+			)
+			if testCase.fails {
+				require.Error(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, testCase.err)
+			require.Equal(t, len(testCase.rules), len(rules))
+			for i, rule := range rules {
+				require.True(
+					t, proto.Equal(testCase.rules[i], rule),
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package liquidity
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// FailureBackoff describes the backoff that is applied to a channel or peer
+// because of consecutive failed swaps.
+type FailureBackoff struct {
+	// Failures is the number of consecutive failed swaps for the target.
+	// This count is reset once a swap for the target succeeds.
+	Failures int
+
+	// LastFailure is the time of the target's most recent failed swap.
+	LastFailure time.Time
+
+	// Backoff is the amount of time that must pass after the last failure
+	// before we consider the target for swaps again.
+	Backoff time.Duration
+}
+
+// backoffUntil returns the time at which the backoff ends.
+func (f *FailureBackoff) backoffUntil() time.Time {
+	return f.LastFailure.Add(f.Backoff)
+}
+
+// failureBackoff returns the backoff that we apply to a target that has failed
+// the number of consecutive times provided. The backoff starts at our
+// configured failure backoff and is doubled for each additional consecutive
+// failure, capped at our maximum failure backoff. If no maximum is set, the
+// backoff does not grow.
+func (p Parameters) failureBackoff(failures int) time.Duration {
+	backoff := p.FailureBackOff
+
+	if p.FailureBackOffMax == 0 {
+		return backoff
+	}
+
+	for i := 1; i < failures && backoff < p.FailureBackOffMax; i++ {
+		backoff *= 2
+	}
+
+	if backoff > p.FailureBackOffMax {
+		backoff = p.FailureBackOffMax
+	}
+
+	return backoff
+}
+
+// swapOutcome records the time at which a swap reached a final outcome that
+// is relevant for our failure backoff, and whether it failed.
+type swapOutcome struct {
+	time   time.Time
+	failed bool
+}
+
+// consecutiveFailures counts the number of consecutive failures that a set of
+// swap outcomes ends with, returning the count and the time of the last
+// failure. Outcomes are examined in chronological order, and a success resets
+// our failure count.
+func consecutiveFailures(outcomes []swapOutcome) (int, time.Time) {
+	sort.Slice(outcomes, func(i, j int) bool {
+		return outcomes[i].time.Before(outcomes[j].time)
+	})
+
+	var (
+		failures    int
+		lastFailure time.Time
+	)
+
+	for _, outcome := range outcomes {
+		if !outcome.failed {
+			failures = 0
+			continue
+		}
+
+		failures++
+		lastFailure = outcome.time
+	}
+
+	return failures, lastFailure
+}
+
+// loopOutFailures examines our loop out swaps and returns the number of
+// consecutive off-chain payment failures for each channel, along with the time
+// of the most recent failure. Channels that have no failures since their last
+// successful swap are not included.
+func loopOutFailures(
+	loopOut []*loopdb.LoopOut) map[lnwire.ShortChannelID]*FailureBackoff {
+
+	outcomes := make(map[lnwire.ShortChannelID][]swapOutcome)
+	for _, out := range loopOut {
+		state := out.State().State
+
+		// We only back off for off chain payment failures, a
+		// successful swap resets the failures for a channel.
+		if state != loopdb.StateFailOffchainPayments &&
+			state != loopdb.StateSuccess {
+
+			continue
+		}
+
+		outcome := swapOutcome{
+			time:   out.LastUpdate().Time,
+			failed: state == loopdb.StateFailOffchainPayments,
+		}
+
+		for _, id := range out.Contract.OutgoingChanSet {
+			chanID := lnwire.NewShortChanIDFromInt(id)
+			outcomes[chanID] = append(outcomes[chanID], outcome)
+		}
+	}
+
+	failures := make(map[lnwire.ShortChannelID]*FailureBackoff)
+	for chanID, chanOutcomes := range outcomes {
+		count, lastFailure := consecutiveFailures(chanOutcomes)
+		if count == 0 {
+			continue
+		}
+
+		failures[chanID] = &FailureBackoff{
+			Failures:    count,
+			LastFailure: lastFailure,
+		}
+	}
+
+	return failures
+}
+
+// loopInFailures examines our loop in swaps and returns the number of
+// consecutive on-chain timeout failures for each last hop peer, along with the
+// time of the most recent failure. Peers that have no failures since their
+// last successful swap are not included.
+func loopInFailures(loopIn []*loopdb.LoopIn) map[route.Vertex]*FailureBackoff {
+	outcomes := make(map[route.Vertex][]swapOutcome)
+	for _, in := range loopIn {
+		// Skip over swaps that may come through any peer.
+		if in.Contract.LastHop == nil {
+			continue
+		}
+
+		state := in.State().State
+		if state != loopdb.StateFailTimeout &&
+			state != loopdb.StateSuccess {
+
+			continue
+		}
+
+		peer := *in.Contract.LastHop
+		outcomes[peer] = append(outcomes[peer], swapOutcome{
+			time:   in.LastUpdate().Time,
+			failed: state == loopdb.StateFailTimeout,
+		})
+	}
+
+	failures := make(map[route.Vertex]*FailureBackoff)
+	for peer, peerOutcomes := range outcomes {
+		count, lastFailure := consecutiveFailures(peerOutcomes)
+		if count == 0 {
+			continue
+		}
+
+		failures[peer] = &FailureBackoff{
+			Failures:    count,
+			LastFailure: lastFailure,
+		}
+	}
+
+	return failures
+}
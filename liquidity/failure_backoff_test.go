@@ -0,0 +1,199 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFailureBackoff tests growth of our failure backoff with consecutive
+// failures.
+func TestFailureBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     time.Duration
+		max      time.Duration
+		failures int
+		expected time.Duration
+	}{
+		{
+			name:     "no maximum, backoff fixed",
+			base:     time.Hour,
+			max:      0,
+			failures: 5,
+			expected: time.Hour,
+		},
+		{
+			name:     "single failure",
+			base:     time.Hour,
+			max:      time.Hour * 24,
+			failures: 1,
+			expected: time.Hour,
+		},
+		{
+			name:     "consecutive failures double",
+			base:     time.Hour,
+			max:      time.Hour * 24,
+			failures: 3,
+			expected: time.Hour * 4,
+		},
+		{
+			name:     "capped at maximum",
+			base:     time.Hour,
+			max:      time.Hour * 24,
+			failures: 10,
+			expected: time.Hour * 24,
+		},
+		{
+			name:     "maximum equal to base",
+			base:     time.Hour,
+			max:      time.Hour,
+			failures: 3,
+			expected: time.Hour,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			params := Parameters{
+				FailureBackOff:    testCase.base,
+				FailureBackOffMax: testCase.max,
+			}
+
+			require.Equal(
+				t, testCase.expected,
+				params.failureBackoff(testCase.failures),
+			)
+		})
+	}
+}
+
+// TestFailureBackoffRpc tests that a maximum failure backoff of zero in rpc
+// parameters maps to our default maximum, and that the growth of our failure
+// backoff is only disabled explicitly.
+func TestFailureBackoffRpc(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     uint64
+		max      uint64
+		fixed    bool
+		expected time.Duration
+	}{
+		{
+			name:     "unset maximum uses default",
+			base:     3600,
+			expected: defaultFailureBackoffMax,
+		},
+		{
+			name:     "default raised to base",
+			base:     14 * 24 * 3600,
+			expected: defaultFailureBackoffMax * 2,
+		},
+		{
+			name:     "maximum set",
+			base:     3600,
+			max:      7200,
+			expected: time.Hour * 2,
+		},
+		{
+			name:     "fixed backoff",
+			base:     3600,
+			max:      7200,
+			fixed:    true,
+			expected: 0,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := ParametersToRpc(defaultParameters)
+			require.NoError(t, err)
+
+			req.FailureBackoffSec = testCase.base
+			req.FailureBackoffMaxSec = testCase.max
+			req.FailureBackoffFixed = testCase.fixed
+
+			params, err := RpcToParameters(req)
+			require.NoError(t, err)
+			require.Equal(
+				t, testCase.expected, params.FailureBackOffMax,
+			)
+
+			// Our parameters survive a round trip through rpc.
+			req, err = ParametersToRpc(*params)
+			require.NoError(t, err)
+
+			roundTrip, err := RpcToParameters(req)
+			require.NoError(t, err)
+			require.Equal(
+				t, params.FailureBackOffMax,
+				roundTrip.FailureBackOffMax,
+			)
+		})
+	}
+}
+
+// TestLoopOutFailures tests counting of consecutive loop out failures per
+// channel, with successful swaps resetting the count.
+func TestLoopOutFailures(t *testing.T) {
+	var (
+		t1 = testTime.Add(time.Hour)
+		t2 = testTime.Add(time.Hour * 2)
+		t3 = testTime.Add(time.Hour * 3)
+	)
+
+	swap := func(state loopdb.SwapState, ts time.Time,
+		channels ...lnwire.ShortChannelID) *loopdb.LoopOut {
+
+		chanSet := make([]uint64, len(channels))
+		for i, id := range channels {
+			chanSet[i] = id.ToUint64()
+		}
+
+		return &loopdb.LoopOut{
+			Contract: &loopdb.LoopOutContract{
+				OutgoingChanSet: chanSet,
+			},
+			Loop: loopdb.Loop{
+				Events: []*loopdb.LoopEvent{
+					{
+						SwapStateData: loopdb.SwapStateData{
+							State: state,
+						},
+						Time: ts,
+					},
+				},
+			},
+		}
+	}
+
+	// Channel 1 fails, succeeds and then fails again, so only has a single
+	// consecutive failure. Channel 2 fails twice in a row. Our swaps are
+	// listed out of order to assert that we sort them by time.
+	failures := loopOutFailures([]*loopdb.LoopOut{
+		swap(loopdb.StateFailOffchainPayments, t3, chanID1, chanID2),
+		swap(loopdb.StateFailOffchainPayments, t1, chanID1),
+		swap(loopdb.StateSuccess, t2, chanID1),
+		swap(loopdb.StateFailOffchainPayments, t2, chanID2),
+		swap(loopdb.StateSuccess, t3, chanID3),
+		swap(loopdb.StateFailTemporary, t3, chanID3),
+	})
+
+	require.Equal(t, map[lnwire.ShortChannelID]*FailureBackoff{
+		chanID1: {
+			Failures:    1,
+			LastFailure: t3,
+		},
+		chanID2: {
+			Failures:    2,
+			LastFailure: t3,
+		},
+	}, failures)
+}
@@ -0,0 +1,199 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/swap"
+)
+
+var (
+	// errJitterAmountPercent is returned when our amount jitter would
+	// allow us to reduce a swap's amount to zero.
+	errJitterAmountPercent = errors.New("amount jitter must be < 100%")
+
+	// errJitterDispatchDelay is returned when our dispatch delay is
+	// negative or would delay autoloop beyond its next tick.
+	errJitterDispatchDelay = fmt.Errorf("dispatch delay must be in "+
+		"[0, %v)", DefaultAutoloopTicker)
+
+	// errJitterConfSpread is returned when our conf target spread is
+	// negative.
+	errJitterConfSpread = errors.New("conf target spread must be >= 0")
+)
+
+// PrivacyJitter randomizes the swaps that autoloop dispatches, so that they
+// are not dispatched with predictable amounts, at predictable times, or with
+// predictable sweep fee rates. Amounts are only ever reduced and sweep conf
+// targets are only ever increased, so that the fee limits and budget checks
+// that our swaps were suggested with still hold.
+type PrivacyJitter struct {
+	// AmountPercent is the maximum percentage that we reduce the amount
+	// of a swap by. Amounts are never reduced below our minimum swap
+	// amount.
+	AmountPercent uint64
+
+	// DispatchDelay is the maximum random delay that we wait for on each
+	// autoloop tick before we suggest and dispatch swaps.
+	DispatchDelay time.Duration
+
+	// ConfTargetSpread is the maximum number of blocks that we add to
+	// the sweep conf target of a loop out.
+	ConfTargetSpread int32
+}
+
+// String returns a string representation of our privacy jitter.
+func (j *PrivacyJitter) String() string {
+	return fmt.Sprintf("jitter: amount: %v%%, dispatch delay: %v, conf "+
+		"target spread: %v", j.AmountPercent, j.DispatchDelay,
+		j.ConfTargetSpread)
+}
+
+// validate checks that our privacy jitter is valid.
+func (j *PrivacyJitter) validate() error {
+	if j.AmountPercent >= 100 {
+		return errJitterAmountPercent
+	}
+
+	if j.DispatchDelay < 0 || j.DispatchDelay >= DefaultAutoloopTicker {
+		return errJitterDispatchDelay
+	}
+
+	if j.ConfTargetSpread < 0 {
+		return errJitterConfSpread
+	}
+
+	return nil
+}
+
+// jitterAmount reduces the amount provided by a random percentage of up to
+// our amount jitter, without going below the minimum amount set by the
+// restrictions provided.
+func (j *PrivacyJitter) jitterAmount(rng *rand.Rand, amount btcutil.Amount,
+	restrictions *Restrictions) btcutil.Amount {
+
+	maxReduction := amount * btcutil.Amount(j.AmountPercent) / 100
+	if amount-maxReduction < restrictions.Minimum {
+		maxReduction = amount - restrictions.Minimum
+	}
+
+	if maxReduction <= 0 {
+		return amount
+	}
+
+	return amount - btcutil.Amount(rng.Int63n(int64(maxReduction)+1))
+}
+
+// jitterConfTarget increases the conf target provided by a random number of
+// blocks of up to our spread, without exceeding the maximum provided.
+func (j *PrivacyJitter) jitterConfTarget(rng *rand.Rand, confTarget,
+	maxConfTarget int32) int32 {
+
+	spread := j.ConfTargetSpread
+	if confTarget+spread > maxConfTarget {
+		spread = maxConfTarget - confTarget
+	}
+
+	if spread <= 0 {
+		return confTarget
+	}
+
+	return confTarget + rng.Int31n(spread+1)
+}
+
+// dispatchDelay returns a random delay of up to our maximum dispatch delay.
+func (j *PrivacyJitter) dispatchDelay(rng *rand.Rand) time.Duration {
+	if j.DispatchDelay == 0 {
+		return 0
+	}
+
+	return time.Duration(rng.Int63n(int64(j.DispatchDelay)))
+}
+
+// random returns the source of randomness that we use for our privacy
+// jitter. It is seeded from our clock so that our jitter is reproducible in
+// tests. This value is only accessed by our main loop.
+func (m *Manager) random() *rand.Rand {
+	if m.rand == nil {
+		m.rand = rand.New(rand.NewSource(m.cfg.Clock.Now().UnixNano()))
+	}
+
+	return m.rand
+}
+
+// autoloopDelay returns the random delay that we wait for before we run
+// autoloop, so that our swaps are not dispatched at predictable times. No
+// delay is returned if we do not dispatch swaps or our privacy jitter has no
+// dispatch delay set.
+func (m *Manager) autoloopDelay() time.Duration {
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	if !m.params.Autoloop || m.params.Jitter == nil {
+		return 0
+	}
+
+	return m.params.Jitter.dispatchDelay(m.random())
+}
+
+// jitterSuggestions applies our privacy jitter to the amounts and sweep conf
+// targets of the swaps suggested. Static address loop ins are not jittered,
+// because their amount is set by the deposits that fund them, and neither are
+// the amounts of asset loop outs, because they are priced by an rfq for their
+// suggested amount.
+func (m *Manager) jitterSuggestions(ctx context.Context,
+	suggestions *Suggestions) error {
+
+	jitter := m.params.Jitter
+	if jitter == nil {
+		return nil
+	}
+
+	rng := m.random()
+
+	if len(suggestions.OutSwaps) != 0 {
+		restrictions, err := m.getSwapRestrictions(ctx, swap.TypeOut)
+		if err != nil {
+			return err
+		}
+
+		terms, err := m.cfg.LoopOutTerms(ctx, getInitiator(m.params))
+		if err != nil {
+			return err
+		}
+
+		for i := range suggestions.OutSwaps {
+			out := &suggestions.OutSwaps[i]
+
+			if len(out.AssetId) == 0 {
+				out.Amount = jitter.jitterAmount(
+					rng, out.Amount, restrictions,
+				)
+			}
+			out.SweepConfTarget = jitter.jitterConfTarget(
+				rng, out.SweepConfTarget, terms.MaxCltvDelta,
+			)
+		}
+	}
+
+	if len(suggestions.InSwaps) != 0 {
+		restrictions, err := m.getSwapRestrictions(ctx, swap.TypeIn)
+		if err != nil {
+			return err
+		}
+
+		for i := range suggestions.InSwaps {
+			in := &suggestions.InSwaps[i]
+
+			in.Amount = jitter.jitterAmount(
+				rng, in.Amount, restrictions,
+			)
+		}
+	}
+
+	return nil
+}
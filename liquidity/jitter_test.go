@@ -0,0 +1,288 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPrivacyJitterValidate tests validation of our privacy jitter.
+func TestPrivacyJitterValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		jitter *PrivacyJitter
+		err    error
+	}{
+		{
+			name: "valid",
+			jitter: &PrivacyJitter{
+				AmountPercent:    10,
+				DispatchDelay:    time.Minute,
+				ConfTargetSpread: 6,
+			},
+		},
+		{
+			name: "amount jitter too high",
+			jitter: &PrivacyJitter{
+				AmountPercent: 100,
+			},
+			err: errJitterAmountPercent,
+		},
+		{
+			name: "negative dispatch delay",
+			jitter: &PrivacyJitter{
+				DispatchDelay: -1,
+			},
+			err: errJitterDispatchDelay,
+		},
+		{
+			name: "dispatch delay past next tick",
+			jitter: &PrivacyJitter{
+				DispatchDelay: DefaultAutoloopTicker,
+			},
+			err: errJitterDispatchDelay,
+		},
+		{
+			name: "negative conf target spread",
+			jitter: &PrivacyJitter{
+				ConfTargetSpread: -1,
+			},
+			err: errJitterConfSpread,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			require.ErrorIs(t, testCase.jitter.validate(), testCase.err)
+		})
+	}
+}
+
+// TestJitterAmount tests that amounts are only reduced by up to our amount
+// jitter, and are never reduced below our minimum swap amount.
+func TestJitterAmount(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	tests := []struct {
+		name    string
+		percent uint64
+		minimum btcutil.Amount
+		lowest  btcutil.Amount
+	}{
+		{
+			name:    "no jitter",
+			percent: 0,
+			minimum: 1,
+			lowest:  10000,
+		},
+		{
+			name:    "reduced by percentage",
+			percent: 20,
+			minimum: 1,
+			lowest:  8000,
+		},
+		{
+			name:    "reduced to minimum",
+			percent: 20,
+			minimum: 9500,
+			lowest:  9500,
+		},
+		{
+			name:    "amount at minimum",
+			percent: 20,
+			minimum: 10000,
+			lowest:  10000,
+		},
+	}
+
+	for _, testCase := range tests {
+		jitter := &PrivacyJitter{
+			AmountPercent: testCase.percent,
+		}
+		restrictions := NewRestrictions(testCase.minimum, 100000)
+
+		for i := 0; i < 100; i++ {
+			amount := jitter.jitterAmount(rng, 10000, restrictions)
+			require.LessOrEqual(t, amount, btcutil.Amount(10000),
+				testCase.name)
+			require.GreaterOrEqual(t, amount, testCase.lowest,
+				testCase.name)
+		}
+	}
+}
+
+// TestJitterConfTarget tests that conf targets are only increased by up to
+// our spread, and never exceed the maximum provided.
+func TestJitterConfTarget(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	jitter := &PrivacyJitter{
+		ConfTargetSpread: 10,
+	}
+
+	for i := 0; i < 100; i++ {
+		confTarget := jitter.jitterConfTarget(rng, 100, 200)
+		require.GreaterOrEqual(t, confTarget, int32(100))
+		require.LessOrEqual(t, confTarget, int32(110))
+
+		confTarget = jitter.jitterConfTarget(rng, 100, 105)
+		require.GreaterOrEqual(t, confTarget, int32(100))
+		require.LessOrEqual(t, confTarget, int32(105))
+	}
+
+	// If our conf target is already at our maximum, it is not changed.
+	require.EqualValues(t, 100, jitter.jitterConfTarget(rng, 100, 100))
+}
+
+// TestJitterSuggestions tests that our jitter is applied to our suggested
+// swaps, and that it is reproducible with the same clock.
+func TestJitterSuggestions(t *testing.T) {
+	jitter := &PrivacyJitter{
+		AmountPercent:    50,
+		ConfTargetSpread: 50,
+	}
+
+	assetRec := chan1Rec
+	assetRec.AssetId = testAssetID[:]
+
+	jitterSwaps := func() *Suggestions {
+		cfg, _ := newTestConfig()
+		cfg.LoopOutTerms = func(context.Context,
+			string) (*loop.LoopOutTerms, error) {
+
+			return &loop.LoopOutTerms{
+				MaxCltvDelta: chan1Rec.SweepConfTarget + 20,
+			}, nil
+		}
+
+		manager := NewManager(cfg)
+		manager.params.Jitter = jitter
+
+		suggestions := &Suggestions{
+			OutSwaps: []loop.OutRequest{chan1Rec, assetRec},
+			InSwaps: []loop.LoopInRequest{
+				{
+					Amount: 5000,
+				},
+			},
+		}
+
+		err := manager.jitterSuggestions(
+			context.Background(), suggestions,
+		)
+		require.NoError(t, err)
+
+		return suggestions
+	}
+
+	suggestions := jitterSwaps()
+	require.Equal(t, suggestions, jitterSwaps())
+
+	out := suggestions.OutSwaps[0]
+	require.LessOrEqual(t, out.Amount, chan1Rec.Amount)
+	require.GreaterOrEqual(t, out.Amount, chan1Rec.Amount/2)
+	require.GreaterOrEqual(t, out.SweepConfTarget, chan1Rec.SweepConfTarget)
+	require.LessOrEqual(
+		t, out.SweepConfTarget, chan1Rec.SweepConfTarget+20,
+	)
+
+	// Asset loop outs are priced for their suggested amount, so only
+	// their conf target is jittered.
+	require.Equal(t, assetRec.Amount, suggestions.OutSwaps[1].Amount)
+
+	in := suggestions.InSwaps[0]
+	require.LessOrEqual(t, in.Amount, btcutil.Amount(5000))
+	require.GreaterOrEqual(t, in.Amount, btcutil.Amount(2500))
+
+	// The fees that our swaps were suggested with should be unchanged.
+	require.Equal(t, chan1Rec.MaxSwapFee, out.MaxSwapFee)
+	require.Equal(t, chan1Rec.MaxMinerFee, out.MaxMinerFee)
+}
+
+// TestAutoloopDispatchDelay tests that our autoloop runs are delayed by our
+// dispatch delay, and that our main loop keeps handling ticks while a run is
+// delayed.
+func TestAutoloopDispatchDelay(t *testing.T) {
+	tickSignal := make(chan time.Duration)
+	testClock := clock.NewTestClockWithTickSignal(testTime, tickSignal)
+
+	cfg, lnd := newTestConfig()
+	cfg.Clock = testClock
+	cfg.AutoloopTicker = ticker.NewForce(DefaultAutoloopTicker)
+	cfg.FetchLiquidityParams = func(context.Context) ([]byte, error) {
+		return nil, nil
+	}
+
+	// Our autoloop run fails once it gets our swap restrictions, which
+	// lets us know that it has run.
+	autoloopRun := make(chan struct{})
+	cfg.Restrictions = func(context.Context, swap.Type, string) (
+		*Restrictions, error) {
+
+		autoloopRun <- struct{}{}
+		return nil, errors.New("restrictions unavailable")
+	}
+
+	lnd.Channels = []lndclient.ChannelInfo{channel1}
+
+	manager := NewManager(cfg)
+	manager.params.AutoloopBudgetLastRefresh = testTime
+	manager.params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+	}
+	manager.params.Jitter = &PrivacyJitter{
+		DispatchDelay: time.Minute * 10,
+	}
+
+	// We do not delay our runs if we do not dispatch swaps.
+	require.Zero(t, manager.autoloopDelay())
+
+	manager.params.Autoloop = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errChan := make(chan error)
+	go func() {
+		errChan <- manager.Run(ctx)
+	}()
+
+	// When we tick, our autoloop run is delayed.
+	cfg.AutoloopTicker.Force <- testTime
+
+	delay := <-tickSignal
+	require.Less(t, delay, time.Minute*10)
+
+	// While our run is delayed, we still handle ticks, which record a
+	// balance snapshot each, but do not delay another run.
+	cfg.AutoloopTicker.Force <- testTime
+	require.Eventually(t, func() bool {
+		return len(manager.Snapshots()) == 2
+	}, test.Timeout, time.Millisecond*10)
+
+	// Once we advance our clock past our maximum delay, autoloop runs.
+	testClock.SetTime(testTime.Add(time.Minute * 10))
+
+	select {
+	case <-autoloopRun:
+
+	case <-time.After(test.Timeout):
+		t.Fatalf("autoloop did not run")
+	}
+
+	cancel()
+	require.ErrorIs(t, <-errChan, context.Canceled)
+}
@@ -33,12 +33,14 @@
 package liquidity
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"math/rand"
 	"sort"
 	"sync"
 	"time"
@@ -46,6 +48,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/descriptor"
 	"github.com/lightninglabs/loop/labels"
 	"github.com/lightninglabs/loop/loopdb"
 	clientrpc "github.com/lightninglabs/loop/looprpc"
@@ -66,6 +69,10 @@ const (
 	// a channel is part of a temporarily failed swap.
 	defaultFailureBackoff = time.Hour * 24
 
+	// defaultFailureBackoffMax is the default upper limit for the
+	// exponential backoff we apply to targets that repeatedly fail.
+	defaultFailureBackoffMax = time.Hour * 24 * 7
+
 	// defaultAmountBackoff is the default backoff we apply to the amount
 	// of a loop out swap that failed the off-chain payments.
 	defaultAmountBackoff = float64(0.25)
@@ -104,6 +111,10 @@ const (
 	// a swap request when issuing an automatic swap.
 	autoloopSwapInitiator = "autoloop"
 
+	// easyAutoloopSwapInitiator is the value we send in the initiator
+	// field of a swap request when issuing an easy autoloop swap.
+	easyAutoloopSwapInitiator = "easy-autoloop"
+
 	// We use a static fee rate to estimate our sweep fee, because we
 	// can't realistically estimate what our fee estimate will be by the
 	// time we reach timeout. We set this to a high estimate so that we can
@@ -128,6 +139,10 @@ var (
 	// the autoloop budget to be refreshed.
 	defaultBudgetRefreshPeriod = time.Hour * 24 * 7
 
+	// defaultApprovalExpiry is the default amount of time that a queued
+	// swap may be approved for before it expires.
+	defaultApprovalExpiry = time.Hour * 24
+
 	// ErrZeroChannelID is returned if we get a rule for a 0 channel ID.
 	ErrZeroChannelID = fmt.Errorf("zero channel ID not allowed")
 
@@ -137,6 +152,11 @@ var (
 	// ErrZeroInFlight is returned is a zero in flight swaps value is set.
 	ErrZeroInFlight = errors.New("max in flight swaps must be >=0")
 
+	// ErrFailureBackoffMax is returned if the maximum failure backoff is
+	// set to a value below the base failure backoff.
+	ErrFailureBackoffMax = errors.New("maximum failure backoff must be " +
+		"zero or >= failure backoff")
+
 	// ErrMinimumExceedsMaximumAmt is returned when the minimum configured
 	// swap amount is more than the maximum.
 	ErrMinimumExceedsMaximumAmt = errors.New("minimum swap amount " +
@@ -160,6 +180,11 @@ var (
 	ErrExclusiveRules = errors.New("channel and peer rules must be " +
 		"exclusive")
 
+	// ErrExclusiveNodeRule is returned when a node rule is set together
+	// with channel or peer rules.
+	ErrExclusiveNodeRule = errors.New("node rule may not be set with " +
+		"channel or peer rules")
+
 	// ErrAmbiguousDestAddr is returned when a destination address and
 	// a extended public key account is set.
 	ErrAmbiguousDestAddr = errors.New("ambiguous destination address")
@@ -168,6 +193,17 @@ var (
 	// account address type is not or vice versa.
 	ErrAccountAndAddrType = errors.New("account and address type have " +
 		"to be both either set or unset")
+
+	// ErrEasyAutoloopInTarget is returned if the easy autoloop loop in
+	// target is not below the loop out target, which would cause easy
+	// autoloop to swap back and forth between the two.
+	ErrEasyAutoloopInTarget = errors.New("easy autoloop loop in target " +
+		"must be below the local balance target")
+
+	// ErrInvalidPeerUptime is returned if the minimum peer uptime is not
+	// a percentage.
+	ErrInvalidPeerUptime = errors.New("minimum peer uptime must be a " +
+		"percentage between 0 and 100")
 )
 
 // Config contains the external functionality required to run the
@@ -209,10 +245,31 @@ type Config struct {
 	LoopOut func(ctx context.Context, request *loop.OutRequest) (
 		*loop.LoopOutSwapInfo, error)
 
+	// NextDescriptorAddr derives a fresh address of a watch-only
+	// descriptor, which is used as the destination of our loop outs if a
+	// destination descriptor is set.
+	NextDescriptorAddr func(ctx context.Context,
+		descriptor string) (btcutil.Address, error)
+
 	// LoopIn dispatches a loop in swap.
 	LoopIn func(ctx context.Context,
 		request *loop.LoopInRequest) (*loop.LoopInSwapInfo, error)
 
+	// ListStaticDeposits returns the static address deposits that are
+	// available to fund loop ins.
+	ListStaticDeposits func(ctx context.Context) ([]*StaticDeposit, error)
+
+	// ListStaticLoopIns returns our static address loop in swaps, so that
+	// they can be accounted for along with our regular loop ins. This
+	// function is optional.
+	ListStaticLoopIns func(ctx context.Context) ([]*StaticLoopInSwap,
+		error)
+
+	// StaticLoopIn dispatches a loop in swap that is funded by static
+	// address deposits, returning the hash of the swap.
+	StaticLoopIn func(ctx context.Context,
+		request *loop.StaticAddressLoopInRequest) (lntypes.Hash, error)
+
 	// LoopInTerms returns the terms for a loop in swap.
 	LoopInTerms func(ctx context.Context,
 		initiator string) (*loop.LoopInTerms, error)
@@ -244,6 +301,22 @@ type Config struct {
 	// NOTE: the params are decoded using `proto.Unmarshal` over a
 	// serialized RPC request.
 	FetchLiquidityParams func(ctx context.Context) ([]byte, error)
+
+	// DecisionStore persists a journal of the decisions made by autoloop.
+	// This field is optional, if it is nil no journal is kept.
+	DecisionStore DecisionStore
+
+	// DecisionRetention is the period that we keep autoloop decisions in
+	// our journal for. If this value is zero, decisions are never pruned.
+	DecisionRetention time.Duration
+
+	// ProfileStore persists our named liquidity profiles. This field is
+	// optional, if it is nil liquidity profiles are not available.
+	ProfileStore ProfileStore
+
+	// QueueStore persists the swaps that autoloop queues for approval.
+	// This field is optional, if it is nil swaps can't be queued.
+	QueueStore QueueStore
 }
 
 // Manager contains a set of desired liquidity rules for our channel
@@ -268,6 +341,21 @@ type Manager struct {
 	// activeStickyLock is a lock to ensure atomic access to the
 	// activeStickyLoops counter.
 	activeStickyLock sync.Mutex
+
+	// snapshots is the set of balance snapshots that we have recorded on
+	// each autoloop tick, which can be replayed in simulations.
+	snapshots []BalanceSnapshot
+
+	// snapshotLock is a lock for our recorded snapshots.
+	snapshotLock sync.Mutex
+
+	// queueLock serializes the approval and rejection of queued swaps, so
+	// that a queued swap can't be dispatched twice.
+	queueLock sync.Mutex
+
+	// rand is the source of randomness for our privacy jitter, which is
+	// created on first use. This value is only accessed by our main loop.
+	rand *rand.Rand
 }
 
 // Run periodically checks whether we should automatically dispatch a loop out.
@@ -290,27 +378,58 @@ func (m *Manager) Run(ctx context.Context) error {
 		}
 	}
 
+	// delayedAutoloop delivers a tick once an autoloop run that is
+	// delayed by our privacy jitter is due. It is nil if no run is
+	// delayed.
+	var delayedAutoloop <-chan time.Time
+
 	for {
 		select {
 		case <-m.cfg.AutoloopTicker.Ticks():
-			if m.params.EasyAutoloop {
+			// Switch to any profile that is scheduled for the
+			// current time before we run autoloop, so that it
+			// uses the scheduled parameters.
+			if err := m.activateScheduledProfile(ctx); err != nil {
+				log.Errorf("could not activate scheduled "+
+					"liquidity profile: %v", err)
+			}
+
+			// Record our current balances so that they can be
+			// replayed in simulations, even if autoloop is not
+			// enabled.
+			if err := m.recordSnapshot(ctx); err != nil {
+				log.Errorf("could not record balance "+
+					"snapshot: %v", err)
+			}
+
+			switch {
+			case m.params.EasyAutoloop:
 				err := m.easyAutoLoop(ctx)
 				if err != nil {
 					log.Errorf("easy autoloop failed: %v",
 						err)
 				}
-			} else {
-				err := m.autoloop(ctx)
-				switch err {
-				case ErrNoRules:
-					log.Debugf("no rules configured for " +
-						"autoloop")
-
-				case nil:
 
-				default:
-					log.Errorf("autoloop failed: %v", err)
+			// If an autoloop run is already delayed by our
+			// privacy jitter, we leave it to dispatch our swaps.
+			case delayedAutoloop != nil:
+
+			default:
+				// We delay our autoloop run by a random delay
+				// if our privacy jitter requires it. We
+				// suggest swaps once the delay has passed, so
+				// that our suggestions reflect our balances at
+				// dispatch.
+				delay := m.autoloopDelay()
+				if delay == 0 {
+					m.runAutoloop(ctx)
+					break
 				}
+
+				log.Debugf("Delaying autoloop dispatch by %v",
+					delay)
+
+				delayedAutoloop = m.cfg.Clock.TickAfter(delay)
 			}
 
 			// Try to automatically dispach an asset auto-loop.
@@ -323,12 +442,30 @@ func (m *Manager) Run(ctx context.Context) error {
 				}
 			}
 
+		case <-delayedAutoloop:
+			delayedAutoloop = nil
+			m.runAutoloop(ctx)
+
 		case <-ctx.Done():
 			return ctx.Err()
 		}
 	}
 }
 
+// runAutoloop runs autoloop, logging any error that it fails with.
+func (m *Manager) runAutoloop(ctx context.Context) {
+	err := m.autoloop(ctx)
+	switch err {
+	case ErrNoRules:
+		log.Debugf("no rules configured for autoloop")
+
+	case nil:
+
+	default:
+		log.Errorf("autoloop failed: %v", err)
+	}
+}
+
 // NewManager creates a liquidity manager which has no rules set.
 func NewManager(cfg *Config) *Manager {
 	return &Manager{
@@ -374,6 +511,23 @@ func (m *Manager) SetParameters(ctx context.Context,
 func (m *Manager) setParameters(ctx context.Context,
 	params Parameters) error {
 
+	if err := m.validateParameters(ctx, params); err != nil {
+		return err
+	}
+
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	m.params = cloneParameters(params)
+
+	return nil
+}
+
+// validateParameters checks that a set of parameters is valid for our current
+// swap restrictions and channels.
+func (m *Manager) validateParameters(ctx context.Context,
+	params Parameters) error {
+
 	restrictions, err := m.cfg.Restrictions(
 		ctx, swap.TypeOut, getInitiator(m.params),
 	)
@@ -386,19 +540,19 @@ func (m *Manager) setParameters(ctx context.Context,
 		return err
 	}
 
-	err = params.validate(
-		m.cfg.MinimumConfirmations, channels, restrictions,
-	)
-	if err != nil {
-		return err
+	if params.DestDescriptor != "" {
+		_, err := descriptor.Parse(
+			params.DestDescriptor, m.cfg.Lnd.ChainParams,
+		)
+		if err != nil {
+			return fmt.Errorf("invalid destination descriptor: %w",
+				err)
+		}
 	}
 
-	m.paramsLock.Lock()
-	defer m.paramsLock.Unlock()
-
-	m.params = cloneParameters(params)
-
-	return nil
+	return params.validate(
+		m.cfg.MinimumConfirmations, channels, restrictions,
+	)
 }
 
 // saveParams marshals an RPC request and saves it to db.
@@ -449,12 +603,33 @@ func (m *Manager) autoloop(ctx context.Context) error {
 	// swaps for autoloop.
 	m.refreshAutoloopBudget(ctx)
 
-	suggestion, err := m.SuggestSwaps(ctx)
+	m.paramsLock.Lock()
+	suggestion, summary, err := m.suggestSwaps(ctx)
+	m.paramsLock.Unlock()
 	if err != nil {
 		return err
 	}
 
-	for _, swap := range suggestion.OutSwaps {
+	// Randomize the swaps that we dispatch before we record them, so that
+	// our journal reflects the swaps that we actually dispatched.
+	if m.params.Autoloop {
+		if err := m.jitterSuggestions(ctx, suggestion); err != nil {
+			return err
+		}
+	}
+
+	// Record our decision in our journal. Our suggested swaps are indexed
+	// with loop outs first, followed by loop ins and then static address
+	// loop ins.
+	decisionID, journaled := m.recordDecision(ctx, suggestion, summary)
+
+	// If our swaps require approval, we add them to our approval queue
+	// rather than dispatching them.
+	if m.params.Autoloop && m.params.RequireApproval {
+		return m.queueSuggestions(ctx, suggestion, decisionID)
+	}
+
+	for i, swap := range suggestion.OutSwaps {
 		// If we don't actually have dispatch of swaps enabled, log
 		// suggestions.
 		if !m.params.Autoloop {
@@ -471,13 +646,30 @@ func (m *Manager) autoloop(ctx context.Context) error {
 			swap.IsExternalAddr = true
 		}
 
+		// If a destination descriptor is set, each loop out pays to a
+		// fresh address of it.
+		err := m.setDescriptorDest(ctx, &swap, m.params.DestDescriptor)
+		if err != nil {
+			return err
+		}
+
+		var dispatched func(lntypes.Hash)
+		if journaled {
+			swapIndex := i
+			dispatched = func(hash lntypes.Hash) {
+				m.recordDecisionSwap(
+					ctx, decisionID, swapIndex, hash,
+				)
+			}
+		}
+
 		go m.dispatchStickyLoopOut(
 			ctx, swap, defaultAmountBackoffRetry,
-			defaultAmountBackoff,
+			defaultAmountBackoff, dispatched,
 		)
 	}
 
-	for _, in := range suggestion.InSwaps {
+	for i, in := range suggestion.InSwaps {
 		// If we don't actually have dispatch of swaps enabled, log
 		// suggestions.
 		if !m.params.Autoloop {
@@ -495,6 +687,41 @@ func (m *Manager) autoloop(ctx context.Context) error {
 		log.Infof("loop in automatically dispatched: hash: %v, "+
 			"address: p2wsh(%v), p2tr(%v)", loopIn.SwapHash,
 			loopIn.HtlcAddressP2WSH, loopIn.HtlcAddressP2TR)
+
+		if journaled {
+			m.recordDecisionSwap(
+				ctx, decisionID, len(suggestion.OutSwaps)+i,
+				loopIn.SwapHash,
+			)
+		}
+	}
+
+	staticIndex := len(suggestion.OutSwaps) + len(suggestion.InSwaps)
+	for i, in := range suggestion.StaticInSwaps {
+		// If we don't actually have dispatch of swaps enabled, log
+		// suggestions.
+		if !m.params.Autoloop {
+			log.Debugf("recommended static autoloop in: %v sats "+
+				"over %v", in.Amount, in.LastHop)
+
+			continue
+		}
+
+		hash, err := m.cfg.StaticLoopIn(
+			ctx, &in.StaticAddressLoopInRequest,
+		)
+		if err != nil {
+			return err
+		}
+
+		log.Infof("static loop in automatically dispatched: hash: "+
+			"%v, deposits: %v", hash, in.DepositOutpoints)
+
+		if journaled {
+			m.recordDecisionSwap(
+				ctx, decisionID, staticIndex+i, hash,
+			)
+		}
 	}
 
 	return nil
@@ -569,7 +796,7 @@ func (m *Manager) dispatchBestEasyAutoloopSwap(ctx context.Context) error {
 		return err
 	}
 
-	loopIn, err := m.cfg.ListLoopIn(ctx)
+	loopIn, err := m.listLoopIns(ctx)
 	if err != nil {
 		return err
 	}
@@ -588,6 +815,19 @@ func (m *Manager) dispatchBestEasyAutoloopSwap(ctx context.Context) error {
 		return err
 	}
 
+	// Easy autoloop is also restricted to our schedule and fee rate
+	// ceiling, so we skip this tick if we may not dispatch swaps now.
+	err = m.checkDispatchWindow(ctx)
+	var reasonErr *reasonError
+	switch {
+	case errors.As(err, &reasonErr):
+		log.Debugf("Easy autoloop deferred: %v", reasonErr.reason)
+		return nil
+
+	case err != nil:
+		return err
+	}
+
 	// Get all channels in order to calculate current total local balance.
 	channels, err := m.cfg.Lnd.Client.ListChannels(ctx, false, false)
 	if err != nil {
@@ -604,8 +844,31 @@ func (m *Manager) dispatchBestEasyAutoloopSwap(ctx context.Context) error {
 		usableChannels = append(usableChannels, channel)
 	}
 
-	// Since we're only autolooping-out we need to check if we are below
-	// the target, meaning that we already meet the requirements.
+	// Our total local balance includes all of our channels, but we only
+	// swap over channels that pass our channel age and peer uptime
+	// filters.
+	usableChannels, err = m.eligibleChannels(ctx, usableChannels)
+	if err != nil {
+		return err
+	}
+
+	// If our total local balance has dropped below our loop in target, we
+	// loop in to restore it.
+	if localTotal < m.params.EasyAutoloopInTarget {
+		return m.dispatchEasyAutoloopIn(
+			ctx, usableChannels, localTotal, loopOut, loopIn,
+		)
+	}
+
+	// If only a loop in target is set, we do not loop out.
+	if m.params.EasyAutoloopTarget == 0 &&
+		m.params.EasyAutoloopInTarget != 0 {
+
+		return nil
+	}
+
+	// For loop out we need to check if we are below the target, meaning
+	// that we already meet the requirements.
 	if localTotal <= m.params.EasyAutoloopTarget {
 		log.Debugf("total local balance %v below target %v",
 			localTotal, m.params.EasyAutoloopTarget)
@@ -659,8 +922,66 @@ func (m *Manager) dispatchBestEasyAutoloopSwap(ctx context.Context) error {
 		return err
 	}
 
-	// If no fee is set, override our current parameters in order to use the
-	// default percent limit of easy-autoloop.
+	easyParams := m.easyAutoloopParams()
+
+	// Set the swap outgoing channel to the chosen channel.
+	outgoing := []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(channel.ChannelID),
+	}
+
+	suggestion, err := builder.buildSwap(
+		ctx, channel.PubKeyBytes, outgoing, swapAmt, easyParams,
+	)
+	if err != nil {
+		return err
+	}
+
+	var swp loop.OutRequest
+	if t, ok := suggestion.(*loopOutSwapSuggestion); ok {
+		swp = t.OutRequest
+	} else {
+		return fmt.Errorf("unexpected swap suggestion type: %T", t)
+	}
+
+	err = m.setDescriptorDest(ctx, &swp, easyParams.DestDescriptor)
+	if err != nil {
+		return err
+	}
+
+	// Dispatch a sticky loop out.
+	go m.dispatchStickyLoopOut(
+		ctx, swp, defaultAmountBackoffRetry, defaultAmountBackoff, nil,
+	)
+
+	return nil
+}
+
+// setDescriptorDest sets the destination of a loop out to a fresh address of
+// the destination descriptor provided, if one is set. Loop outs that are built
+// for a descriptor are left without a destination address, so this must be
+// called before they are dispatched.
+func (m *Manager) setDescriptorDest(ctx context.Context, out *loop.OutRequest,
+	destDescriptor string) error {
+
+	if destDescriptor == "" {
+		return nil
+	}
+
+	addr, err := m.cfg.NextDescriptorAddr(ctx, destDescriptor)
+	if err != nil {
+		return err
+	}
+
+	out.DestAddr = addr
+	out.IsExternalAddr = true
+
+	return nil
+}
+
+// easyAutoloopParams returns the parameters that easy autoloop swaps are built
+// with. If no fee is set, our current parameters are overridden in order to use
+// the default percent limit of easy-autoloop.
+func (m *Manager) easyAutoloopParams() Parameters {
 	easyParams := m.params
 
 	switch feeLimit := easyParams.FeeLimit.(type) {
@@ -676,29 +997,101 @@ func (m *Manager) dispatchBestEasyAutoloopSwap(ctx context.Context) error {
 		}
 	}
 
-	// Set the swap outgoing channel to the chosen channel.
-	outgoing := []lnwire.ShortChannelID{
-		lnwire.NewShortChanIDFromInt(channel.ChannelID),
+	return easyParams
+}
+
+// dispatchEasyAutoloopIn dispatches a loop in to bring our total local balance
+// back up to our easy autoloop loop in target. The loop in is funded from our
+// on-chain wallet and routed through the peer that has the most remote balance
+// available.
+func (m *Manager) dispatchEasyAutoloopIn(ctx context.Context,
+	channels []lndclient.ChannelInfo, localTotal btcutil.Amount,
+	loopOut []*loopdb.LoopOut, loopIn []*loopdb.LoopIn) error {
+
+	restrictions, err := m.cfg.Restrictions(
+		ctx, swap.TypeIn, getInitiator(m.params),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Calculate the amount that we want to loop in. If it exceeds the max
+	// allowed clamp it to max.
+	amount := m.params.EasyAutoloopInTarget - localTotal
+	if amount > restrictions.Maximum {
+		amount = restrictions.Maximum
 	}
 
+	// We can't loop in more than our wallet currently holds.
+	walletBalance, err := m.cfg.Lnd.Client.WalletBalance(ctx)
+	if err != nil {
+		return err
+	}
+
+	if amount > walletBalance.Confirmed {
+		amount = walletBalance.Confirmed
+	}
+
+	// If the amount we want to loop in is less than the minimum we can't
+	// proceed with a swap, so we return early.
+	if amount < restrictions.Minimum {
+		log.Debugf("easy autoloop: loop in amount is below minimum "+
+			"swap size, minimum=%v, need to swap %v, wallet "+
+			"balance %v", restrictions.Minimum, amount,
+			walletBalance.Confirmed)
+
+		return nil
+	}
+
+	peer := m.pickEasyAutoloopPeer(channels, loopOut, loopIn)
+	if peer == nil {
+		return fmt.Errorf("no eligible peer for easy autoloop in")
+	}
+
+	log.Debugf("easy autoloop: picked peer %v with remote balance %v",
+		peer.pubkey, peer.incoming)
+
+	if amount > peer.incoming {
+		amount = peer.incoming
+	}
+
+	// Our peer may not have enough remote balance for a swap of the
+	// minimum size. Since we picked the peer with the most remote balance,
+	// no other peer can take the swap either, so we skip this round.
+	if amount < restrictions.Minimum {
+		log.Debugf("easy autoloop: remote balance %v of peer %v is "+
+			"below minimum swap size %v", peer.incoming,
+			peer.pubkey, restrictions.Minimum)
+
+		return nil
+	}
+
+	log.Debugf("easy autoloop: local_total=%v, in target=%v, "+
+		"attempting to loop in %v", localTotal,
+		m.params.EasyAutoloopInTarget, amount)
+
+	builder := newLoopInBuilder(m.cfg)
 	suggestion, err := builder.buildSwap(
-		ctx, channel.PubKeyBytes, outgoing, swapAmt, easyParams,
+		ctx, peer.pubkey, peer.channels, amount,
+		m.easyAutoloopParams(),
 	)
 	if err != nil {
 		return err
 	}
 
-	var swp loop.OutRequest
-	if t, ok := suggestion.(*loopOutSwapSuggestion); ok {
-		swp = t.OutRequest
-	} else {
-		return fmt.Errorf("unexpected swap suggestion type: %T", t)
+	in, ok := suggestion.(*loopInSwapSuggestion)
+	if !ok {
+		return fmt.Errorf("unexpected swap suggestion type: %T",
+			suggestion)
 	}
 
-	// Dispatch a sticky loop out.
-	go m.dispatchStickyLoopOut(
-		ctx, swp, defaultAmountBackoffRetry, defaultAmountBackoff,
-	)
+	loopInInfo, err := m.cfg.LoopIn(ctx, &in.LoopInRequest)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("easy autoloop: loop in dispatched: hash: %v, amount: %v, "+
+		"last hop: %v", loopInInfo.SwapHash, amount, peer.pubkey)
 
 	return nil
 }
@@ -718,7 +1111,7 @@ func (m *Manager) dispatchBestAssetEasyAutoloopSwap(ctx context.Context,
 		return err
 	}
 
-	loopIn, err := m.cfg.ListLoopIn(ctx)
+	loopIn, err := m.listLoopIns(ctx)
 	if err != nil {
 		return err
 	}
@@ -737,6 +1130,17 @@ func (m *Manager) dispatchBestAssetEasyAutoloopSwap(ctx context.Context,
 		return err
 	}
 
+	err = m.checkDispatchWindow(ctx)
+	var reasonErr *reasonError
+	switch {
+	case errors.As(err, &reasonErr):
+		log.Debugf("Easy asset autoloop deferred: %v", reasonErr.reason)
+		return nil
+
+	case err != nil:
+		return err
+	}
+
 	// Get all channels in order to calculate current total local balance.
 	channels, err := m.cfg.Lnd.Client.ListChannels(ctx, false, false)
 	if err != nil {
@@ -885,9 +1289,14 @@ func (m *Manager) dispatchBestAssetEasyAutoloopSwap(ctx context.Context,
 		return fmt.Errorf("unexpected swap suggestion type: %T", t)
 	}
 
+	err = m.setDescriptorDest(ctx, &swp, easyParams.DestDescriptor)
+	if err != nil {
+		return err
+	}
+
 	// Dispatch a sticky loop out.
 	go m.dispatchStickyLoopOut(
-		ctx, swp, defaultAmountBackoffRetry, defaultAmountBackoff,
+		ctx, swp, defaultAmountBackoffRetry, defaultAmountBackoff, nil,
 	)
 
 	return nil
@@ -902,6 +1311,10 @@ type Suggestions struct {
 	// InSwaps is the set of loop in swaps that we suggest executing.
 	InSwaps []loop.LoopInRequest
 
+	// StaticInSwaps is the set of loop in swaps funded by static address
+	// deposits that we suggest executing.
+	StaticInSwaps []StaticLoopInRequest
+
 	// DisqualifiedChans maps the set of channels that we do not recommend
 	// swaps on to the reason that we did not recommend a swap.
 	DisqualifiedChans map[lnwire.ShortChannelID]Reason
@@ -909,6 +1322,46 @@ type Suggestions struct {
 	// DisqualifiedPeers maps the set of peers that we do not recommend
 	// swaps for to the reason that they were excluded.
 	DisqualifiedPeers map[route.Vertex]Reason
+
+	// BackoffChans maps the channels that were disqualified because of
+	// ReasonFailureBackoff to the backoff that is currently applied to
+	// them. This map is nil if no channels are backing off.
+	BackoffChans map[lnwire.ShortChannelID]*FailureBackoff
+
+	// BackoffPeers maps the peers that were disqualified because of
+	// ReasonFailureBackoff to the backoff that is currently applied to
+	// them. This map is nil if no peers are backing off.
+	BackoffPeers map[route.Vertex]*FailureBackoff
+
+	// ChanProjections maps the channels that have a predictive rule set to
+	// their projected liquidity. This map is nil if no channels have
+	// predictive rules.
+	ChanProjections map[lnwire.ShortChannelID]*Projection
+
+	// PeerProjections maps the peers that have a predictive rule set to
+	// their projected liquidity. This map is nil if no peers have
+	// predictive rules.
+	PeerProjections map[route.Vertex]*Projection
+
+	// DisqualifiedNode is the reason that we did not recommend a swap for
+	// our node rule. This value is ReasonNone if we have no node rule, or
+	// if a swap was recommended for it.
+	DisqualifiedNode Reason
+
+	// ChanBalances maps the channels that have a rule set to the balances
+	// that their rule was applied to. This map is nil if we do not include
+	// pending liquidity in our balances.
+	ChanBalances map[lnwire.ShortChannelID]*TargetBalance
+
+	// PeerBalances maps the peers that have a rule set to the balances that
+	// their rule was applied to. This map is nil if we do not include
+	// pending liquidity in our balances.
+	PeerBalances map[route.Vertex]*TargetBalance
+
+	// NodeBalance is the balance that our node rule was applied to. This
+	// value is nil if we have no node rule, or if we do not include pending
+	// liquidity in our balances.
+	NodeBalance *TargetBalance
 }
 
 func newSuggestions() *Suggestions {
@@ -926,6 +1379,9 @@ func (s *Suggestions) addSwap(swap swapSuggestion) error {
 	case *loopInSwapSuggestion:
 		s.InSwaps = append(s.InSwaps, t.LoopInRequest)
 
+	case *staticLoopInSwapSuggestion:
+		s.StaticInSwaps = append(s.StaticInSwaps, t.StaticLoopInRequest)
+
 	default:
 		return fmt.Errorf("unexpected swap type: %T", swap)
 	}
@@ -933,6 +1389,90 @@ func (s *Suggestions) addSwap(swap swapSuggestion) error {
 	return nil
 }
 
+// addChanBackoff records the failure backoff that is applied to a channel that
+// was disqualified because of ReasonFailureBackoff.
+func (s *Suggestions) addChanBackoff(channel lnwire.ShortChannelID,
+	backoff *FailureBackoff) {
+
+	if backoff == nil {
+		return
+	}
+
+	if s.BackoffChans == nil {
+		s.BackoffChans = make(map[lnwire.ShortChannelID]*FailureBackoff)
+	}
+
+	s.BackoffChans[channel] = backoff
+}
+
+// addPeerBackoff records the failure backoff that is applied to a peer that
+// was disqualified because of ReasonFailureBackoff.
+func (s *Suggestions) addPeerBackoff(peer route.Vertex,
+	backoff *FailureBackoff) {
+
+	if backoff == nil {
+		return
+	}
+
+	if s.BackoffPeers == nil {
+		s.BackoffPeers = make(map[route.Vertex]*FailureBackoff)
+	}
+
+	s.BackoffPeers[peer] = backoff
+}
+
+// addChanProjection records the liquidity projection for a channel with a
+// predictive rule.
+func (s *Suggestions) addChanProjection(channel lnwire.ShortChannelID,
+	projection *Projection) {
+
+	if projection == nil {
+		return
+	}
+
+	if s.ChanProjections == nil {
+		s.ChanProjections = make(map[lnwire.ShortChannelID]*Projection)
+	}
+
+	s.ChanProjections[channel] = projection
+}
+
+// addChanBalance records the balances that a channel's rule was applied to.
+func (s *Suggestions) addChanBalance(channel lnwire.ShortChannelID,
+	balance *balances) {
+
+	if s.ChanBalances == nil {
+		s.ChanBalances = make(map[lnwire.ShortChannelID]*TargetBalance)
+	}
+
+	s.ChanBalances[channel] = newTargetBalance(balance)
+}
+
+// addPeerBalance records the balances that a peer's rule was applied to.
+func (s *Suggestions) addPeerBalance(peer route.Vertex, balance *balances) {
+	if s.PeerBalances == nil {
+		s.PeerBalances = make(map[route.Vertex]*TargetBalance)
+	}
+
+	s.PeerBalances[peer] = newTargetBalance(balance)
+}
+
+// addPeerProjection records the liquidity projection for a peer with a
+// predictive rule.
+func (s *Suggestions) addPeerProjection(peer route.Vertex,
+	projection *Projection) {
+
+	if projection == nil {
+		return
+	}
+
+	if s.PeerProjections == nil {
+		s.PeerProjections = make(map[route.Vertex]*Projection)
+	}
+
+	s.PeerProjections[peer] = projection
+}
+
 // singleReasonSuggestion is a helper function which returns a set of
 // suggestions where all of our rules are disqualified due to a reason that
 // applies to all of them (such as being out of budget).
@@ -947,6 +1487,10 @@ func (m *Manager) singleReasonSuggestion(reason Reason) *Suggestions {
 		resp.DisqualifiedPeers[peer] = reason
 	}
 
+	if m.params.NodeRule != nil {
+		resp.DisqualifiedNode = reason
+	}
+
 	return resp
 }
 
@@ -962,21 +1506,33 @@ func (m *Manager) SuggestSwaps(ctx context.Context) (
 	m.paramsLock.Lock()
 	defer m.paramsLock.Unlock()
 
+	suggestions, _, err := m.suggestSwaps(ctx)
+
+	return suggestions, err
+}
+
+// suggestSwaps returns a set of swap suggestions along with the summary of our
+// existing automated swaps that the suggestions were based on. The summary is
+// nil if we failed before our existing swaps were summarized. The caller must
+// hold the params lock.
+func (m *Manager) suggestSwaps(ctx context.Context) (*Suggestions,
+	*existingAutoLoopSummary, error) {
+
 	// If we have no rules set, exit early to avoid unnecessary calls to
 	// lnd and the server.
 	if !m.params.haveRules() {
-		return nil, ErrNoRules
+		return nil, nil, ErrNoRules
 	}
 
 	// Get restrictions placed on swaps by the server.
 	outRestrictions, err := m.getSwapRestrictions(ctx, swap.TypeOut)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	inRestrictions, err := m.getSwapRestrictions(ctx, swap.TypeIn)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// List our current set of swaps so that we can determine which channels
@@ -984,12 +1540,12 @@ func (m *Manager) SuggestSwaps(ctx context.Context) (
 	// with manual initiation of swaps.
 	loopOut, err := m.cfg.ListLoopOut(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	loopIn, err := m.cfg.ListLoopIn(ctx)
+	loopIn, err := m.listLoopIns(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Get a summary of our existing swaps so that we can check our autoloop
@@ -998,45 +1554,141 @@ func (m *Manager) SuggestSwaps(ctx context.Context) (
 
 	err = m.checkSummaryBudget(summary)
 	if err != nil {
-		return m.singleReasonSuggestion(ReasonBudgetElapsed), nil
+		resp := m.singleReasonSuggestion(ReasonBudgetElapsed)
+		return resp, summary, nil
 	}
 
 	allowedSwaps, err := m.checkSummaryInflight(summary)
 	if err != nil {
-		return m.singleReasonSuggestion(ReasonInFlight), nil
+		resp := m.singleReasonSuggestion(ReasonInFlight)
+		return resp, summary, nil
+	}
+
+	// Check whether we are currently allowed to dispatch swaps based on
+	// our schedule and on-chain fee rate ceiling.
+	err = m.checkDispatchWindow(ctx)
+	var reasonErr *reasonError
+	switch {
+	case errors.As(err, &reasonErr):
+		resp := m.singleReasonSuggestion(reasonErr.reason)
+		return resp, summary, nil
+
+	case err != nil:
+		return nil, nil, err
 	}
 
 	channels, err := m.cfg.Lnd.Client.ListChannels(ctx, false, false)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// Exclude channels that are too young, or that belong to peers that
+	// are not reliable enough, from our swaps.
+	filter, err := m.newChannelFilter(ctx, channels)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// If we count pending liquidity in our balances, we gather it now.
+	// Otherwise, our pending liquidity is nil and nothing is counted.
+	var pending *pendingLiquidity
+	if m.params.IncludePending {
+		pending, err = m.pendingLiquidity(ctx, channels, loopOut, loopIn)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	// Collect a map of channel IDs to peer pubkeys, and a set of per-peer
-	// balances which we will use for peer-level liquidity rules.
+	// balances which we will use for peer-level liquidity rules. Channels
+	// that don't pass our filter are not included in their peer's
+	// balances, and we track the reason that they were excluded so that
+	// we can report peers that have no eligible channels.
+	// We also collect the set of eligible channels that our node rule
+	// applies to, and their aggregate balances.
 	channelPeers := make(map[uint64]route.Vertex)
 	peerChannels := make(map[route.Vertex]*balances)
+	peerExcluded := make(map[route.Vertex]Reason)
+	nodeChannels := make([]lndclient.ChannelInfo, 0, len(channels))
+	nodeBalance := &balances{}
 	for _, channel := range channels {
+		channelPeers[channel.ChannelID] = channel.PubKeyBytes
+
 		if channelIsCustom(channel) {
 			continue
 		}
 
-		channelPeers[channel.ChannelID] = channel.PubKeyBytes
+		if reason := filter.check(channel); reason != ReasonNone {
+			peerExcluded[channel.PubKeyBytes] = reason
+			continue
+		}
+
+		nodeChannels = append(nodeChannels, channel)
+		nodeBalance.addChannel(channel)
+		pending.addHtlcs(nodeBalance, channel)
 
 		bal, ok := peerChannels[channel.PubKeyBytes]
 		if !ok {
-			bal = &balances{}
+			bal = &balances{
+				pubkey: channel.PubKeyBytes,
+			}
 		}
 
-		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
-		bal.channels = append(bal.channels, chanID)
-		bal.capacity += channel.Capacity
-		bal.incoming += channel.RemoteBalance
-		bal.outgoing += channel.LocalBalance
-		bal.pubkey = channel.PubKeyBytes
+		bal.addChannel(channel)
+		pending.addHtlcs(bal, channel)
 
 		peerChannels[channel.PubKeyBytes] = bal
 	}
 
+	// Channels that are pending open are only counted for peers that have
+	// eligible channels, because we can't yet tell whether the new
+	// channels will be eligible. Swaps that have not yet shifted liquidity
+	// in our channels are only counted for our node.
+	for peer, bal := range peerChannels {
+		pending.addOpens(bal, peer)
+		pending.addOpens(nodeBalance, peer)
+	}
+	pending.addSwaps(nodeBalance)
+
+	// If any of our rules are predictive, or we gate our loop outs on
+	// their routing revenue, we fetch our forwarding history for the
+	// longest lookback that we require.
+	var (
+		now    = m.cfg.Clock.Now()
+		events []lndclient.ForwardingEvent
+	)
+	havePredictive, lookback := m.params.haveForecasts()
+	if m.params.ROI != nil && m.params.ROI.Lookback > lookback {
+		lookback = m.params.ROI.Lookback
+	}
+
+	if havePredictive || m.params.ROI != nil {
+		events, err = m.forwardingHistory(ctx, now.Add(-lookback))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// project is a helper that projects a target's liquidity if its rule
+	// is predictive.
+	project := func(rule *SwapRule, balance *balances) *Projection {
+		if rule.Predictive == nil {
+			return nil
+		}
+
+		return newProjection(rule.Predictive, balance, events, now)
+	}
+
+	// If our loop ins are funded by static address deposits, we fetch our
+	// deposits once so that they are shared between all of our targets.
+	var deposits *depositPool
+	if m.params.StaticLoopIn {
+		deposits, err = m.staticDeposits(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// Get a summary of the channels and peers that are not eligible due
 	// to ongoing swaps.
 	traffic := m.currentSwapTraffic(loopOut, loopIn)
@@ -1046,31 +1698,103 @@ func (m *Manager) SuggestSwaps(ctx context.Context) (
 		resp        = newSuggestions()
 	)
 
-	for peer, balances := range peerChannels {
+	// We consider our peers in a deterministic order, so that resources
+	// that are shared between targets, such as static address deposits,
+	// are allocated consistently.
+	peers := make([]route.Vertex, 0, len(peerChannels))
+	for peer := range peerChannels {
+		peers = append(peers, peer)
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return bytes.Compare(peers[i][:], peers[j][:]) < 0
+	})
+
+	for peer, reason := range peerExcluded {
+		if _, ok := peerChannels[peer]; ok {
+			continue
+		}
+
+		if _, ok := m.params.PeerRules[peer]; ok {
+			resp.DisqualifiedPeers[peer] = reason
+		}
+	}
+
+	for _, peer := range peers {
+		balances := peerChannels[peer]
+
 		rule, haveRule := m.params.PeerRules[peer]
 		if !haveRule {
 			continue
 		}
 
+		projection := project(rule, balances)
+		resp.addPeerProjection(peer, projection)
+
+		if pending != nil {
+			resp.addPeerBalance(peer, balances)
+		}
+
 		suggestion, err := m.suggestSwap(
-			ctx, traffic, balances, rule, outRestrictions,
-			inRestrictions,
+			ctx, traffic, summary, balances, rule, projection,
+			outRestrictions, inRestrictions, deposits,
 		)
+		if err == nil {
+			err = m.params.ROI.check(events, now, suggestion)
+		}
+
 		var reasonErr *reasonError
 		if errors.As(err, &reasonErr) {
 			resp.DisqualifiedPeers[peer] = reasonErr.reason
+
+			if reasonErr.reason == ReasonFailureBackoff {
+				resp.addPeerBackoff(peer, traffic.targetBackoff(
+					rule.Type, peer, balances.channels,
+				))
+			}
+
 			continue
 		}
 
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		suggestions = append(suggestions, suggestion)
 	}
 
+	// Our node rule considers the aggregate balances of all of our
+	// eligible channels, and picks the channels or peer that we swap over.
+	var nodeSwap swapSuggestion
+	if m.params.NodeRule != nil {
+		if pending != nil {
+			resp.NodeBalance = newTargetBalance(nodeBalance)
+		}
+
+		nodeSwap, err = m.suggestNodeSwap(
+			ctx, traffic, nodeChannels, nodeBalance,
+			m.params.NodeRule, outRestrictions, inRestrictions,
+			deposits,
+		)
+		if err == nil {
+			err = m.params.ROI.check(events, now, nodeSwap)
+		}
+
+		var reasonErr *reasonError
+		switch {
+		case errors.As(err, &reasonErr):
+			resp.DisqualifiedNode = reasonErr.reason
+
+		case err != nil:
+			return nil, nil, err
+
+		default:
+			suggestions = append(suggestions, nodeSwap)
+		}
+	}
+
 	for _, channel := range channels {
 		balance := newBalances(channel)
+		pending.addHtlcs(balance, channel)
 
 		channelID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
 
@@ -1086,28 +1810,67 @@ func (m *Manager) SuggestSwaps(ctx context.Context) (
 			continue
 		}
 
+		if reason := filter.check(channel); reason != ReasonNone {
+			resp.DisqualifiedChans[channelID] = reason
+			continue
+		}
+
+		projection := project(rule, balance)
+		resp.addChanProjection(channelID, projection)
+
+		if pending != nil {
+			resp.addChanBalance(channelID, balance)
+		}
+
 		suggestion, err := m.suggestSwap(
-			ctx, traffic, balance, rule, outRestrictions,
-			inRestrictions,
+			ctx, traffic, summary, balance, rule, projection,
+			outRestrictions, inRestrictions, deposits,
 		)
+		if err == nil {
+			err = m.params.ROI.check(events, now, suggestion)
+		}
 
 		var reasonErr *reasonError
 		if errors.As(err, &reasonErr) {
 			resp.DisqualifiedChans[channelID] = reasonErr.reason
+
+			if reasonErr.reason == ReasonFailureBackoff {
+				resp.addChanBackoff(channelID,
+					traffic.targetBackoff(
+						rule.Type, balance.pubkey,
+						balance.channels,
+					),
+				)
+			}
+
 			continue
 		}
 
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		suggestions = append(suggestions, suggestion)
 	}
 
+	// Our asset rules are applied to the asset balances of the asset
+	// channels that our other rules skip.
+	if len(m.params.AssetRules) != 0 {
+		assetSuggestions, err := m.suggestAssetSwaps(
+			ctx, traffic, channels, filter, outRestrictions,
+			events, now, resp,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		suggestions = append(suggestions, assetSuggestions...)
+	}
+
 	// If we have no swaps to execute after we have applied all of our
 	// limits, just return our set of disqualified swaps.
 	if len(suggestions) == 0 {
-		return resp, nil
+		return resp, summary, nil
 	}
 
 	// Sort suggestions by amount in descending order.
@@ -1122,9 +1885,14 @@ func (m *Manager) SuggestSwaps(ctx context.Context) (
 	// setReason is a helper that adds a swap's channels to our disqualified
 	// list with the reason provided.
 	setReason := func(reason Reason, swap swapSuggestion) {
+		if swap == nodeSwap {
+			resp.DisqualifiedNode = reason
+			return
+		}
+
 		for _, peer := range swap.peers(channelPeers) {
 			_, ok := m.params.PeerRules[peer]
-			if !ok {
+			if !ok && !m.params.haveAssetPeerRule(peer) {
 				continue
 			}
 
@@ -1133,7 +1901,7 @@ func (m *Manager) SuggestSwaps(ctx context.Context) (
 
 		for _, channel := range swap.channels() {
 			_, ok := m.params.ChannelRules[channel]
-			if !ok {
+			if !ok && !m.params.haveAssetChannelRule(channel) {
 				continue
 			}
 
@@ -1168,7 +1936,7 @@ func (m *Manager) SuggestSwaps(ctx context.Context) (
 			available -= fees
 
 			if err := resp.addSwap(swap); err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		} else {
 			refreshTime := m.params.AutoFeeRefreshPeriod -
@@ -1181,14 +1949,21 @@ func (m *Manager) SuggestSwaps(ctx context.Context) (
 		}
 	}
 
-	return resp, nil
+	return resp, summary, nil
 }
 
 // suggestSwap checks whether we can currently perform a swap, and creates a
-// swap request for the rule provided.
+// swap request for the rule provided. If the rule has its own budget, the swap
+// is checked against the budget remaining for the rule's target. A projection
+// is provided for predictive rules, and is marked as predicted if the swap is
+// suggested because of the target's projected liquidity. If our loop ins are
+// funded by static address deposits, they are taken from the deposit pool
+// provided.
 func (m *Manager) suggestSwap(ctx context.Context, traffic *swapTraffic,
-	balance *balances, rule *SwapRule, outRestrictions *Restrictions,
-	inRestrictions *Restrictions) (swapSuggestion, error) {
+	summary *existingAutoLoopSummary, balance *balances, rule *SwapRule,
+	projection *Projection, outRestrictions *Restrictions,
+	inRestrictions *Restrictions,
+	deposits *depositPool) (swapSuggestion, error) {
 
 	var (
 		builder      swapBuilder
@@ -1206,6 +1981,12 @@ func (m *Manager) suggestSwap(ctx context.Context, traffic *swapTraffic,
 		builder = newLoopInBuilder(m.cfg)
 		restrictions = inRestrictions
 
+		if m.params.StaticLoopIn {
+			builder = newStaticLoopInBuilder(
+				m.cfg, deposits, inRestrictions,
+			)
+		}
+
 	default:
 		return nil, fmt.Errorf("unsupported swap type: %v", rule.Type)
 	}
@@ -1227,14 +2008,59 @@ func (m *Manager) suggestSwap(ctx context.Context, traffic *swapTraffic,
 
 	// Next, get the amount that we need to swap for this entity, skipping
 	// over it if no change in liquidity is required.
-	amount := rule.swapAmount(balance, restrictions, rule.Type)
+	amount, err := m.ruleAmount(ctx, balance, rule, projection, restrictions)
+	if err != nil {
+		return nil, err
+	}
+
 	if amount == 0 {
 		return nil, newReasonError(ReasonLiquidityOk)
 	}
 
-	return builder.buildSwap(
+	suggestion, err := builder.buildSwap(
 		ctx, balance.pubkey, balance.channels, amount, m.params,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkTargetBudget(summary, rule, balance, suggestion)
+	if err != nil {
+		return nil, err
+	}
+
+	return suggestion, nil
+}
+
+// ruleAmount returns the amount that a rule requires us to swap for the
+// balances provided, or zero if no swap is required. If the rule's threshold
+// does not require a swap, we check whether we can swap early because on-chain
+// fees are low, and then whether the target's projected liquidity crosses the
+// threshold. The projection is marked as predicted if it requires a swap.
+func (m *Manager) ruleAmount(ctx context.Context, balance *balances,
+	rule *SwapRule, projection *Projection,
+	restrictions *Restrictions) (btcutil.Amount, error) {
+
+	amount := rule.swapAmount(balance, restrictions, rule.Type)
+	if amount != 0 {
+		return amount, nil
+	}
+
+	amount, err := m.opportunisticAmount(ctx, balance, rule, restrictions)
+	if err != nil {
+		return 0, err
+	}
+
+	if amount != 0 {
+		return amount, nil
+	}
+
+	amount = predictedAmount(rule, balance, projection, restrictions)
+	if amount != 0 {
+		projection.Predicted = true
+	}
+
+	return amount, nil
 }
 
 // getSwapRestrictions queries the server for its latest swap size restrictions,
@@ -1301,6 +2127,11 @@ type existingAutoLoopSummary struct {
 	// it can only lead to dispatching fewer swaps than we could have (not
 	// too many).
 	inFlightCount int
+
+	// swapFees records the fees of each automated swap along with the
+	// targets it was dispatched for, so that we can check per-target
+	// budgets.
+	swapFees []autoloopFees
 }
 
 // totalFees returns the total amount of fees that automatically dispatched
@@ -1332,21 +2163,41 @@ func (m *Manager) checkExistingAutoLoops(_ context.Context,
 		// it has succeeded or failed so we just record our actual fees
 		// for the swap provided that the swap completed after our
 		// budget start date.
-		if out.State().State.Type() == loopdb.StateTypePending {
+		outFees := autoloopFees{
+			pending: out.State().State.Type() ==
+				loopdb.StateTypePending,
+			lastUpdate: out.LastUpdateTime(),
+		}
+
+		for _, id := range out.Contract.OutgoingChanSet {
+			outFees.channels = append(
+				outFees.channels,
+				lnwire.NewShortChanIDFromInt(id),
+			)
+		}
+
+		if outFees.pending {
 			summary.inFlightCount++
 
-			summary.pendingFees += worstCaseOutFees(
+			outFees.fees = worstCaseOutFees(
 				out.Contract.MaxPrepayRoutingFee,
 				out.Contract.MaxSwapRoutingFee,
 				out.Contract.MaxSwapFee,
 				out.Contract.MaxMinerFee,
 			)
-		} else if out.LastUpdateTime().After(
-			m.params.AutoloopBudgetLastRefresh,
-		) {
+			summary.pendingFees += outFees.fees
+		} else {
+			outFees.fees = out.State().Cost.Total()
 
-			summary.spentFees += out.State().Cost.Total()
+			if out.LastUpdateTime().After(
+				m.params.AutoloopBudgetLastRefresh,
+			) {
+
+				summary.spentFees += outFees.fees
+			}
 		}
+
+		summary.swapFees = append(summary.swapFees, outFees)
 	}
 
 	for _, in := range loopIns {
@@ -1361,15 +2212,29 @@ func (m *Manager) checkExistingAutoLoops(_ context.Context,
 		// If an autoloop is in a pending state, we always count it in
 		// our current budget, and record the worst-case fees for it,
 		// because we do not know how it will resolve.
+		inFees := autoloopFees{
+			peer:       in.Contract.LastHop,
+			pending:    pending,
+			lastUpdate: in.LastUpdateTime(),
+		}
+
 		if pending {
 			summary.inFlightCount++
-			summary.pendingFees += worstCaseInFees(
+
+			inFees.fees = worstCaseInFees(
 				in.Contract.MaxMinerFee, in.Contract.MaxSwapFee,
 				defaultLoopInSweepFee,
 			)
-		} else if inBudget {
-			summary.spentFees += in.State().Cost.Total()
+			summary.pendingFees += inFees.fees
+		} else {
+			inFees.fees = in.State().Cost.Total()
+
+			if inBudget {
+				summary.spentFees += inFees.fees
+			}
 		}
+
+		summary.swapFees = append(summary.swapFees, inFees)
 	}
 
 	return &summary
@@ -1382,11 +2247,38 @@ func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
 	loopIn []*loopdb.LoopIn) *swapTraffic {
 
 	traffic := newSwapTraffic()
+	now := m.cfg.Clock.Now()
+
+	// If loop out swaps failed due to off chain payment, we add all of
+	// their channels to a set of recently failed channels if they are
+	// still within their backoff period. It is possible that not all of
+	// these channels were used for the swap, but we play it safe and back
+	// off for all of them. The backoff grows with every consecutive
+	// failure of a channel, and is reset once a swap over the channel
+	// succeeds.
+	//
+	// We only backoff for off temporary failures. In the case of chain
+	// payment failures, our swap failed to route and we do not want to
+	// repeatedly try to route through bad channels which remain
+	// unbalanced because they cannot route a swap, so we backoff.
+	for chanID, failure := range loopOutFailures(loopOut) {
+		failure.Backoff = m.params.failureBackoff(failure.Failures)
+
+		if failure.backoffUntil().After(now) {
+			traffic.failedLoopOut[chanID] = failure
+		}
+	}
 
-	// Failure cutoff is the most recent failure timestamp we will still
-	// consider a channel eligible. Any channels involved in swaps that have
-	// failed since this point will not be considered.
-	failureCutoff := m.cfg.Clock.Now().Add(m.params.FailureBackOff * -1)
+	// If a loop in swap failed with an on-chain timeout, the server could
+	// not route to us. We add the peer to our backoff list so that there's
+	// some time for routing conditions to improve.
+	for peer, failure := range loopInFailures(loopIn) {
+		failure.Backoff = m.params.failureBackoff(failure.Failures)
+
+		if failure.backoffUntil().After(now) {
+			traffic.failedLoopIn[peer] = failure
+		}
+	}
 
 	for _, out := range loopOut {
 		var (
@@ -1394,31 +2286,6 @@ func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
 			chanSet = out.Contract.OutgoingChanSet
 		)
 
-		// If a loop out swap failed due to off chain payment after our
-		// failure cutoff, we add all of its channels to a set of
-		// recently failed channels. It is possible that not all of
-		// these channels were used for the swap, but we play it safe
-		// and back off for all of them.
-		//
-		// We only backoff for off temporary failures. In the case of
-		// chain payment failures, our swap failed to route and we do
-		// not want to repeatedly try to route through bad channels
-		// which remain unbalanced because they cannot route a swap, so
-		// we backoff.
-		if state == loopdb.StateFailOffchainPayments {
-			failedAt := out.LastUpdate().Time
-
-			if failedAt.After(failureCutoff) {
-				for _, id := range chanSet {
-					chanID := lnwire.NewShortChanIDFromInt(
-						id,
-					)
-
-					traffic.failedLoopOut[chanID] = failedAt
-				}
-			}
-		}
-
 		// Skip completed swaps, they can't affect our channel balances.
 		// Swaps that fail temporarily are considered to be in a pending
 		// state, so we will also check that channels being used by
@@ -1443,26 +2310,15 @@ func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
 
 		pubkey := *in.Contract.LastHop
 
-		switch {
 		// Include any pending swaps in our ongoing set of swaps. Swaps
 		// that reached InvoiceSettled are not considered ongoing since
 		// from the client's perspective the swap is complete. This
 		// consideration allows the client to dispatch the next autoloop
 		// in once an invoice for a previous swap is settled.
-		case in.State().State.Type() == loopdb.StateTypePending &&
-			in.State().State != loopdb.StateInvoiceSettled:
+		if in.State().State.Type() == loopdb.StateTypePending &&
+			in.State().State != loopdb.StateInvoiceSettled {
 
 			traffic.ongoingLoopIn[pubkey] = true
-
-		// If a swap failed with an on-chain timeout, the server could
-		// not route to us. We add it to our backoff list so that
-		// there's some time for routing conditions to improve.
-		case in.State().State == loopdb.StateFailTimeout:
-			failedAt := in.LastUpdate().Time
-
-			if failedAt.After(failureCutoff) {
-				traffic.failedLoopIn[pubkey] = failedAt
-			}
 		}
 	}
 
@@ -1471,32 +2327,49 @@ func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
 
 // refreshAutoloopBudget checks whether the elapsed time since our last autoloop
 // budget refresh is greater than our configured refresh period. If so, the last
-// refresh timestamp.
+// refresh timestamp. The budgets of individual channel and peer rules are
+// refreshed in the same way.
 func (m *Manager) refreshAutoloopBudget(ctx context.Context) {
-	if time.Since(m.params.AutoloopBudgetLastRefresh) >
+	var refreshed bool
+	now := m.cfg.Clock.Now()
+	if now.Sub(m.params.AutoloopBudgetLastRefresh) >
 		m.params.AutoFeeRefreshPeriod {
 
 		log.Debug("Refreshing autoloop budget")
-		m.params.AutoloopBudgetLastRefresh = m.cfg.Clock.Now()
+		m.params.AutoloopBudgetLastRefresh = now
+		refreshed = true
+	}
 
-		paramsRpc, err := ParametersToRpc(m.params)
-		if err != nil {
-			log.Errorf("Error converting parameters to rpc: %v",
-				err)
-			return
-		}
+	// Refresh the budgets of any channels or peers that have their own
+	// budget set.
+	if m.params.refreshTargetBudgets(now) {
+		log.Debug("Refreshed channel/peer autoloop budgets")
+		refreshed = true
+	}
 
-		err = m.saveParams(ctx, paramsRpc)
-		if err != nil {
-			log.Errorf("Error saving parameters: %v", err)
-		}
+	if !refreshed {
+		return
+	}
+
+	paramsRpc, err := ParametersToRpc(m.params)
+	if err != nil {
+		log.Errorf("Error converting parameters to rpc: %v", err)
+		return
+	}
+
+	err = m.saveParams(ctx, paramsRpc)
+	if err != nil {
+		log.Errorf("Error saving parameters: %v", err)
 	}
 }
 
 // dispatchStickyLoopOut attempts to dispatch a loop out swap that will
-// automatically retry its execution with an amount based backoff.
+// automatically retry its execution with an amount based backoff. If a
+// dispatched callback is provided, it is called with the hash of each swap
+// that is dispatched.
 func (m *Manager) dispatchStickyLoopOut(ctx context.Context,
-	out loop.OutRequest, retryCount uint16, amountBackoff float64) {
+	out loop.OutRequest, retryCount uint16, amountBackoff float64,
+	dispatched func(lntypes.Hash)) {
 
 	// Check our sticky loop counter to decide whether we should continue
 	// executing this loop.
@@ -1529,6 +2402,10 @@ func (m *Manager) dispatchStickyLoopOut(ctx context.Context,
 			"address: %v, amount %v", swap.SwapHash,
 			swap.HtlcAddress, out.Amount)
 
+		if dispatched != nil {
+			dispatched(swap.SwapHash)
+		}
+
 		updates := make(chan *loopdb.SwapState, 1)
 
 		// Monitor the swap state and write the desired update to the
@@ -1681,7 +2558,7 @@ func (m *Manager) pickEasyAutoloopChannel(channels []lndclient.ChannelInfo,
 		if recentFail {
 			log.Debugf("Channel %v cannot be used for easy "+
 				"autoloop: last failed swap was at %v",
-				channel.ChannelID, lastFail)
+				channel.ChannelID, lastFail.LastFailure)
 			continue
 		}
 
@@ -1715,6 +2592,78 @@ func (m *Manager) pickEasyAutoloopChannel(channels []lndclient.ChannelInfo,
 	return nil
 }
 
+// pickEasyAutoloopPeer picks the peer to be used as the last hop of an easy
+// autoloop loop in. This function prioritizes the peer with the highest remote
+// balance over its active channels, skipping peers that are used by ongoing
+// loop ins or have recently failed.
+func (m *Manager) pickEasyAutoloopPeer(channels []lndclient.ChannelInfo,
+	loopOut []*loopdb.LoopOut, loopIn []*loopdb.LoopIn) *balances {
+
+	traffic := m.currentSwapTraffic(loopOut, loopIn)
+
+	peers := make(map[route.Vertex]*balances)
+	for _, channel := range channels {
+		if !channel.Active {
+			log.Debugf("Channel %v cannot be used for easy "+
+				"autoloop in: inactive", channel.ChannelID)
+			continue
+		}
+
+		peer, ok := peers[channel.PubKeyBytes]
+		if !ok {
+			peer = &balances{
+				pubkey: channel.PubKeyBytes,
+			}
+			peers[channel.PubKeyBytes] = peer
+		}
+
+		peer.channels = append(
+			peer.channels,
+			lnwire.NewShortChanIDFromInt(channel.ChannelID),
+		)
+		peer.capacity += channel.Capacity
+		peer.incoming += channel.RemoteBalance
+		peer.outgoing += channel.LocalBalance
+	}
+
+	candidates := make([]*balances, 0, len(peers))
+	for _, peer := range peers {
+		candidates = append(candidates, peer)
+	}
+
+	// Sort the candidate peers based on descending remote balance, using
+	// their pubkey as a tie-breaker so that our choice is deterministic.
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].incoming != candidates[j].incoming {
+			return candidates[i].incoming > candidates[j].incoming
+		}
+
+		return bytes.Compare(
+			candidates[i].pubkey[:], candidates[j].pubkey[:],
+		) < 0
+	})
+
+	for _, peer := range candidates {
+		if traffic.ongoingLoopIn[peer.pubkey] {
+			log.Debugf("Peer %v cannot be used for easy autoloop "+
+				"in: ongoing swap", peer.pubkey)
+			continue
+		}
+
+		lastFail, recentFail := traffic.failedLoopIn[peer.pubkey]
+		if recentFail {
+			log.Debugf("Peer %v cannot be used for easy autoloop "+
+				"in: last failed swap was at %v", peer.pubkey,
+				lastFail.LastFailure)
+			continue
+		}
+
+		return peer
+	}
+
+	return nil
+}
+
 func (m *Manager) numActiveStickyLoops() int {
 	m.activeStickyLock.Lock()
 	defer m.activeStickyLock.Unlock()
@@ -1749,10 +2698,10 @@ func (m *Manager) checkSummaryInflight(
 
 func getInitiator(params Parameters) string {
 	if params.EasyAutoloop {
-		return "easy-autoloop"
+		return easyAutoloopSwapInitiator
 	}
 
-	return "autoloop"
+	return autoloopSwapInitiator
 }
 
 // isAutoloopLabel is a helper function that returns a flag indicating whether
@@ -1776,20 +2725,50 @@ func isAutoloopLabel(label string) bool {
 }
 
 // swapTraffic contains a summary of our current and previously failed swaps.
+// The failed sets only contain targets that are still within their failure
+// backoff period.
 type swapTraffic struct {
 	ongoingLoopOut map[lnwire.ShortChannelID]bool
 	ongoingLoopIn  map[route.Vertex]bool
-	failedLoopOut  map[lnwire.ShortChannelID]time.Time
-	failedLoopIn   map[route.Vertex]time.Time
+	failedLoopOut  map[lnwire.ShortChannelID]*FailureBackoff
+	failedLoopIn   map[route.Vertex]*FailureBackoff
 }
 
 func newSwapTraffic() *swapTraffic {
 	return &swapTraffic{
 		ongoingLoopOut: make(map[lnwire.ShortChannelID]bool),
 		ongoingLoopIn:  make(map[route.Vertex]bool),
-		failedLoopOut:  make(map[lnwire.ShortChannelID]time.Time),
-		failedLoopIn:   make(map[route.Vertex]time.Time),
+		failedLoopOut:  make(map[lnwire.ShortChannelID]*FailureBackoff),
+		failedLoopIn:   make(map[route.Vertex]*FailureBackoff),
+	}
+}
+
+// targetBackoff returns the failure backoff that applies to a swap of the type
+// provided for the peer and channels provided, or nil if the target is not
+// backing off. If a loop out target has multiple channels that are backing
+// off, the backoff that ends last is returned.
+func (s *swapTraffic) targetBackoff(swapType swap.Type, peer route.Vertex,
+	channels []lnwire.ShortChannelID) *FailureBackoff {
+
+	if swapType == swap.TypeIn {
+		return s.failedLoopIn[peer]
 	}
+
+	var backoff *FailureBackoff
+	for _, channel := range channels {
+		failure, ok := s.failedLoopOut[channel]
+		if !ok {
+			continue
+		}
+
+		if backoff == nil ||
+			failure.backoffUntil().After(backoff.backoffUntil()) {
+
+			backoff = failure
+		}
+	}
+
+	return backoff
 }
 
 // satPerKwToSatPerVByte converts sat per kWeight to sat per vByte.
@@ -2,12 +2,15 @@ package liquidity
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/descriptor"
 	"github.com/lightninglabs/loop/labels"
 	"github.com/lightninglabs/loop/loopdb"
 	clientrpc "github.com/lightninglabs/loop/looprpc"
@@ -254,6 +257,13 @@ func TestParameters(t *testing.T) {
 	}
 	err = manager.setParameters(context.Background(), expected)
 	require.Equal(t, ErrZeroChannelID, err)
+
+	// Easy autoloop's loop in target must be below its loop out target.
+	expected.ChannelRules = nil
+	expected.EasyAutoloopTarget = 100000
+	expected.EasyAutoloopInTarget = 100000
+	err = manager.setParameters(context.Background(), expected)
+	require.Equal(t, ErrEasyAutoloopInTarget, err)
 }
 
 // TestPersistParams tests reading and writing of parameters for our manager.
@@ -484,6 +494,13 @@ func TestRestrictedSuggestions(t *testing.T) {
 					chanID1: ReasonFailureBackoff,
 				},
 				DisqualifiedPeers: noPeersDisqualified,
+				BackoffChans: map[lnwire.ShortChannelID]*FailureBackoff{
+					chanID1: {
+						Failures:    1,
+						LastFailure: failedWithinTimeout.Time,
+						Backoff:     defaultFailureBackoff,
+					},
+				},
 			},
 		},
 		{
@@ -678,6 +695,585 @@ func TestSweepFeeLimit(t *testing.T) {
 	}
 }
 
+// TestDispatchWindow tests restriction of swap suggestions to our schedule
+// windows and fee rate ceiling.
+func TestDispatchWindow(t *testing.T) {
+	// Our test time is a Thursday at midnight UTC.
+	var (
+		thursday = ScheduleWindow{
+			Days:      []time.Weekday{time.Thursday},
+			StartHour: 0,
+			EndHour:   6,
+		}
+
+		weekend = ScheduleWindow{
+			Days:      []time.Weekday{time.Saturday, time.Sunday},
+			StartHour: 0,
+			EndHour:   24,
+		}
+
+		everyDayLate = ScheduleWindow{
+			StartHour: 22,
+			EndHour:   24,
+		}
+
+		chan1Out = &Suggestions{
+			OutSwaps: []loop.OutRequest{
+				chan1Rec,
+			},
+			DisqualifiedChans: noneDisqualified,
+			DisqualifiedPeers: noPeersDisqualified,
+		}
+	)
+
+	tests := []struct {
+		name        string
+		schedule    []ScheduleWindow
+		ceiling     chainfee.SatPerKWeight
+		suggestions *Suggestions
+	}{
+		{
+			name:        "no schedule or ceiling",
+			suggestions: chan1Out,
+		},
+		{
+			name: "within schedule",
+			schedule: []ScheduleWindow{
+				weekend, thursday,
+			},
+			suggestions: chan1Out,
+		},
+		{
+			name: "outside schedule",
+			schedule: []ScheduleWindow{
+				weekend, everyDayLate,
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonOutsideSchedule,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:        "fee rate at ceiling",
+			ceiling:     defaultSweepFeeRateLimit,
+			suggestions: chan1Out,
+		},
+		{
+			name:    "fee rate above ceiling",
+			ceiling: defaultSweepFeeRateLimit - 1,
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonFeeRateTooHigh,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+			}
+
+			params := defaultParameters
+			params.AutoloopBudgetLastRefresh = testBudgetStart
+			params.ScheduleWindows = testCase.schedule
+			params.FeeRateCeiling = testCase.ceiling
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestOpportunisticSuggestions tests suggesting of loop outs for channels
+// that are within the opportunistic band of their rule when fees are low.
+func TestOpportunisticSuggestions(t *testing.T) {
+	// Our channel has 45% incoming liquidity, so it does not require a
+	// swap for a 40% incoming threshold, but is within a 10% band.
+	channel := lndclient.ChannelInfo{
+		ChannelID:     chanID1.ToUint64(),
+		PubKeyBytes:   peer1,
+		LocalBalance:  5500,
+		RemoteBalance: 4500,
+		Capacity:      10000,
+	}
+
+	// With our threshold raised to 50%, we aim for the midpoint of 75%
+	// incoming liquidity, so we swap 3000 sats.
+	var (
+		amount          = btcutil.Amount(3000)
+		prepay, routing = testPPMFees(defaultFeePPM, testQuote, amount)
+
+		bandRec = loop.OutRequest{
+			Amount:              amount,
+			OutgoingChanSet:     loopdb.ChannelSet{chanID1.ToUint64()},
+			MaxPrepayRoutingFee: prepay,
+			MaxSwapRoutingFee:   routing,
+			MaxMinerFee: scaleMaxMinerFee(
+				scaleMinerFee(testQuote.MinerFee),
+			),
+			MaxSwapFee:      testQuote.SwapFee,
+			MaxPrepayAmount: testQuote.PrepayAmount,
+			SweepConfTarget: defaultConfTarget,
+			Initiator:       autoloopSwapInitiator,
+		}
+	)
+
+	tests := []struct {
+		name        string
+		band        *OpportunisticBand
+		budget      btcutil.Amount
+		suggestions *Suggestions
+	}{
+		{
+			name: "no band",
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonLiquidityOk,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "fees low, within band",
+			band: &OpportunisticBand{
+				Percent:    10,
+				MaxFeeRate: defaultSweepFeeRateLimit,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					bandRec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "fees low, outside band",
+			band: &OpportunisticBand{
+				Percent:    5,
+				MaxFeeRate: defaultSweepFeeRateLimit,
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonLiquidityOk,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "fees too high for band",
+			band: &OpportunisticBand{
+				Percent:    10,
+				MaxFeeRate: defaultSweepFeeRateLimit - 1,
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonLiquidityOk,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "budget respected",
+			band: &OpportunisticBand{
+				Percent:    10,
+				MaxFeeRate: defaultSweepFeeRateLimit,
+			},
+			budget: 1,
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonBudgetInsufficient,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel,
+			}
+
+			params := defaultParameters
+			params.AutoloopBudgetLastRefresh = testBudgetStart
+			if testCase.budget != 0 {
+				params.AutoFeeBudget = testCase.budget
+			}
+
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: {
+					ThresholdRule: NewThresholdRule(40, 0),
+					Type:          swap.TypeOut,
+					Opportunistic: testCase.band,
+				},
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestPredictiveSuggestions tests suggestion of swaps for predictive rules
+// based on our forwarding history.
+func TestPredictiveSuggestions(t *testing.T) {
+	// Our channel has 30% incoming liquidity, so it does not require a
+	// swap for a 25% incoming threshold based on its current balances.
+	channel := lndclient.ChannelInfo{
+		ChannelID:     chanID1.ToUint64(),
+		PubKeyBytes:   peer1,
+		LocalBalance:  7000,
+		RemoteBalance: 3000,
+		Capacity:      10000,
+	}
+
+	var (
+		lookback = time.Hour * 24
+		horizon  = time.Hour * 12
+
+		// inbound is a forward into our channel within our lookback,
+		// which drains 2000 sats of incoming liquidity. Over our
+		// horizon, we project that 1000 sats will be drained, leaving
+		// us with 20% incoming liquidity.
+		inbound = lndclient.ForwardingEvent{
+			Timestamp:     testTime.Add(time.Hour * -1),
+			ChannelIn:     chanID1.ToUint64(),
+			ChannelOut:    chanID2.ToUint64(),
+			AmountMsatIn:  2_000_000,
+			AmountMsatOut: 2_000_000,
+		}
+
+		// outbound is a forward out of our channel, which increases
+		// our incoming liquidity.
+		outbound = lndclient.ForwardingEvent{
+			Timestamp:     testTime.Add(time.Hour * -1),
+			ChannelIn:     chanID2.ToUint64(),
+			ChannelOut:    chanID1.ToUint64(),
+			AmountMsatIn:  2_000_000,
+			AmountMsatOut: 2_000_000,
+		}
+
+		// stale is an inbound forward that is outside of our lookback.
+		stale = lndclient.ForwardingEvent{
+			Timestamp:     testTime.Add(lookback * -2),
+			ChannelIn:     chanID1.ToUint64(),
+			ChannelOut:    chanID2.ToUint64(),
+			AmountMsatIn:  2_000_000,
+			AmountMsatOut: 2_000_000,
+		}
+
+		// unchanged is the projection for a channel that has no
+		// forwards within our lookback.
+		unchanged = &Projection{
+			Lookback: lookback,
+			Horizon:  horizon,
+			Incoming: 3000,
+			Outgoing: 7000,
+		}
+	)
+
+	outRequest := func(amount btcutil.Amount) loop.OutRequest {
+		prepay, routing := testPPMFees(defaultFeePPM, testQuote, amount)
+
+		return loop.OutRequest{
+			Amount:              amount,
+			OutgoingChanSet:     loopdb.ChannelSet{chanID1.ToUint64()},
+			MaxPrepayRoutingFee: prepay,
+			MaxSwapRoutingFee:   routing,
+			MaxMinerFee: scaleMaxMinerFee(
+				scaleMinerFee(testQuote.MinerFee),
+			),
+			MaxSwapFee:      testQuote.SwapFee,
+			MaxPrepayAmount: testQuote.PrepayAmount,
+			SweepConfTarget: defaultConfTarget,
+			Initiator:       autoloopSwapInitiator,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		rule        *ThresholdRule
+		events      []lndclient.ForwardingEvent
+		suggestions *Suggestions
+	}{
+		{
+			name: "no forwards",
+			rule: NewThresholdRule(25, 0),
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonLiquidityOk,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+				ChanProjections: map[lnwire.ShortChannelID]*Projection{
+					chanID1: unchanged,
+				},
+			},
+		},
+		{
+			name:   "forwards outside of lookback",
+			rule:   NewThresholdRule(25, 0),
+			events: []lndclient.ForwardingEvent{stale},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonLiquidityOk,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+				ChanProjections: map[lnwire.ShortChannelID]*Projection{
+					chanID1: unchanged,
+				},
+			},
+		},
+		{
+			name:   "incoming liquidity increasing",
+			rule:   NewThresholdRule(25, 0),
+			events: []lndclient.ForwardingEvent{outbound},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonLiquidityOk,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+				ChanProjections: map[lnwire.ShortChannelID]*Projection{
+					chanID1: {
+						NetOutflow: 2000,
+						Lookback:   lookback,
+						Horizon:    horizon,
+						Incoming:   4000,
+						Outgoing:   6000,
+					},
+				},
+			},
+		},
+		{
+			// With a projected incoming balance of 2000, we aim
+			// for the midpoint of 6250 incoming.
+			name:   "projected below threshold",
+			rule:   NewThresholdRule(25, 0),
+			events: []lndclient.ForwardingEvent{inbound, stale},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					outRequest(4250),
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				ChanProjections: map[lnwire.ShortChannelID]*Projection{
+					chanID1: {
+						NetOutflow: -2000,
+						Lookback:   lookback,
+						Horizon:    horizon,
+						Incoming:   2000,
+						Outgoing:   8000,
+						Predicted:  true,
+					},
+				},
+			},
+		},
+		{
+			// Our projected balances would allow a swap of 1250
+			// without dipping below our 60% outgoing reserve, but
+			// we currently only have 1000 available.
+			name:   "limited by current reserve",
+			rule:   NewThresholdRule(25, 60),
+			events: []lndclient.ForwardingEvent{inbound},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					outRequest(1000),
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				ChanProjections: map[lnwire.ShortChannelID]*Projection{
+					chanID1: {
+						NetOutflow: -2000,
+						Lookback:   lookback,
+						Horizon:    horizon,
+						Incoming:   2000,
+						Outgoing:   8000,
+						Predicted:  true,
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel,
+			}
+			lnd.ForwardingEvents = testCase.events
+
+			params := defaultParameters
+			params.AutoloopBudgetLastRefresh = testBudgetStart
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: {
+					ThresholdRule: testCase.rule,
+					Type:          swap.TypeOut,
+					Predictive: &PredictiveRule{
+						Lookback: lookback,
+						Horizon:  horizon,
+					},
+				},
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestTargetBudget tests limiting of swap suggestions by the budgets set on
+// individual rules.
+func TestTargetBudget(t *testing.T) {
+	var (
+		spent = btcutil.Amount(100)
+
+		chan1Fees = worstCaseOutFees(
+			chan1Rec.MaxPrepayRoutingFee, chan1Rec.MaxSwapRoutingFee,
+			chan1Rec.MaxSwapFee, chan1Rec.MaxMinerFee,
+		)
+
+		// completedOut is an automatically dispatched swap over
+		// channel 1 that completed just before our test time.
+		completedOut = &loopdb.LoopOut{
+			Contract: &loopdb.LoopOutContract{
+				SwapContract: loopdb.SwapContract{
+					Label: labels.AutoloopLabel(
+						swap.TypeOut,
+					),
+					InitiationTime: testBudgetStart,
+				},
+				OutgoingChanSet: loopdb.ChannelSet{
+					chanID1.ToUint64(),
+				},
+			},
+			Loop: loopdb.Loop{
+				Events: []*loopdb.LoopEvent{
+					{
+						SwapStateData: loopdb.SwapStateData{
+							State: loopdb.StateSuccess,
+							Cost: loopdb.SwapCost{
+								Server: spent,
+							},
+						},
+						Time: testTime.Add(-time.Minute),
+					},
+				},
+			},
+		}
+
+		chan1Out = &Suggestions{
+			OutSwaps: []loop.OutRequest{
+				chan1Rec,
+			},
+			DisqualifiedChans: noneDisqualified,
+			DisqualifiedPeers: noPeersDisqualified,
+		}
+
+		chan1Budget = &Suggestions{
+			DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+				chanID1: ReasonTargetBudget,
+			},
+			DisqualifiedPeers: noPeersDisqualified,
+		}
+	)
+
+	tests := []struct {
+		name        string
+		budget      *TargetBudget
+		suggestions *Suggestions
+	}{
+		{
+			name:        "no target budget",
+			suggestions: chan1Out,
+		},
+		{
+			name: "budget covers spent and swap",
+			budget: &TargetBudget{
+				Amount:        spent + chan1Fees,
+				RefreshPeriod: time.Hour,
+				LastRefresh:   testBudgetStart,
+			},
+			suggestions: chan1Out,
+		},
+		{
+			name: "budget insufficient with spent fees",
+			budget: &TargetBudget{
+				Amount:        spent + chan1Fees - 1,
+				RefreshPeriod: time.Hour,
+				LastRefresh:   testBudgetStart,
+			},
+			suggestions: chan1Budget,
+		},
+		{
+			name: "spent fees before budget refresh",
+			budget: &TargetBudget{
+				Amount:        chan1Fees,
+				RefreshPeriod: time.Hour,
+				LastRefresh:   testTime,
+			},
+			suggestions: chan1Out,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			cfg.ListLoopOut = func(context.Context) (
+				[]*loopdb.LoopOut, error) {
+
+				return []*loopdb.LoopOut{completedOut}, nil
+			}
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+			}
+
+			params := defaultParameters
+			params.AutoloopBudgetLastRefresh = testBudgetStart
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: {
+					ThresholdRule: chanRule.ThresholdRule,
+					Type:          swap.TypeOut,
+					Budget:        testCase.budget,
+				},
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
 // TestSuggestSwaps tests getting of swap suggestions based on the rules set for
 // the liquidity manager and the current set of channel balances.
 func TestSuggestSwaps(t *testing.T) {
@@ -716,6 +1312,23 @@ func TestSuggestSwaps(t *testing.T) {
 				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
+		{
+			name:     "loop out amount rule",
+			channels: singleChannel,
+			rules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: {
+					AmountRule: NewAmountRule(5000, 0),
+					Type:       swap.TypeOut,
+				},
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
 		{
 			name:     "no rule for channel",
 			channels: singleChannel,
@@ -1967,9 +2580,11 @@ func TestCurrentTraffic(t *testing.T) {
 				},
 				// Make empty maps so that we can assert equal.
 				failedLoopOut: make(
-					map[lnwire.ShortChannelID]time.Time,
+					map[lnwire.ShortChannelID]*FailureBackoff,
+				),
+				failedLoopIn: make(
+					map[route.Vertex]*FailureBackoff,
 				),
-				failedLoopIn: make(map[route.Vertex]time.Time),
 			},
 		},
 		{
@@ -2019,11 +2634,19 @@ func TestCurrentTraffic(t *testing.T) {
 					map[lnwire.ShortChannelID]bool,
 				),
 				ongoingLoopIn: make(map[route.Vertex]bool),
-				failedLoopOut: map[lnwire.ShortChannelID]time.Time{
-					chanID1: withinBackoff,
+				failedLoopOut: map[lnwire.ShortChannelID]*FailureBackoff{
+					chanID1: {
+						Failures:    1,
+						LastFailure: withinBackoff,
+						Backoff:     backoff,
+					},
 				},
-				failedLoopIn: map[route.Vertex]time.Time{
-					peer1: withinBackoff,
+				failedLoopIn: map[route.Vertex]*FailureBackoff{
+					peer1: {
+						Failures:    1,
+						LastFailure: withinBackoff,
+						Backoff:     backoff,
+					},
 				},
 			},
 		},
@@ -2041,3 +2664,150 @@ func TestCurrentTraffic(t *testing.T) {
 		require.Equal(t, testCase.expected, actual)
 	}
 }
+
+// TestDestDescriptor tests that autoloop loop outs pay to a fresh address of
+// our destination descriptor.
+func TestDestDescriptor(t *testing.T) {
+	ctx := context.Background()
+	cfg, lnd := newTestConfig()
+
+	master, err := hdkeychain.NewMaster(
+		make([]byte, hdkeychain.RecommendedSeedLen),
+		lnd.ChainParams,
+	)
+	require.NoError(t, err)
+
+	xpub, err := master.Neuter()
+	require.NoError(t, err)
+
+	desc := "wpkh(" + xpub.String() + "/0/*)"
+
+	addr, err := btcutil.DecodeAddress(p2wkhAddr, nil)
+	require.NoError(t, err)
+
+	cfg.NextDescriptorAddr = func(_ context.Context,
+		descriptor string) (btcutil.Address, error) {
+
+		require.Equal(t, desc, descriptor)
+
+		return addr, nil
+	}
+
+	lnd.Channels = []lndclient.ChannelInfo{channel1}
+	manager := NewManager(cfg)
+
+	// A descriptor can't be set along with a destination address, and it
+	// must be valid for our network.
+	params := manager.GetParameters()
+	params.DestDescriptor = desc
+	params.DestAddr = addr
+	err = manager.validateParameters(ctx, params)
+	require.ErrorIs(t, err, ErrAmbiguousDestAddr)
+
+	params.DestAddr = nil
+	params.DestDescriptor = "wpkh(" + xpub.String() + "/0/0)"
+	err = manager.validateParameters(ctx, params)
+	require.ErrorIs(t, err, descriptor.ErrNotRanged)
+
+	params.DestDescriptor = desc
+	require.NoError(t, manager.validateParameters(ctx, params))
+
+	// Our suggestions don't use up addresses of the descriptor, but leave
+	// it to dispatch to derive one.
+	params.Autoloop = true
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+	}
+	manager.params = params
+
+	suggestions, err := manager.SuggestSwaps(ctx)
+	require.NoError(t, err)
+	require.Len(t, suggestions.OutSwaps, 1)
+	require.Nil(t, suggestions.OutSwaps[0].DestAddr)
+	require.True(t, suggestions.OutSwaps[0].IsExternalAddr)
+
+	// Once a queued swap is approved, it is dispatched to an address of
+	// our descriptor.
+	testDb := loopdb.NewTestDB(t)
+	defer testDb.Close()
+
+	cfg.QueueStore = NewSQLStore(loopdb.NewTypedStore[Querier](testDb))
+
+	var dispatched []loop.OutRequest
+	cfg.LoopOut = func(_ context.Context,
+		req *loop.OutRequest) (*loop.LoopOutSwapInfo, error) {
+
+		dispatched = append(dispatched, *req)
+
+		return &loop.LoopOutSwapInfo{}, nil
+	}
+
+	manager.params.RequireApproval = true
+	manager.params.AutoFeeBudget = 100000
+	manager.params.AutoFeeRefreshPeriod = testBudgetRefresh
+	manager.params.AutoloopBudgetLastRefresh = testBudgetStart
+	require.NoError(t, manager.autoloop(ctx))
+
+	queued, err := manager.ListQueuedSwaps(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+
+	_, _, err = manager.ApproveQueuedSwap(ctx, queued[0].ID)
+	require.NoError(t, err)
+	require.Len(t, dispatched, 1)
+	require.Equal(t, addr, dispatched[0].DestAddr)
+	require.True(t, dispatched[0].IsExternalAddr)
+}
+
+// TestEasyAutoloopDestDescriptor tests that easy autoloop loop outs pay to a
+// fresh address of our destination descriptor.
+func TestEasyAutoloopDestDescriptor(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg, lnd := newTestConfig()
+
+	addr, err := btcutil.DecodeAddress(p2wkhAddr, nil)
+	require.NoError(t, err)
+
+	cfg.NextDescriptorAddr = func(context.Context,
+		string) (btcutil.Address, error) {
+
+		return addr, nil
+	}
+
+	dispatched := make(chan loop.OutRequest, 1)
+	cfg.LoopOut = func(_ context.Context,
+		req *loop.OutRequest) (*loop.LoopOutSwapInfo, error) {
+
+		dispatched <- *req
+
+		return nil, errors.New("stop sticky loop out")
+	}
+
+	channel := channel1
+	channel.Active = true
+	lnd.Channels = []lndclient.ChannelInfo{channel}
+	manager := NewManager(cfg)
+
+	params := manager.GetParameters()
+	params.Autoloop = true
+	params.EasyAutoloop = true
+	params.EasyAutoloopTarget = channel.LocalBalance / 2
+	params.DestDescriptor = "wpkh(descriptor)"
+	params.AutoFeeBudget = 100000
+	params.AutoFeeRefreshPeriod = testBudgetRefresh
+	params.AutoloopBudgetLastRefresh = testBudgetStart
+	manager.params = params
+
+	require.NoError(t, manager.easyAutoLoop(ctx))
+
+	select {
+	case req := <-dispatched:
+		require.Equal(t, addr, req.DestAddr)
+		require.True(t, req.IsExternalAddr)
+
+	case <-time.After(test.Timeout):
+		t.Fatal("easy autoloop loop out not dispatched")
+	}
+}
@@ -69,8 +69,9 @@ func (b *loopInBuilder) inUse(traffic *swapTraffic, peer route.Vertex,
 	lastFail, recentFail := traffic.failedLoopIn[peer]
 	if recentFail {
 		log.Debugf("Peer: %v not eligible for suggestions, "+
-			"was part of a failed swap at: %v", peer,
-			lastFail)
+			"was part of a failed swap at: %v, %v consecutive "+
+			"failures, backoff: %v", peer, lastFail.LastFailure,
+			lastFail.Failures, lastFail.Backoff)
 
 		return newReasonError(ReasonFailureBackoff)
 	}
@@ -94,15 +95,7 @@ func (b *loopInBuilder) buildSwap(ctx context.Context, pubkey route.Vertex,
 		Initiator:      getInitiator(params),
 	})
 	if err != nil {
-		// If the server fails our quote, we're not reachable right
-		// now, so we want to catch this error and fail with a
-		// structured error so that we know why we can't swap.
-		status, ok := status.FromError(err)
-		if ok && status.Code() == codes.FailedPrecondition {
-			return nil, newReasonError(ReasonLoopInUnreachable)
-		}
-
-		return nil, err
+		return nil, loopInQuoteError(err)
 	}
 
 	if err := params.FeeLimit.loopInLimits(amount, quote); err != nil {
@@ -130,3 +123,15 @@ func (b *loopInBuilder) buildSwap(ctx context.Context, pubkey route.Vertex,
 		LoopInRequest: request,
 	}, nil
 }
+
+// loopInQuoteError converts an error returned when quoting a loop in to a
+// structured error if the failure indicates that we are not reachable from
+// the server right now, so that we know why we can't swap.
+func loopInQuoteError(err error) error {
+	status, ok := status.FromError(err)
+	if ok && status.Code() == codes.FailedPrecondition {
+		return newReasonError(ReasonLoopInUnreachable)
+	}
+
+	return err
+}
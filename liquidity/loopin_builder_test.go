@@ -70,7 +70,11 @@ func TestLoopinInUse(t *testing.T) {
 		}
 
 		if testCase.failedLoopIn != nil {
-			traffic.failedLoopIn[*testCase.failedLoopIn] = testTime
+			traffic.failedLoopIn[*testCase.failedLoopIn] =
+				&FailureBackoff{
+					Failures:    1,
+					LastFailure: testTime,
+				}
 		}
 
 		builder := newLoopInBuilder(nil)
@@ -68,8 +68,10 @@ func (b *loopOutBuilder) inUse(traffic *swapTraffic, peer route.Vertex,
 		lastFail, recentFail := traffic.failedLoopOut[chanID]
 		if recentFail {
 			log.Debugf("Channel: %v not eligible for suggestions, "+
-				"was part of a failed swap at: %v", chanID,
-				lastFail)
+				"was part of a failed swap at: %v, %v "+
+				"consecutive failures, backoff: %v", chanID,
+				lastFail.LastFailure, lastFail.Failures,
+				lastFail.Backoff)
 
 			return newReasonError(ReasonFailureBackoff)
 		}
@@ -240,10 +242,18 @@ func (b *loopOutBuilder) buildSwap(ctx context.Context, pubkey route.Vertex,
 			addrType = params.AccountAddrType
 			request.IsExternalAddr = true
 		}
-		if params.DestAddr != nil {
+		switch {
+		case params.DestAddr != nil:
 			request.DestAddr = params.DestAddr
 			request.IsExternalAddr = true
-		} else {
+
+		// Addresses of a destination descriptor are only derived once
+		// the swap is dispatched, so that suggestions that are never
+		// dispatched don't use up addresses.
+		case params.DestDescriptor != "":
+			request.IsExternalAddr = true
+
+		default:
 			addr, err := b.cfg.Lnd.WalletKit.NextAddr(
 				ctx, account, addrType, false,
 			)
@@ -0,0 +1,335 @@
+package liquidity
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// nodeLoopInCandidates is the maximum number of peers that we quote as
+	// the last hop of a loop in for our node rule. We consider the peers
+	// with the most incoming liquidity first.
+	nodeLoopInCandidates = 5
+)
+
+var (
+	// errNodeRuleBudget is returned when a node rule has its own budget
+	// set. Swaps for our node rule are limited by our global budget.
+	errNodeRuleBudget = errors.New("node rules do not support budgets, " +
+		"the global autoloop budget applies")
+
+	// errNodeRulePredictive is returned when a node rule is predictive.
+	errNodeRulePredictive = errors.New("node rules do not support " +
+		"forecasts")
+)
+
+// validateNode validates a rule that is set for our node. The total capacity
+// of our channels is required to check that absolute amount rules can be
+// satisfied.
+func (r *SwapRule) validateNode(capacity btcutil.Amount) error {
+	if err := r.validate(); err != nil {
+		return err
+	}
+
+	if r.Budget != nil {
+		return errNodeRuleBudget
+	}
+
+	if r.Predictive != nil {
+		return errNodeRulePredictive
+	}
+
+	if r.AmountRule != nil && capacity != 0 {
+		return r.AmountRule.validateCapacity(capacity)
+	}
+
+	return nil
+}
+
+// suggestNodeSwap checks whether our node rule requires a swap based on the
+// aggregate balance of the eligible channels provided. If it does, we pick
+// the channels (for loop out) or last hop peer (for loop in) that reach our
+// target at the lowest fee.
+func (m *Manager) suggestNodeSwap(ctx context.Context, traffic *swapTraffic,
+	channels []lndclient.ChannelInfo, balance *balances, rule *SwapRule,
+	outRestrictions, inRestrictions *Restrictions,
+	deposits *depositPool) (swapSuggestion, error) {
+
+	switch rule.Type {
+	case swap.TypeOut:
+		err := newLoopOutBuilder(m.cfg).maySwap(ctx, m.params)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := m.ruleAmount(
+			ctx, balance, rule, nil, outRestrictions,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if amount == 0 {
+			return nil, newReasonError(ReasonLiquidityOk)
+		}
+
+		return m.nodeLoopOut(
+			ctx, traffic, channels, amount, outRestrictions,
+		)
+
+	case swap.TypeIn:
+		amount, err := m.ruleAmount(
+			ctx, balance, rule, nil, inRestrictions,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if amount == 0 {
+			return nil, newReasonError(ReasonLiquidityOk)
+		}
+
+		return m.nodeLoopIn(
+			ctx, traffic, channels, amount, inRestrictions,
+			deposits,
+		)
+
+	default:
+		return nil, fmt.Errorf("unsupported swap type: %v", rule.Type)
+	}
+}
+
+// nodeLoopOut builds a loop out of the amount provided for our node rule. Our
+// loop out quote does not depend on the channels that we route our payment
+// over, so we pick the fewest channels that can cover the amount, preferring
+// those with the most local balance. Splitting our payment over fewer channels
+// keeps the routing fees that we pay low. If our channels cannot cover the
+// full amount, we swap as much as they can.
+func (m *Manager) nodeLoopOut(ctx context.Context, traffic *swapTraffic,
+	channels []lndclient.ChannelInfo, amount btcutil.Amount,
+	restrictions *Restrictions) (swapSuggestion, error) {
+
+	var (
+		builder    = newLoopOutBuilder(m.cfg)
+		candidates = make([]lndclient.ChannelInfo, 0, len(channels))
+		reason     = ReasonLiquidityOk
+	)
+	for _, channel := range channels {
+		if !channel.Active {
+			continue
+		}
+
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		err := builder.inUse(
+			traffic, channel.PubKeyBytes,
+			[]lnwire.ShortChannelID{chanID},
+		)
+
+		var reasonErr *reasonError
+		if errors.As(err, &reasonErr) {
+			reason = reasonErr.reason
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		candidates = append(candidates, channel)
+	}
+
+	// Sort our candidates by descending local balance, using their channel
+	// ID as a tie-breaker so that our choice is deterministic.
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].LocalBalance != candidates[j].LocalBalance {
+			return candidates[i].LocalBalance >
+				candidates[j].LocalBalance
+		}
+
+		return candidates[i].ChannelID < candidates[j].ChannelID
+	})
+
+	var (
+		selected  []lnwire.ShortChannelID
+		available btcutil.Amount
+	)
+	for _, channel := range candidates {
+		if available >= amount {
+			break
+		}
+
+		selected = append(
+			selected, lnwire.NewShortChanIDFromInt(
+				channel.ChannelID,
+			),
+		)
+		available += channel.LocalBalance
+	}
+
+	if available < amount {
+		amount = limitSwapAmount(available, restrictions)
+	}
+
+	if amount == 0 {
+		log.Debugf("Node rule: channels %v cannot cover loop out, "+
+			"available: %v", selected, available)
+
+		return nil, newReasonError(reason)
+	}
+
+	log.Debugf("Node rule: loop out of %v over channels %v", amount,
+		selected)
+
+	return builder.buildSwap(
+		ctx, route.Vertex{}, selected, amount, m.params,
+	)
+}
+
+// nodeLoopIn builds a loop in of the amount provided for our node rule. Our
+// loop in quote depends on the last hop that the server pays us through, so
+// we quote the peers that have the most incoming liquidity and pick the one
+// with the lowest fees relative to the amount that it can swap. If our loop
+// ins are funded by static address deposits, the swap is built with deposits
+// from the pool provided once we have picked our peer.
+func (m *Manager) nodeLoopIn(ctx context.Context, traffic *swapTraffic,
+	channels []lndclient.ChannelInfo, amount btcutil.Amount,
+	restrictions *Restrictions,
+	deposits *depositPool) (swapSuggestion, error) {
+
+	peers := make(map[route.Vertex]*balances)
+	for _, channel := range channels {
+		if !channel.Active {
+			continue
+		}
+
+		peer, ok := peers[channel.PubKeyBytes]
+		if !ok {
+			peer = &balances{
+				pubkey: channel.PubKeyBytes,
+			}
+			peers[channel.PubKeyBytes] = peer
+		}
+
+		peer.channels = append(
+			peer.channels,
+			lnwire.NewShortChanIDFromInt(channel.ChannelID),
+		)
+		peer.capacity += channel.Capacity
+		peer.incoming += channel.RemoteBalance
+		peer.outgoing += channel.LocalBalance
+	}
+
+	var (
+		builder    = newLoopInBuilder(m.cfg)
+		candidates = make([]*balances, 0, len(peers))
+		reason     = ReasonLiquidityOk
+	)
+	for _, peer := range peers {
+		err := builder.inUse(traffic, peer.pubkey, peer.channels)
+
+		var reasonErr *reasonError
+		if errors.As(err, &reasonErr) {
+			reason = reasonErr.reason
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		candidates = append(candidates, peer)
+	}
+
+	// Sort the candidate peers based on descending remote balance, using
+	// their pubkey as a tie-breaker so that our choice is deterministic.
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].incoming != candidates[j].incoming {
+			return candidates[i].incoming > candidates[j].incoming
+		}
+
+		return bytes.Compare(
+			candidates[i].pubkey[:], candidates[j].pubkey[:],
+		) < 0
+	})
+
+	if len(candidates) > nodeLoopInCandidates {
+		candidates = candidates[:nodeLoopInCandidates]
+	}
+
+	var (
+		best       swapSuggestion
+		bestPeer   *balances
+		bestFeePPM uint64
+	)
+	for _, peer := range candidates {
+		peerAmount := amount
+		if peerAmount > peer.incoming {
+			peerAmount = limitSwapAmount(
+				peer.incoming, restrictions,
+			)
+		}
+
+		if peerAmount == 0 {
+			continue
+		}
+
+		suggestion, err := builder.buildSwap(
+			ctx, peer.pubkey, peer.channels, peerAmount, m.params,
+		)
+
+		var reasonErr *reasonError
+		if errors.As(err, &reasonErr) {
+			reason = reasonErr.reason
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		in, ok := suggestion.(*loopInSwapSuggestion)
+		if !ok {
+			return nil, fmt.Errorf("unexpected swap suggestion "+
+				"type: %T", suggestion)
+		}
+
+		// Our worst case fees are dominated by the cost of sweeping a
+		// failed swap, which does not depend on our last hop, so we
+		// compare the fees that a successful swap pays.
+		fees := in.MaxSwapFee + in.MaxMinerFee
+		feePPM := uint64(fees) * 1e6 / uint64(in.Amount)
+
+		log.Debugf("Node rule: loop in of %v via last hop %v, fees: "+
+			"%v (%v ppm)", peerAmount, peer.pubkey, fees, feePPM)
+
+		if best == nil || feePPM < bestFeePPM {
+			best = suggestion
+			bestPeer = peer
+			bestFeePPM = feePPM
+		}
+	}
+
+	if best == nil {
+		return nil, newReasonError(reason)
+	}
+
+	if !m.params.StaticLoopIn {
+		return best, nil
+	}
+
+	staticBuilder := newStaticLoopInBuilder(m.cfg, deposits, restrictions)
+
+	return staticBuilder.buildSwap(
+		ctx, bestPeer.pubkey, bestPeer.channels, best.amount(),
+		m.params,
+	)
+}
@@ -0,0 +1,300 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNodeRuleValidation tests validation of node rules.
+func TestNodeRuleValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		params func(Parameters) Parameters
+		err    error
+	}{
+		{
+			name: "valid rule",
+			params: func(p Parameters) Parameters {
+				p.NodeRule = chanRule
+				return p
+			},
+		},
+		{
+			name: "combined with channel rule",
+			params: func(p Parameters) Parameters {
+				p.NodeRule = chanRule
+				p.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+					chanID1: chanRule,
+				}
+				return p
+			},
+			err: ErrExclusiveNodeRule,
+		},
+		{
+			name: "budget",
+			params: func(p Parameters) Parameters {
+				p.NodeRule = &SwapRule{
+					ThresholdRule: NewThresholdRule(50, 0),
+					Type:          swap.TypeOut,
+					Budget: &TargetBudget{
+						Amount:        100,
+						RefreshPeriod: time.Hour,
+					},
+				}
+				return p
+			},
+			err: errNodeRuleBudget,
+		},
+		{
+			name: "predictive",
+			params: func(p Parameters) Parameters {
+				p.NodeRule = &SwapRule{
+					ThresholdRule: NewThresholdRule(50, 0),
+					Type:          swap.TypeOut,
+					Predictive: &PredictiveRule{
+						Lookback: time.Hour,
+						Horizon:  time.Hour,
+					},
+				}
+				return p
+			},
+			err: errNodeRulePredictive,
+		},
+		{
+			name: "amount exceeds capacity",
+			params: func(p Parameters) Parameters {
+				p.NodeRule = &SwapRule{
+					AmountRule: NewAmountRule(20000, 0),
+					Type:       swap.TypeOut,
+				}
+				return p
+			},
+			err: errAmountRuleCapacity,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			params := testCase.params(defaultParameters)
+
+			err := params.validate(
+				1, []lndclient.ChannelInfo{channel1, channel2},
+				testRestrictions,
+			)
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+}
+
+// TestNodeRuleLoopOut tests that loop outs for our node rule are spread over
+// the fewest channels that can cover the swap amount.
+func TestNodeRuleLoopOut(t *testing.T) {
+	// Node rules only swap over active channels. Our node has 15000 sats
+	// of outgoing and 5000 sats of incoming liquidity. To reach the
+	// midpoint of a 60% incoming threshold, we need to loop out 11000
+	// sats.
+	active := channel1
+	active.Active = true
+
+	partial := channel2
+	partial.Active = true
+	partial.LocalBalance = 5000
+	partial.RemoteBalance = 5000
+
+	// A channel that only has incoming liquidity brings our node's
+	// incoming liquidity above our threshold.
+	inbound := active
+	inbound.LocalBalance = 0
+	inbound.RemoteBalance = 10000
+
+	nodeRule := &SwapRule{
+		ThresholdRule: NewThresholdRule(60, 0),
+		Type:          swap.TypeOut,
+	}
+
+	outRequest := func(amount btcutil.Amount,
+		channels ...lnwire.ShortChannelID) loop.OutRequest {
+
+		prepay, routing := testPPMFees(defaultFeePPM, testQuote, amount)
+
+		var chanSet loopdb.ChannelSet
+		for _, channel := range channels {
+			chanSet = append(chanSet, channel.ToUint64())
+		}
+
+		return loop.OutRequest{
+			Amount:              amount,
+			OutgoingChanSet:     chanSet,
+			MaxPrepayRoutingFee: prepay,
+			MaxSwapRoutingFee:   routing,
+			MaxMinerFee: scaleMaxMinerFee(
+				scaleMinerFee(testQuote.MinerFee),
+			),
+			MaxSwapFee:      testQuote.SwapFee,
+			MaxPrepayAmount: testQuote.PrepayAmount,
+			SweepConfTarget: defaultConfTarget,
+			Initiator:       autoloopSwapInitiator,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		channels    []lndclient.ChannelInfo
+		loopOut     []*loopdb.LoopOut
+		suggestions *Suggestions
+	}{
+		{
+			name: "multiple channels",
+			channels: []lndclient.ChannelInfo{
+				partial, active,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					outRequest(11000, chanID1, chanID2),
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "channel in use",
+			channels: []lndclient.ChannelInfo{
+				partial, active,
+			},
+			loopOut: []*loopdb.LoopOut{
+				{
+					Contract: chan1Out,
+				},
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					outRequest(5000, chanID2),
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "liquidity ok",
+			channels: []lndclient.ChannelInfo{
+				partial, inbound,
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				DisqualifiedNode:  ReasonLiquidityOk,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = testCase.channels
+
+			cfg.Restrictions = func(context.Context, swap.Type,
+				string) (*Restrictions, error) {
+
+				return NewRestrictions(1, 20000), nil
+			}
+
+			cfg.ListLoopOut = func(context.Context) ([]*loopdb.LoopOut,
+				error) {
+
+				return testCase.loopOut, nil
+			}
+
+			params := defaultParameters
+			params.AutoloopBudgetLastRefresh = testBudgetStart
+			params.ClientRestrictions = Restrictions{}
+			params.NodeRule = nodeRule
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestNodeRuleLoopIn tests that loop ins for our node rule are routed via the
+// last hop with the lowest fees.
+func TestNodeRuleLoopIn(t *testing.T) {
+	// We use large channels so that our loop in's worst case sweep fee
+	// is within our fee limit.
+	inbound1 := channel1
+	inbound1.Active = true
+	inbound1.Capacity = 500000
+	inbound1.LocalBalance = 0
+	inbound1.RemoteBalance = 500000
+
+	inbound2 := inbound1
+	inbound2.ChannelID = chanID2.ToUint64()
+	inbound2.PubKeyBytes = peer2
+
+	quotes := map[route.Vertex]*loop.LoopInQuote{
+		peer1: {
+			SwapFee:  20,
+			MinerFee: 1,
+		},
+		peer2: {
+			SwapFee:  5,
+			MinerFee: 1,
+		},
+	}
+
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{inbound1, inbound2}
+
+	cfg.Restrictions = func(context.Context, swap.Type,
+		string) (*Restrictions, error) {
+
+		return NewRestrictions(1, 1000000), nil
+	}
+
+	cfg.LoopInQuote = func(_ context.Context,
+		req *loop.LoopInQuoteRequest) (*loop.LoopInQuote, error) {
+
+		return quotes[*req.LastHop], nil
+	}
+
+	params := defaultParameters
+	params.AutoloopBudgetLastRefresh = testBudgetStart
+	params.ClientRestrictions = Restrictions{}
+	params.NodeRule = &SwapRule{
+		ThresholdRule: NewThresholdRule(0, 60),
+		Type:          swap.TypeIn,
+	}
+
+	lastHop := peer2
+	testSuggestSwaps(
+		t, newSuggestSwapsSetup(cfg, lnd, params), &Suggestions{
+			InSwaps: []loop.LoopInRequest{
+				{
+					Amount:         500000,
+					MaxSwapFee:     quotes[peer2].SwapFee,
+					MaxMinerFee:    quotes[peer2].MinerFee,
+					HtlcConfTarget: defaultHtlcConfTarget,
+					LastHop:        &lastHop,
+					Initiator:      autoloopSwapInitiator,
+				},
+			},
+			DisqualifiedChans: noneDisqualified,
+			DisqualifiedPeers: noPeersDisqualified,
+		}, nil,
+	)
+}
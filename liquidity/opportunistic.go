@@ -0,0 +1,105 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// errOpportunisticLoopIn is returned when an opportunistic band is set
+	// on a loop in rule.
+	errOpportunisticLoopIn = errors.New("opportunistic band only " +
+		"supported for loop out rules")
+
+	// errInvalidOpportunisticBand is returned when an opportunistic band
+	// has an invalid percentage.
+	errInvalidOpportunisticBand = errors.New("opportunistic band must " +
+		"be > 0, and the sum of band, incoming and outgoing " +
+		"thresholds must be < 100")
+
+	// errZeroOpportunisticFeeRate is returned when an opportunistic band
+	// does not have a fee rate set.
+	errZeroOpportunisticFeeRate = errors.New("opportunistic fee rate " +
+		"must be > 0")
+)
+
+// OpportunisticBand describes a band above a rule's incoming threshold within
+// which we will pre-emptively loop out when on-chain fees are cheap.
+type OpportunisticBand struct {
+	// Percent is the number of percentage points above our minimum
+	// incoming threshold within which we swap early.
+	Percent int
+
+	// MaxFeeRate is the sweep fee rate estimate at or below which we
+	// consider fees cheap enough to swap early.
+	MaxFeeRate chainfee.SatPerKWeight
+}
+
+// String returns a string representation of an opportunistic band.
+func (o *OpportunisticBand) String() string {
+	return fmt.Sprintf("opportunistic band: %v%% below %v sat/vbyte",
+		o.Percent, satPerKwToSatPerVByte(o.MaxFeeRate))
+}
+
+// validate checks that an opportunistic band is valid for the threshold rule
+// that it is applied to.
+func (o *OpportunisticBand) validate(rule *ThresholdRule) error {
+	if o.Percent <= 0 {
+		return errInvalidOpportunisticBand
+	}
+
+	if rule.MinimumIncoming+rule.MinimumOutgoing+o.Percent >= 100 {
+		return errInvalidOpportunisticBand
+	}
+
+	if o.MaxFeeRate <= 0 {
+		return errZeroOpportunisticFeeRate
+	}
+
+	return nil
+}
+
+// opportunisticAmount returns the amount that we may loop out for a rule that
+// does not require a swap based on its threshold, but has an opportunistic
+// band set. If the rule has no band, current sweep fees are above the band's
+// fee rate or the target is not within the band, zero is returned.
+func (m *Manager) opportunisticAmount(ctx context.Context, balance *balances,
+	rule *SwapRule, restrictions *Restrictions) (btcutil.Amount, error) {
+
+	if rule.Opportunistic == nil || rule.ThresholdRule == nil {
+		return 0, nil
+	}
+
+	estimate, err := m.cfg.Lnd.WalletKit.EstimateFeeRate(
+		ctx, m.params.SweepConfTarget,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if estimate > rule.Opportunistic.MaxFeeRate {
+		return 0, nil
+	}
+
+	// Raise our incoming threshold by the band that we have set, so that
+	// targets within the band are swapped to the same relative midpoint
+	// that our threshold rule would aim for.
+	bandRule := NewThresholdRule(
+		rule.MinimumIncoming+rule.Opportunistic.Percent,
+		rule.MinimumOutgoing,
+	)
+
+	amount := bandRule.swapAmount(balance, restrictions, rule.Type)
+	if amount != 0 {
+		log.Infof("Opportunistic loop out of %v suggested for %v, "+
+			"sweep fee estimate: %v sat/vbyte", amount,
+			balance.channels,
+			satPerKwToSatPerVByte(estimate))
+	}
+
+	return amount, nil
+}
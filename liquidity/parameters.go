@@ -28,10 +28,12 @@ var (
 		ChannelRules:              make(map[lnwire.ShortChannelID]*SwapRule),
 		PeerRules:                 make(map[route.Vertex]*SwapRule),
 		FailureBackOff:            defaultFailureBackoff,
+		FailureBackOffMax:         defaultFailureBackoffMax,
 		SweepConfTarget:           defaultConfTarget,
 		HtlcConfTarget:            defaultHtlcConfTarget,
 		FeeLimit:                  defaultFeePortion(),
 		FastSwapPublication:       true,
+		ApprovalExpiry:            defaultApprovalExpiry,
 	}
 )
 
@@ -55,6 +57,11 @@ type Parameters struct {
 	// The address type of the account specified in the account field.
 	AccountAddrType walletrpc.AddressType
 
+	// DestDescriptor is a watch-only output descriptor or extended public
+	// key that a fresh destination address is derived from for every
+	// loop out.
+	DestDescriptor string
+
 	// AutoFeeBudget is the total amount we allow to be spent on
 	// automatically dispatched swaps. Once this budget has been used, we
 	// will stop dispatching swaps until the budget is refreshed.
@@ -74,10 +81,17 @@ type Parameters struct {
 
 	// FailureBackOff is the amount of time that we require passes after a
 	// channel has been part of a failed loop out swap before we suggest
-	// using it again.
-	// TODO(carla): add exponential backoff
+	// using it again. This backoff is doubled for every consecutive
+	// failure of the channel (or peer for loop in), up to a maximum of
+	// FailureBackOffMax.
 	FailureBackOff time.Duration
 
+	// FailureBackOffMax is the upper limit for the exponentially growing
+	// failure backoff. If this value is zero, the backoff does not grow and
+	// FailureBackOff is applied regardless of the number of consecutive
+	// failures.
+	FailureBackOffMax time.Duration
+
 	// SweepConfTarget is the number of blocks we aim to confirm our sweep
 	// transaction in. This value affects the on chain fees we will pay.
 	SweepConfTarget int32
@@ -86,6 +100,16 @@ type Parameters struct {
 	// loop in swap htlcs on chain.
 	HtlcConfTarget int32
 
+	// ScheduleWindows is the set of windows that automated swaps are
+	// restricted to. If no windows are set, swaps may be dispatched at
+	// any time.
+	ScheduleWindows []ScheduleWindow
+
+	// FeeRateCeiling is the on-chain fee rate, estimated for our sweep
+	// confirmation target, above which we do not dispatch automated
+	// swaps. If this value is zero, no ceiling is applied.
+	FeeRateCeiling chainfee.SatPerKWeight
+
 	// FeeLimit controls the fee limit we place on swaps.
 	FeeLimit FeeLimit
 
@@ -104,6 +128,12 @@ type Parameters struct {
 	// and channel rules map to avoid ambiguity.
 	PeerRules map[route.Vertex]*SwapRule
 
+	// NodeRule is an optional rule that applies to the aggregate balances
+	// of all of our eligible channels. When it is breached, we pick the
+	// channels or peer that we swap over ourselves. This rule may not be
+	// set with ChannelRules or PeerRules.
+	NodeRule *SwapRule
+
 	// CustomPaymentCheckInterval is an optional custom interval to use when
 	// checking an autoloop loop out payments' payment status.
 	CustomPaymentCheckInterval time.Duration
@@ -116,6 +146,57 @@ type Parameters struct {
 	// maintain in our channels.
 	EasyAutoloopTarget btcutil.Amount
 
+	// EasyAutoloopInTarget is the minimum amount of local liquidity that
+	// we want to maintain in our channels. If our total local balance
+	// drops below this amount, easy autoloop dispatches a loop in from our
+	// on-chain wallet. If this value is zero, easy autoloop does not loop
+	// in.
+	EasyAutoloopInTarget btcutil.Amount
+
+	// StaticLoopIn indicates that the loop in swaps suggested by our
+	// liquidity rules should be funded by static address deposits rather
+	// than by our on-chain wallet.
+	StaticLoopIn bool
+
+	// RequireApproval indicates that the swaps suggested by autoloop
+	// should be added to a queue of swaps that must be approved before
+	// they are dispatched, rather than being dispatched automatically.
+	RequireApproval bool
+
+	// ApprovalExpiry is the amount of time that a queued swap may be
+	// approved for before it expires.
+	ApprovalExpiry time.Duration
+
+	// MinChannelAge is the minimum number of confirmations that a channel
+	// must have before we consider it for swaps. If this value is zero,
+	// channels of any age are considered.
+	MinChannelAge uint32
+
+	// MinPeerUptime is the minimum percentage of time that a peer must
+	// have been online while lnd has monitored it for us to consider its
+	// channels for swaps. If this value is zero, peers are not filtered
+	// by their uptime.
+	MinPeerUptime uint32
+
+	// IncludePending indicates that liquidity which has not yet settled in
+	// our channels should be counted in the balances that our rules are
+	// applied to. This includes pending htlcs, pending channel opens and
+	// swaps that have not yet shifted liquidity in our channels.
+	IncludePending bool
+
+	// ROI optionally limits our loop outs to those that we expect to pay
+	// for themselves in routing revenue. If this value is nil, loop outs
+	// are not gated on their routing revenue.
+	ROI *ROIGate
+
+	// Jitter optionally randomizes the swaps that autoloop dispatches. If
+	// this value is nil, swaps are dispatched as suggested.
+	Jitter *PrivacyJitter
+
+	// AssetRules maps a hex encoded asset id to the liquidity rules that
+	// we apply to the asset's balances in our asset channels.
+	AssetRules map[string]*AssetRules
+
 	// AssetAutoloopParams maps an asset id hex encoded string to its
 	// easy autoloop parameters.
 	AssetAutoloopParams map[string]AssetParams
@@ -153,14 +234,36 @@ func (p Parameters) String() string {
 		)
 	}
 
-	return fmt.Sprintf("rules: %v, failure backoff: %v, sweep "+
-		"sweep conf target: %v, htlc conf target: %v,fees: %v, "+
-		"auto budget: %v, budget refresh: %v, max auto in flight: %v, "+
-		"minimum swap size=%v, maximum swap size=%v",
+	if p.NodeRule != nil {
+		ruleList = append(
+			ruleList, fmt.Sprintf("Node: %v", p.NodeRule),
+		)
+	}
+
+	for assetID, rules := range p.AssetRules {
+		for channel, rule := range rules.ChannelRules {
+			ruleList = append(ruleList, fmt.Sprintf("Asset: %v "+
+				"channel: %v: %v", assetID, channel, rule))
+		}
+
+		for peer, rule := range rules.PeerRules {
+			ruleList = append(ruleList, fmt.Sprintf("Asset: %v "+
+				"peer: %v: %v", assetID, peer, rule))
+		}
+	}
+
+	return fmt.Sprintf("rules: %v, failure backoff: %v, maximum failure "+
+		"backoff: %v, sweep conf target: %v, htlc conf target: %v,"+
+		"fees: %v, auto budget: %v, budget refresh: %v, max auto in "+
+		"flight: %v, minimum swap size=%v, maximum swap size=%v, "+
+		"schedule: %v, fee rate ceiling: %v, include pending: %v, "+
+		"roi: %v, jitter: %v",
 		strings.Join(ruleList, ","), p.FailureBackOff,
-		p.SweepConfTarget, p.HtlcConfTarget, p.FeeLimit,
+		p.FailureBackOffMax, p.SweepConfTarget, p.HtlcConfTarget, p.FeeLimit,
 		p.AutoFeeBudget, p.AutoFeeRefreshPeriod, p.MaxAutoInFlight,
-		p.ClientRestrictions.Minimum, p.ClientRestrictions.Maximum)
+		p.ClientRestrictions.Minimum, p.ClientRestrictions.Maximum,
+		p.ScheduleWindows, p.FeeRateCeiling, p.IncludePending, p.ROI,
+		p.Jitter)
 }
 
 // haveRules returns a boolean indicating whether we have any rules configured.
@@ -173,7 +276,11 @@ func (p Parameters) haveRules() bool {
 		return true
 	}
 
-	return false
+	if len(p.AssetRules) != 0 {
+		return true
+	}
+
+	return p.NodeRule != nil
 }
 
 // validate checks whether a set of parameters is valid. Our set of currently
@@ -211,6 +318,28 @@ func (p Parameters) validate(minConfs int32, openChans []lndclient.ChannelInfo,
 		}
 	}
 
+	// Our node rule already covers all of our channels, so it may not be
+	// combined with any channel or peer rules.
+	if p.NodeRule != nil &&
+		(len(p.ChannelRules) != 0 || len(p.PeerRules) != 0) {
+
+		return ErrExclusiveNodeRule
+	}
+
+	// Collect the capacity of our channels and peers so that we can check
+	// that absolute amount rules can be satisfied.
+	var (
+		chanCapacity = make(map[lnwire.ShortChannelID]btcutil.Amount)
+		peerCapacity = make(map[route.Vertex]btcutil.Amount)
+		nodeCapacity btcutil.Amount
+	)
+	for _, channel := range openChans {
+		shortID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		chanCapacity[shortID] = channel.Capacity
+		peerCapacity[channel.PubKeyBytes] += channel.Capacity
+		nodeCapacity += channel.Capacity
+	}
+
 	for channel, rule := range p.ChannelRules {
 		if channel.ToUint64() == 0 {
 			return ErrZeroChannelID
@@ -225,6 +354,15 @@ func (p Parameters) validate(minConfs int32, openChans []lndclient.ChannelInfo,
 			return fmt.Errorf("channel: %v has invalid rule: %v",
 				channel.ToUint64(), err)
 		}
+
+		capacity, ok := chanCapacity[channel]
+		if ok && rule.AmountRule != nil {
+			err := rule.AmountRule.validateCapacity(capacity)
+			if err != nil {
+				return fmt.Errorf("channel: %v has invalid "+
+					"rule: %w", channel.ToUint64(), err)
+			}
+		}
 	}
 
 	for peer, rule := range p.PeerRules {
@@ -232,6 +370,35 @@ func (p Parameters) validate(minConfs int32, openChans []lndclient.ChannelInfo,
 			return fmt.Errorf("peer: %v has invalid rule: %v",
 				peer, err)
 		}
+
+		capacity, ok := peerCapacity[peer]
+		if ok && rule.AmountRule != nil {
+			err := rule.AmountRule.validateCapacity(capacity)
+			if err != nil {
+				return fmt.Errorf("peer: %v has invalid rule: "+
+					"%w", peer, err)
+			}
+		}
+	}
+
+	if p.NodeRule != nil {
+		if err := p.NodeRule.validateNode(nodeCapacity); err != nil {
+			return fmt.Errorf("node has invalid rule: %w", err)
+		}
+	}
+
+	for assetID, rules := range p.AssetRules {
+		if err := rules.validate(assetID, openChans); err != nil {
+			return err
+		}
+
+		if p.AssetAutoloopParams[assetID].EnableEasyOut {
+			return errAssetRuleEasyAutoloop
+		}
+	}
+
+	if len(p.AssetRules) != 0 && p.RequireApproval {
+		return errAssetRuleApproval
 	}
 
 	// Check that our confirmation target is above our required minimum.
@@ -256,6 +423,39 @@ func (p Parameters) validate(minConfs int32, openChans []lndclient.ChannelInfo,
 		return ErrZeroInFlight
 	}
 
+	if p.FailureBackOffMax != 0 && p.FailureBackOffMax < p.FailureBackOff {
+		return ErrFailureBackoffMax
+	}
+
+	if p.EasyAutoloopTarget != 0 && p.EasyAutoloopInTarget != 0 &&
+		p.EasyAutoloopInTarget >= p.EasyAutoloopTarget {
+
+		return ErrEasyAutoloopInTarget
+	}
+
+	if p.MinPeerUptime > 100 {
+		return ErrInvalidPeerUptime
+	}
+
+	if p.ROI != nil {
+		if err := p.ROI.validate(); err != nil {
+			return err
+		}
+	}
+
+	if p.Jitter != nil {
+		if err := p.Jitter.validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, window := range p.ScheduleWindows {
+		if err := window.validate(); err != nil {
+			return fmt.Errorf("schedule window: %v invalid: %w",
+				window, err)
+		}
+	}
+
 	// Destination address and account cannot be set at the same time.
 	if p.DestAddr != nil && len(p.DestAddr.String()) > 0 &&
 		len(p.Account) > 0 {
@@ -263,6 +463,14 @@ func (p Parameters) validate(minConfs int32, openChans []lndclient.ChannelInfo,
 		return ErrAmbiguousDestAddr
 	}
 
+	// A destination descriptor can't be set along with a destination
+	// address or account either.
+	if len(p.DestDescriptor) > 0 && (p.DestAddr != nil ||
+		len(p.Account) > 0) {
+
+		return ErrAmbiguousDestAddr
+	}
+
 	// If an account is specified the respective address type must be
 	// specified as well, or both must be unset.
 	if len(p.Account) == 0 !=
@@ -326,8 +534,7 @@ func cloneParameters(params Parameters) Parameters {
 	)
 
 	for channel, rule := range params.ChannelRules {
-		ruleCopy := *rule
-		paramCopy.ChannelRules[channel] = &ruleCopy
+		paramCopy.ChannelRules[channel] = cloneSwapRule(rule)
 	}
 
 	paramCopy.PeerRules = make(
@@ -336,13 +543,73 @@ func cloneParameters(params Parameters) Parameters {
 	)
 
 	for peer, rule := range params.PeerRules {
-		ruleCopy := *rule
-		paramCopy.PeerRules[peer] = &ruleCopy
+		paramCopy.PeerRules[peer] = cloneSwapRule(rule)
+	}
+
+	if params.NodeRule != nil {
+		paramCopy.NodeRule = cloneSwapRule(params.NodeRule)
+	}
+
+	if params.AssetRules != nil {
+		paramCopy.AssetRules = make(
+			map[string]*AssetRules, len(params.AssetRules),
+		)
+
+		for assetID, rules := range params.AssetRules {
+			paramCopy.AssetRules[assetID] = cloneAssetRules(rules)
+		}
+	}
+
+	if params.ROI != nil {
+		roi := *params.ROI
+		paramCopy.ROI = &roi
+	}
+
+	if params.Jitter != nil {
+		jitter := *params.Jitter
+		paramCopy.Jitter = &jitter
+	}
+
+	if params.ScheduleWindows != nil {
+		paramCopy.ScheduleWindows = make(
+			[]ScheduleWindow, len(params.ScheduleWindows),
+		)
+
+		for i, window := range params.ScheduleWindows {
+			windowCopy := window
+			windowCopy.Days = append(
+				[]time.Weekday(nil), window.Days...,
+			)
+			paramCopy.ScheduleWindows[i] = windowCopy
+		}
 	}
 
 	return paramCopy
 }
 
+// cloneSwapRule creates a copy of a swap rule, including its optional
+// opportunistic band, budget and forecast.
+func cloneSwapRule(rule *SwapRule) *SwapRule {
+	ruleCopy := *rule
+
+	if rule.Opportunistic != nil {
+		band := *rule.Opportunistic
+		ruleCopy.Opportunistic = &band
+	}
+
+	if rule.Budget != nil {
+		budget := *rule.Budget
+		ruleCopy.Budget = &budget
+	}
+
+	if rule.Predictive != nil {
+		predictive := *rule.Predictive
+		ruleCopy.Predictive = &predictive
+	}
+
+	return &ruleCopy
+}
+
 // rpcToFee converts the values provided over rpc to a fee limit interface,
 // failing if an inconsistent set of fields are set.
 func rpcToFee(req *clientrpc.LiquidityParameters) (FeeLimit, error) {
@@ -391,14 +658,59 @@ func rpcToRule(rule *clientrpc.LiquidityRule) (*SwapRule, error) {
 	case clientrpc.LiquidityRuleType_UNKNOWN:
 		return nil, fmt.Errorf("rule type field must be set")
 
-	case clientrpc.LiquidityRuleType_THRESHOLD:
-		return &SwapRule{
-			ThresholdRule: NewThresholdRule(
+	case clientrpc.LiquidityRuleType_THRESHOLD,
+		clientrpc.LiquidityRuleType_AMOUNT,
+		clientrpc.LiquidityRuleType_PREDICTIVE:
+
+		swapRule := &SwapRule{
+			Type: swapType,
+		}
+
+		if rule.Type == clientrpc.LiquidityRuleType_AMOUNT {
+			swapRule.AmountRule = NewAmountRule(
+				btcutil.Amount(rule.MinIncomingSat),
+				btcutil.Amount(rule.MinOutgoingSat),
+			)
+		} else {
+			swapRule.ThresholdRule = NewThresholdRule(
 				int(rule.IncomingThreshold),
 				int(rule.OutgoingThreshold),
-			),
-			Type: swapType,
-		}, nil
+			)
+		}
+
+		if rule.Type == clientrpc.LiquidityRuleType_PREDICTIVE {
+			swapRule.Predictive = &PredictiveRule{
+				Lookback: time.Duration(
+					rule.ForecastLookbackSec,
+				) * time.Second,
+				Horizon: time.Duration(
+					rule.ForecastHorizonSec,
+				) * time.Second,
+			}
+		}
+
+		if rule.OpportunisticBandPercent != 0 {
+			swapRule.Opportunistic = &OpportunisticBand{
+				Percent: int(rule.OpportunisticBandPercent),
+				MaxFeeRate: satPerVByteToSatPerKw(
+					rule.OpportunisticFeeRateSatPerVbyte,
+				),
+			}
+		}
+
+		if rule.BudgetRefreshPeriodSec != 0 {
+			swapRule.Budget = &TargetBudget{
+				Amount: btcutil.Amount(rule.BudgetSat),
+				RefreshPeriod: time.Duration(
+					rule.BudgetRefreshPeriodSec,
+				) * time.Second,
+				LastRefresh: time.Unix(
+					int64(rule.BudgetLastRefresh), 0,
+				),
+			}
+		}
+
+		return swapRule, nil
 
 	default:
 		return nil, fmt.Errorf("unknown rule: %T", rule)
@@ -429,6 +741,11 @@ func RpcToParameters(req *clientrpc.LiquidityParameters) (*Parameters,
 		}
 	}
 
+	destDescriptor := req.AutoloopDestDescriptor
+	if destDescriptor == "default" {
+		destDescriptor = ""
+	}
+
 	addrType := walletrpc.AddressType_UNKNOWN
 	if req.AccountAddrType == clientrpc.AddressType_TAPROOT_PUBKEY {
 		addrType = walletrpc.AddressType_TAPROOT_PUBKEY
@@ -442,18 +759,39 @@ func RpcToParameters(req *clientrpc.LiquidityParameters) (*Parameters,
 		}
 	}
 
+	// A maximum failure backoff of zero is what parameters that were
+	// persisted before the maximum was introduced decode to, so we use our
+	// default maximum for it. The growth of the failure backoff is only
+	// disabled if this is requested explicitly. The default maximum is
+	// raised to the base failure backoff if it is below it.
+	failureBackoff := time.Duration(req.FailureBackoffSec) * time.Second
+	failureBackoffMax := time.Duration(req.FailureBackoffMaxSec) *
+		time.Second
+
+	switch {
+	case req.FailureBackoffFixed:
+		failureBackoffMax = 0
+
+	case failureBackoffMax == 0:
+		failureBackoffMax = defaultFailureBackoffMax
+		if failureBackoffMax < failureBackoff {
+			failureBackoffMax = failureBackoff
+		}
+	}
+
 	params := &Parameters{
-		FeeLimit:        feeLimit,
-		SweepConfTarget: req.SweepConfTarget,
-		FailureBackOff: time.Duration(req.FailureBackoffSec) *
-			time.Second,
-		Autoloop: req.Autoloop,
+		FeeLimit:          feeLimit,
+		SweepConfTarget:   req.SweepConfTarget,
+		FailureBackOff:    failureBackoff,
+		FailureBackOffMax: failureBackoffMax,
+		Autoloop:          req.Autoloop,
 		AutoloopBudgetLastRefresh: time.Unix(
 			int64(req.AutoloopBudgetLastRefresh), 0,
 		),
 		DestAddr:        destaddr,
 		Account:         req.Account,
 		AccountAddrType: addrType,
+		DestDescriptor:  destDescriptor,
 		AutoFeeBudget:   btcutil.Amount(req.AutoloopBudgetSat),
 		MaxAutoInFlight: int(req.AutoMaxInFlight),
 		ChannelRules: make(
@@ -471,8 +809,49 @@ func RpcToParameters(req *clientrpc.LiquidityParameters) (*Parameters,
 		EasyAutoloopTarget: btcutil.Amount(
 			req.EasyAutoloopLocalTargetSat,
 		),
+		EasyAutoloopInTarget: btcutil.Amount(
+			req.EasyAutoloopLocalMinimumSat,
+		),
+		StaticLoopIn:        req.StaticLoopIn,
+		RequireApproval:     req.RequireApproval,
 		AssetAutoloopParams: easyAssetParams,
 		FastSwapPublication: req.FastSwapPublication,
+		ScheduleWindows:     RpcToScheduleWindows(req.ScheduleWindows),
+		FeeRateCeiling: satPerVByteToSatPerKw(
+			req.FeeRateCeilingSatPerVbyte,
+		),
+		ApprovalExpiry: time.Duration(req.ApprovalExpirySec) *
+			time.Second,
+		MinChannelAge:  req.MinChannelAgeBlocks,
+		MinPeerUptime:  req.MinPeerUptimePercent,
+		IncludePending: req.IncludePending,
+	}
+
+	if req.RoiPaybackSec != 0 || req.RoiLookbackSec != 0 {
+		params.ROI = &ROIGate{
+			Lookback: time.Duration(req.RoiLookbackSec) *
+				time.Second,
+			PaybackPeriod: time.Duration(req.RoiPaybackSec) *
+				time.Second,
+		}
+	}
+
+	if req.JitterAmountPercent != 0 || req.JitterDispatchDelaySec != 0 ||
+		req.JitterConfTargetSpread != 0 {
+
+		params.Jitter = &PrivacyJitter{
+			AmountPercent: uint64(req.JitterAmountPercent),
+			DispatchDelay: time.Duration(
+				req.JitterDispatchDelaySec,
+			) * time.Second,
+			ConfTargetSpread: int32(req.JitterConfTargetSpread),
+		}
+	}
+
+	// Parameters that were stored before queued swaps were introduced do
+	// not have an approval expiry set, so we fall back to our default.
+	if params.ApprovalExpiry == 0 {
+		params.ApprovalExpiry = defaultApprovalExpiry
 	}
 
 	if req.AutoloopBudgetRefreshPeriodSec != 0 {
@@ -502,7 +881,27 @@ func RpcToParameters(req *clientrpc.LiquidityParameters) (*Parameters,
 			return nil, err
 		}
 
+		if rule.AssetId != "" {
+			err := params.addAssetRule(rule, liquidityRule)
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
 		switch {
+		case rule.Node && (peerRule || chanRule):
+			return nil, errors.New("cannot set channel or peer " +
+				"fields in node rule")
+
+		case rule.Node:
+			if params.NodeRule != nil {
+				return nil, errors.New("multiple node rules set")
+			}
+
+			params.NodeRule = liquidityRule
+
 		case peerRule && chanRule:
 			return nil, fmt.Errorf("cannot set channel: %v and "+
 				"peer: %v fields in rule", rule.ChannelId,
@@ -546,6 +945,13 @@ func ParametersToRpc(cfg Parameters) (*clientrpc.LiquidityParameters,
 	error) {
 
 	totalRules := len(cfg.ChannelRules) + len(cfg.PeerRules)
+	if cfg.NodeRule != nil {
+		totalRules++
+	}
+
+	for _, rules := range cfg.AssetRules {
+		totalRules += len(rules.ChannelRules) + len(rules.PeerRules)
+	}
 
 	var destaddr string
 	if cfg.DestAddr != nil {
@@ -576,16 +982,21 @@ func ParametersToRpc(cfg Parameters) (*clientrpc.LiquidityParameters,
 	rpcCfg := &clientrpc.LiquidityParameters{
 		SweepConfTarget:   cfg.SweepConfTarget,
 		FailureBackoffSec: uint64(cfg.FailureBackOff.Seconds()),
-		Autoloop:          cfg.Autoloop,
-		AutoloopBudgetSat: uint64(cfg.AutoFeeBudget),
+		FailureBackoffMaxSec: uint64(
+			cfg.FailureBackOffMax.Seconds(),
+		),
+		FailureBackoffFixed: cfg.FailureBackOffMax == 0,
+		Autoloop:            cfg.Autoloop,
+		AutoloopBudgetSat:   uint64(cfg.AutoFeeBudget),
 		AutoloopBudgetRefreshPeriodSec: uint64(
 			cfg.AutoFeeRefreshPeriod.Seconds(),
 		),
 		AutoloopBudgetLastRefresh: uint64(
 			cfg.AutoloopBudgetLastRefresh.Unix(),
 		),
-		AutoMaxInFlight:     uint64(cfg.MaxAutoInFlight),
-		AutoloopDestAddress: destaddr,
+		AutoMaxInFlight:        uint64(cfg.MaxAutoInFlight),
+		AutoloopDestAddress:    destaddr,
+		AutoloopDestDescriptor: cfg.DestDescriptor,
 		Rules: make(
 			[]*clientrpc.LiquidityRule, 0, totalRules,
 		),
@@ -598,10 +1009,40 @@ func ParametersToRpc(cfg Parameters) (*clientrpc.LiquidityParameters,
 		HtlcConfTarget:             cfg.HtlcConfTarget,
 		EasyAutoloop:               cfg.EasyAutoloop,
 		EasyAutoloopLocalTargetSat: uint64(cfg.EasyAutoloopTarget),
-		Account:                    cfg.Account,
-		AccountAddrType:            addrType,
-		EasyAssetParams:            easyAssetMap,
-		FastSwapPublication:        cfg.FastSwapPublication,
+		EasyAutoloopLocalMinimumSat: uint64(
+			cfg.EasyAutoloopInTarget,
+		),
+		StaticLoopIn:        cfg.StaticLoopIn,
+		RequireApproval:     cfg.RequireApproval,
+		ApprovalExpirySec:   uint64(cfg.ApprovalExpiry.Seconds()),
+		Account:             cfg.Account,
+		AccountAddrType:     addrType,
+		EasyAssetParams:     easyAssetMap,
+		FastSwapPublication: cfg.FastSwapPublication,
+		ScheduleWindows: ScheduleWindowsToRpc(
+			cfg.ScheduleWindows,
+		),
+		FeeRateCeilingSatPerVbyte: uint64(
+			cfg.FeeRateCeiling.FeePerKVByte() / 1000,
+		),
+		MinChannelAgeBlocks:  cfg.MinChannelAge,
+		MinPeerUptimePercent: cfg.MinPeerUptime,
+		IncludePending:       cfg.IncludePending,
+	}
+
+	if cfg.ROI != nil {
+		rpcCfg.RoiLookbackSec = uint64(cfg.ROI.Lookback.Seconds())
+		rpcCfg.RoiPaybackSec = uint64(cfg.ROI.PaybackPeriod.Seconds())
+	}
+
+	if cfg.Jitter != nil {
+		rpcCfg.JitterAmountPercent = uint32(cfg.Jitter.AmountPercent)
+		rpcCfg.JitterDispatchDelaySec = uint64(
+			cfg.Jitter.DispatchDelay.Seconds(),
+		)
+		rpcCfg.JitterConfTargetSpread = uint32(
+			cfg.Jitter.ConfTargetSpread,
+		)
 	}
 
 	switch f := cfg.FeeLimit.(type) {
@@ -633,6 +1074,26 @@ func ParametersToRpc(cfg Parameters) (*clientrpc.LiquidityParameters,
 		rpcCfg.Rules = append(rpcCfg.Rules, rpcRule)
 	}
 
+	if cfg.NodeRule != nil {
+		rpcRule := newRPCRule(0, nil, cfg.NodeRule)
+		rpcRule.Node = true
+		rpcCfg.Rules = append(rpcCfg.Rules, rpcRule)
+	}
+
+	for assetID, rules := range cfg.AssetRules {
+		for channel, rule := range rules.ChannelRules {
+			rpcRule := newRPCRule(channel.ToUint64(), nil, rule)
+			rpcRule.AssetId = assetID
+			rpcCfg.Rules = append(rpcCfg.Rules, rpcRule)
+		}
+
+		for peer, rule := range rules.PeerRules {
+			rpcRule := newRPCRule(0, peer[:], rule)
+			rpcRule.AssetId = assetID
+			rpcCfg.Rules = append(rpcCfg.Rules, rpcRule)
+		}
+	}
+
 	return rpcCfg, nil
 }
 
@@ -642,17 +1103,53 @@ func newRPCRule(channelID uint64, peer []byte,
 	rule *SwapRule) *clientrpc.LiquidityRule {
 
 	rpcRule := &clientrpc.LiquidityRule{
-		ChannelId:         channelID,
-		Pubkey:            peer,
-		Type:              clientrpc.LiquidityRuleType_THRESHOLD,
-		IncomingThreshold: uint32(rule.MinimumIncoming),
-		OutgoingThreshold: uint32(rule.MinimumOutgoing),
-		SwapType:          clientrpc.SwapType_LOOP_OUT,
+		ChannelId: channelID,
+		Pubkey:    peer,
+		SwapType:  clientrpc.SwapType_LOOP_OUT,
+	}
+
+	if rule.AmountRule != nil {
+		rpcRule.Type = clientrpc.LiquidityRuleType_AMOUNT
+		rpcRule.MinIncomingSat = uint64(rule.AmountRule.MinimumIncoming)
+		rpcRule.MinOutgoingSat = uint64(rule.AmountRule.MinimumOutgoing)
+	} else {
+		rpcRule.Type = clientrpc.LiquidityRuleType_THRESHOLD
+		rpcRule.IncomingThreshold = uint32(rule.MinimumIncoming)
+		rpcRule.OutgoingThreshold = uint32(rule.MinimumOutgoing)
+	}
+
+	if rule.Predictive != nil {
+		rpcRule.Type = clientrpc.LiquidityRuleType_PREDICTIVE
+		rpcRule.ForecastLookbackSec = uint64(
+			rule.Predictive.Lookback.Seconds(),
+		)
+		rpcRule.ForecastHorizonSec = uint64(
+			rule.Predictive.Horizon.Seconds(),
+		)
 	}
 
 	if rule.Type == swap.TypeIn {
 		rpcRule.SwapType = clientrpc.SwapType_LOOP_IN
 	}
 
+	if rule.Opportunistic != nil {
+		rpcRule.OpportunisticBandPercent = uint32(
+			rule.Opportunistic.Percent,
+		)
+		rpcRule.OpportunisticFeeRateSatPerVbyte = uint64(
+			rule.Opportunistic.MaxFeeRate.FeePerKVByte() / 1000,
+		)
+	}
+
+	if rule.Budget != nil {
+		rpcRule.BudgetSat = uint64(rule.Budget.Amount)
+		rpcRule.BudgetRefreshPeriodSec = uint64(
+			rule.Budget.RefreshPeriod.Seconds(),
+		)
+		rpcRule.BudgetLastRefresh = uint64(
+			rule.Budget.LastRefresh.Unix(),
+		)
+	}
+
 	return rpcRule
 }
@@ -0,0 +1,166 @@
+package liquidity
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// pendingLiquidity is the liquidity that has not yet settled in our channels,
+// which we count in our balances when IncludePending is set. Its methods may
+// be called on a nil value, in which case no pending liquidity is counted.
+type pendingLiquidity struct {
+	// opens maps peers to the channels that we have pending open with
+	// them.
+	opens map[route.Vertex][]lndclient.PendingChannel
+
+	// swapIncoming is the incoming liquidity that our loop outs will
+	// provide once their payment is made.
+	swapIncoming btcutil.Amount
+
+	// swapOutgoing is the outgoing liquidity that our loop ins will
+	// provide once their invoice is paid.
+	swapOutgoing btcutil.Amount
+}
+
+// pendingLiquidity gathers the liquidity that has not yet settled in the
+// channels provided. Loop outs are counted until their payment is in flight,
+// after which they are counted as a pending htlc. Loop ins are counted until
+// the server has paid our invoice, and are likewise only counted as a pending
+// htlc while the server's payment is in flight.
+func (m *Manager) pendingLiquidity(ctx context.Context,
+	channels []lndclient.ChannelInfo, loopOut []*loopdb.LoopOut,
+	loopIn []*loopdb.LoopIn) (*pendingLiquidity, error) {
+
+	pendingChannels, err := m.cfg.Lnd.Client.PendingChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := &pendingLiquidity{
+		opens: make(map[route.Vertex][]lndclient.PendingChannel),
+	}
+	for _, channel := range pendingChannels.PendingOpen {
+		pending.opens[channel.PubKeyBytes] = append(
+			pending.opens[channel.PubKeyBytes], channel,
+		)
+	}
+
+	htlcs := make(map[lntypes.Hash]struct{})
+	for _, channel := range channels {
+		for _, htlc := range channel.PendingHtlcs {
+			htlcs[htlc.Hash] = struct{}{}
+		}
+	}
+
+	for _, out := range loopOut {
+		if out.State().State != loopdb.StateInitiated {
+			continue
+		}
+
+		if _, ok := htlcs[out.Hash]; ok {
+			continue
+		}
+
+		pending.swapIncoming += out.Contract.AmountRequested
+	}
+
+	for _, in := range loopIn {
+		state := in.State().State
+		if state != loopdb.StateInitiated &&
+			state != loopdb.StateHtlcPublished {
+
+			continue
+		}
+
+		if _, ok := htlcs[in.Hash]; ok {
+			continue
+		}
+
+		pending.swapOutgoing += in.Contract.AmountRequested
+	}
+
+	return pending, nil
+}
+
+// addHtlcs counts the pending htlcs of a channel in the balances provided, as
+// if they will settle. Incoming htlcs will add to our outgoing liquidity, and
+// outgoing htlcs will add to our incoming liquidity.
+func (p *pendingLiquidity) addHtlcs(balance *balances,
+	channel lndclient.ChannelInfo) {
+
+	if p == nil {
+		return
+	}
+
+	var incoming, outgoing btcutil.Amount
+	for _, htlc := range channel.PendingHtlcs {
+		if htlc.Incoming {
+			outgoing += htlc.Amount
+		} else {
+			incoming += htlc.Amount
+		}
+	}
+
+	balance.addPending(incoming, outgoing)
+}
+
+// addOpens counts the channels that we have pending open with a peer in the
+// balances provided.
+func (p *pendingLiquidity) addOpens(balance *balances, peer route.Vertex) {
+	if p == nil {
+		return
+	}
+
+	for _, channel := range p.opens[peer] {
+		balance.capacity += channel.Capacity
+		balance.addPending(channel.RemoteBalance, channel.LocalBalance)
+	}
+}
+
+// addSwaps counts the liquidity that our swaps will provide in the balances
+// provided. We can't always tell which channels a swap will shift liquidity
+// in, so this is only used for our node's aggregate balances.
+func (p *pendingLiquidity) addSwaps(balance *balances) {
+	if p == nil {
+		return
+	}
+
+	balance.addPending(p.swapIncoming, p.swapOutgoing)
+}
+
+// TargetBalance is the balance of a channel, peer or our node that a rule was
+// applied to, including any pending liquidity that was counted.
+type TargetBalance struct {
+	// Capacity is the total capacity, including pending channel opens.
+	Capacity btcutil.Amount
+
+	// Incoming is our incoming liquidity, including pending incoming
+	// liquidity.
+	Incoming btcutil.Amount
+
+	// Outgoing is our outgoing liquidity, including pending outgoing
+	// liquidity.
+	Outgoing btcutil.Amount
+
+	// PendingIncoming is the pending incoming liquidity that was counted.
+	PendingIncoming btcutil.Amount
+
+	// PendingOutgoing is the pending outgoing liquidity that was counted.
+	PendingOutgoing btcutil.Amount
+}
+
+// newTargetBalance creates a target balance from a set of balances.
+func newTargetBalance(balance *balances) *TargetBalance {
+	return &TargetBalance{
+		Capacity:        balance.capacity,
+		Incoming:        balance.incoming,
+		Outgoing:        balance.outgoing,
+		PendingIncoming: balance.pendingIncoming,
+		PendingOutgoing: balance.pendingOutgoing,
+	}
+}
@@ -0,0 +1,340 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPendingLiquidity tests gathering of the liquidity that has not yet
+// settled in our channels.
+func TestPendingLiquidity(t *testing.T) {
+	var (
+		hashInFlight   = lntypes.Hash{1}
+		hashUnpaid     = lntypes.Hash{2}
+		hashRevealed   = lntypes.Hash{3}
+		hashPublished  = lntypes.Hash{4}
+		hashSettled    = lntypes.Hash{5}
+		hashInvoicePay = lntypes.Hash{6}
+	)
+
+	// Our channel has the payment for one of our loop outs, and the
+	// server's payment for one of our loop ins in flight.
+	channel := channel1
+	channel.PendingHtlcs = []lndclient.PendingHtlc{
+		{
+			Incoming: false,
+			Amount:   100,
+			Hash:     hashInFlight,
+		},
+		{
+			Incoming: true,
+			Amount:   200,
+			Hash:     hashInvoicePay,
+		},
+	}
+
+	swapLoop := func(hash lntypes.Hash,
+		state loopdb.SwapState) loopdb.Loop {
+
+		return loopdb.Loop{
+			Hash: hash,
+			Events: []*loopdb.LoopEvent{
+				{
+					SwapStateData: loopdb.SwapStateData{
+						State: state,
+					},
+				},
+			},
+		}
+	}
+
+	loopOut := func(hash lntypes.Hash, amount int64,
+		state loopdb.SwapState) *loopdb.LoopOut {
+
+		return &loopdb.LoopOut{
+			Loop: swapLoop(hash, state),
+			Contract: &loopdb.LoopOutContract{
+				SwapContract: loopdb.SwapContract{
+					AmountRequested: btcutil.Amount(amount),
+				},
+			},
+		}
+	}
+
+	loopIn := func(hash lntypes.Hash, amount int64,
+		state loopdb.SwapState) *loopdb.LoopIn {
+
+		return &loopdb.LoopIn{
+			Loop: swapLoop(hash, state),
+			Contract: &loopdb.LoopInContract{
+				SwapContract: loopdb.SwapContract{
+					AmountRequested: btcutil.Amount(amount),
+				},
+			},
+		}
+	}
+
+	open := lndclient.PendingChannel{
+		PubKeyBytes:   peer1,
+		Capacity:      5000,
+		LocalBalance:  1000,
+		RemoteBalance: 4000,
+	}
+
+	cfg, lnd := newTestConfig()
+	lnd.PendingChannels = lndclient.PendingChannels{
+		PendingOpen: []lndclient.PendingChannel{open},
+	}
+
+	manager := NewManager(cfg)
+	pending, err := manager.pendingLiquidity(
+		context.Background(), []lndclient.ChannelInfo{channel},
+		[]*loopdb.LoopOut{
+			loopOut(hashInFlight, 100, loopdb.StateInitiated),
+			loopOut(hashUnpaid, 1000, loopdb.StateInitiated),
+			loopOut(
+				hashRevealed, 10000,
+				loopdb.StatePreimageRevealed,
+			),
+		},
+		[]*loopdb.LoopIn{
+			loopIn(hashPublished, 2000, loopdb.StateHtlcPublished),
+			loopIn(hashSettled, 20000, loopdb.StateInvoiceSettled),
+			loopIn(hashInvoicePay, 200, loopdb.StateHtlcPublished),
+		},
+	)
+	require.NoError(t, err)
+
+	// Only swaps that have not shifted liquidity in our channels, and do
+	// not have a payment in flight, are counted.
+	require.Equal(t, &pendingLiquidity{
+		opens: map[route.Vertex][]lndclient.PendingChannel{
+			peer1: {open},
+		},
+		swapIncoming: 1000,
+		swapOutgoing: 2000,
+	}, pending)
+
+	balance := newBalances(channel)
+	pending.addHtlcs(balance, channel)
+	pending.addOpens(balance, peer1)
+	pending.addSwaps(balance)
+
+	require.Equal(t, &TargetBalance{
+		Capacity:        15000,
+		Incoming:        5100,
+		Outgoing:        13200,
+		PendingIncoming: 5100,
+		PendingOutgoing: 3200,
+	}, newTargetBalance(balance))
+
+	// A nil set of pending liquidity should not change our balances.
+	var noPending *pendingLiquidity
+	balance = newBalances(channel)
+	noPending.addHtlcs(balance, channel)
+	noPending.addOpens(balance, peer1)
+	noPending.addSwaps(balance)
+	require.Equal(t, newBalances(channel), balance)
+}
+
+// TestIncludePendingSuggestions tests that pending liquidity is counted in
+// the balances that our rules are applied to, and reported in our
+// suggestions.
+func TestIncludePendingSuggestions(t *testing.T) {
+	// Our channel has 4000 sats of outgoing liquidity, and 6000 sats in
+	// flight to our peer, which brings its incoming liquidity to our
+	// threshold if it settles.
+	htlcChannel := channel1
+	htlcChannel.LocalBalance = 4000
+	htlcChannel.PendingHtlcs = []lndclient.PendingHtlc{
+		{
+			Amount: 6000,
+		},
+	}
+
+	var (
+		chanRules = map[lnwire.ShortChannelID]*SwapRule{
+			chanID1: chanRule,
+		}
+
+		chanOk = map[lnwire.ShortChannelID]Reason{
+			chanID1: ReasonLiquidityOk,
+		}
+
+		htlcBalances = map[lnwire.ShortChannelID]*TargetBalance{
+			chanID1: {
+				Capacity:        10000,
+				Incoming:        6000,
+				Outgoing:        4000,
+				PendingIncoming: 6000,
+			},
+		}
+	)
+
+	// Our peer is opening an inbound channel to us, which brings our
+	// incoming liquidity with them to our threshold once it confirms.
+	pendingOpen := lndclient.PendingChannel{
+		PubKeyBytes:   peer1,
+		Capacity:      10000,
+		RemoteBalance: 10000,
+	}
+
+	// Our unrestricted loop out will bring our node's incoming liquidity
+	// to our threshold once its payment is made.
+	inFlight := &loopdb.LoopOut{
+		Contract: &loopdb.LoopOutContract{
+			SwapContract: loopdb.SwapContract{
+				AmountRequested: 5000,
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		channels    []lndclient.ChannelInfo
+		pending     lndclient.PendingChannels
+		loopOut     []*loopdb.LoopOut
+		params      func(Parameters) Parameters
+		suggestions *Suggestions
+	}{
+		{
+			name:     "pending htlc",
+			channels: []lndclient.ChannelInfo{htlcChannel},
+			params: func(p Parameters) Parameters {
+				p.ChannelRules = chanRules
+				return p
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: chanOk,
+				DisqualifiedPeers: noPeersDisqualified,
+				ChanBalances:      htlcBalances,
+			},
+		},
+		{
+			name:     "pending open",
+			channels: []lndclient.ChannelInfo{channel1},
+			pending: lndclient.PendingChannels{
+				PendingOpen: []lndclient.PendingChannel{
+					pendingOpen,
+				},
+			},
+			params: func(p Parameters) Parameters {
+				p.PeerRules = map[route.Vertex]*SwapRule{
+					peer1: chanRule,
+				}
+				return p
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: map[route.Vertex]Reason{
+					peer1: ReasonLiquidityOk,
+				},
+				PeerBalances: map[route.Vertex]*TargetBalance{
+					peer1: {
+						Capacity:        20000,
+						Incoming:        10000,
+						Outgoing:        10000,
+						PendingIncoming: 10000,
+					},
+				},
+			},
+		},
+		{
+			name:     "in flight swap",
+			channels: []lndclient.ChannelInfo{channel1},
+			loopOut:  []*loopdb.LoopOut{inFlight},
+			params: func(p Parameters) Parameters {
+				p.NodeRule = chanRule
+				return p
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				DisqualifiedNode:  ReasonLiquidityOk,
+				NodeBalance: &TargetBalance{
+					Capacity:        10000,
+					Incoming:        5000,
+					Outgoing:        10000,
+					PendingIncoming: 5000,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = testCase.channels
+			lnd.PendingChannels = testCase.pending
+
+			cfg.ListLoopOut = func(
+				context.Context) ([]*loopdb.LoopOut, error) {
+
+				return testCase.loopOut, nil
+			}
+
+			params := defaultParameters
+			params.AutoloopBudgetLastRefresh = testBudgetStart
+			params.IncludePending = true
+			params = testCase.params(params)
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestPendingNodeRule tests that our node rule only loops out the amount
+// that is required once pending liquidity is counted.
+func TestPendingNodeRule(t *testing.T) {
+	cfg, lnd := newTestConfig()
+
+	channel := channel1
+	channel.Active = true
+	lnd.Channels = []lndclient.ChannelInfo{channel}
+
+	// Our in flight swap brings our incoming liquidity to 2000 sats, so
+	// we only need to loop out 5500 sats to reach the 7500 sat midpoint
+	// of our threshold.
+	cfg.ListLoopOut = func(context.Context) ([]*loopdb.LoopOut, error) {
+		return []*loopdb.LoopOut{
+			{
+				Contract: &loopdb.LoopOutContract{
+					SwapContract: loopdb.SwapContract{
+						AmountRequested: 2000,
+					},
+				},
+			},
+		}, nil
+	}
+
+	params := defaultParameters
+	params.AutoloopBudgetLastRefresh = testBudgetStart
+	params.IncludePending = true
+	params.NodeRule = &SwapRule{
+		ThresholdRule: NewThresholdRule(50, 0),
+		Type:          swap.TypeOut,
+	}
+
+	manager := NewManager(cfg)
+	err := manager.setParameters(context.Background(), params)
+	require.NoError(t, err)
+
+	suggestions, err := manager.SuggestSwaps(context.Background())
+	require.NoError(t, err)
+	require.Len(t, suggestions.OutSwaps, 1)
+	require.EqualValues(t, 5500, suggestions.OutSwaps[0].Amount)
+}
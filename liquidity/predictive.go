@@ -0,0 +1,286 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// forwardingHistoryBatch is the number of forwarding events that we
+	// query from lnd at a time.
+	forwardingHistoryBatch = 10000
+)
+
+var (
+	// errPredictiveThreshold is returned when a predictive rule is set
+	// without a threshold rule.
+	errPredictiveThreshold = errors.New("predictive rules require " +
+		"incoming and outgoing thresholds")
+
+	// errZeroForecastLookback is returned when a predictive rule does not
+	// have a lookback period set.
+	errZeroForecastLookback = errors.New("forecast lookback must be > 0")
+
+	// errZeroForecastHorizon is returned when a predictive rule does not
+	// have a horizon set.
+	errZeroForecastHorizon = errors.New("forecast horizon must be > 0")
+)
+
+// PredictiveRule extends a threshold rule to also consider the rate at which
+// a target's liquidity has been drained by forwards. If the target's liquidity
+// is projected to cross the rule's threshold within the horizon, we swap
+// before the threshold is actually crossed.
+type PredictiveRule struct {
+	// Lookback is the period of forwarding history that we use to
+	// estimate the rate at which liquidity is drained.
+	Lookback time.Duration
+
+	// Horizon is the period that we project liquidity over.
+	Horizon time.Duration
+}
+
+// String returns a string representation of a predictive rule.
+func (p *PredictiveRule) String() string {
+	return fmt.Sprintf("forecast: %v lookback, %v horizon", p.Lookback,
+		p.Horizon)
+}
+
+// validate checks that a predictive rule is valid.
+func (p *PredictiveRule) validate() error {
+	if p.Lookback <= 0 {
+		return errZeroForecastLookback
+	}
+
+	if p.Horizon <= 0 {
+		return errZeroForecastHorizon
+	}
+
+	return nil
+}
+
+// Projection describes the liquidity that we project a channel or peer to
+// have at the end of its rule's horizon, based on its recent forwards.
+type Projection struct {
+	// NetOutflow is the net amount that was forwarded out of our local
+	// balance over the lookback period. This value is negative if more
+	// was forwarded in than out.
+	NetOutflow btcutil.Amount
+
+	// Lookback is the period of forwarding history that the projection
+	// is based on.
+	Lookback time.Duration
+
+	// Horizon is the period that liquidity was projected over.
+	Horizon time.Duration
+
+	// Incoming is the projected incoming liquidity at the end of the
+	// horizon.
+	Incoming btcutil.Amount
+
+	// Outgoing is the projected outgoing liquidity at the end of the
+	// horizon.
+	Outgoing btcutil.Amount
+
+	// Predicted indicates that a swap was suggested for the target
+	// because of its projected, rather than current, liquidity.
+	Predicted bool
+}
+
+// haveForecasts returns a boolean indicating whether any of our rules are
+// predictive, and the longest lookback period that they require.
+func (p Parameters) haveForecasts() (bool, time.Duration) {
+	var (
+		havePredictive bool
+		lookback       time.Duration
+	)
+
+	check := func(rule *SwapRule) {
+		if rule.Predictive == nil {
+			return
+		}
+
+		havePredictive = true
+		if rule.Predictive.Lookback > lookback {
+			lookback = rule.Predictive.Lookback
+		}
+	}
+
+	for _, rule := range p.ChannelRules {
+		check(rule)
+	}
+
+	for _, rule := range p.PeerRules {
+		check(rule)
+	}
+
+	return havePredictive, lookback
+}
+
+// forwardingHistory queries lnd for all forwarding events since the time
+// provided.
+func (m *Manager) forwardingHistory(ctx context.Context,
+	start time.Time) ([]lndclient.ForwardingEvent, error) {
+
+	var (
+		events []lndclient.ForwardingEvent
+		offset uint32
+	)
+
+	for {
+		resp, err := m.cfg.Lnd.Client.ForwardingHistory(
+			ctx, lndclient.ForwardingHistoryRequest{
+				StartTime: start,
+				EndTime:   m.cfg.Clock.Now(),
+				MaxEvents: forwardingHistoryBatch,
+				Offset:    offset,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, resp.Events...)
+
+		if len(resp.Events) < forwardingHistoryBatch {
+			return events, nil
+		}
+
+		offset = resp.LastIndexOffset
+	}
+}
+
+// netOutflow returns the net amount forwarded out of our local balance on
+// the set of channels provided since the time provided. Forwards between two
+// channels in the set are counted in both directions, so cancel out.
+func netOutflow(events []lndclient.ForwardingEvent,
+	channels []lnwire.ShortChannelID, since time.Time) btcutil.Amount {
+
+	chanSet := make(map[uint64]bool, len(channels))
+	for _, channel := range channels {
+		chanSet[channel.ToUint64()] = true
+	}
+
+	var outflow lnwire.MilliSatoshi
+	var inflow lnwire.MilliSatoshi
+	for _, event := range events {
+		if event.Timestamp.Before(since) {
+			continue
+		}
+
+		if chanSet[event.ChannelOut] {
+			outflow += event.AmountMsatOut
+		}
+
+		if chanSet[event.ChannelIn] {
+			inflow += event.AmountMsatIn
+		}
+	}
+
+	return outflow.ToSatoshis() - inflow.ToSatoshis()
+}
+
+// newProjection projects a target's balances over a rule's horizon, based on
+// the rate at which its liquidity was drained over the rule's lookback.
+func newProjection(rule *PredictiveRule, balance *balances,
+	events []lndclient.ForwardingEvent, now time.Time) *Projection {
+
+	outflow := netOutflow(
+		events, balance.channels, now.Add(-rule.Lookback),
+	)
+
+	// Scale our net outflow from the lookback period to our horizon.
+	shift := btcutil.Amount(
+		float64(outflow) * float64(rule.Horizon) /
+			float64(rule.Lookback),
+	)
+
+	clamp := func(amt btcutil.Amount) btcutil.Amount {
+		switch {
+		case amt < 0:
+			return 0
+
+		case amt > balance.capacity:
+			return balance.capacity
+
+		default:
+			return amt
+		}
+	}
+
+	return &Projection{
+		NetOutflow: outflow,
+		Lookback:   rule.Lookback,
+		Horizon:    rule.Horizon,
+		Incoming:   clamp(balance.incoming + shift),
+		Outgoing:   clamp(balance.outgoing - shift),
+	}
+}
+
+// predictedAmount returns the amount that we should swap for a target whose
+// current liquidity does not require a swap, but whose projected liquidity
+// crosses its rule's threshold. The amount is calculated on our projected
+// balances, but limited to the amount that we can currently shift without
+// dipping below our reserve threshold.
+func predictedAmount(rule *SwapRule, balance *balances,
+	projection *Projection, restrictions *Restrictions) btcutil.Amount {
+
+	if rule.Predictive == nil || rule.ThresholdRule == nil ||
+		projection == nil {
+
+		return 0
+	}
+
+	projected := &balances{
+		capacity: balance.capacity,
+		incoming: projection.Incoming,
+		outgoing: projection.Outgoing,
+		channels: balance.channels,
+		pubkey:   balance.pubkey,
+	}
+
+	amount := rule.ThresholdRule.swapAmount(
+		projected, restrictions, rule.Type,
+	)
+	if amount == 0 {
+		return 0
+	}
+
+	// Our projected balances may have more reserve available than we
+	// currently have, so we limit our swap to the reserve that we can
+	// currently use.
+	reserve, reservePercentage := balance.outgoing, rule.MinimumOutgoing
+	if rule.Type == swap.TypeIn {
+		reserve, reservePercentage = balance.incoming,
+			rule.MinimumIncoming
+	}
+
+	reserveMinimum := balance.capacity *
+		btcutil.Amount(reservePercentage) / 100
+
+	available := reserve - reserveMinimum
+	if available <= 0 {
+		return 0
+	}
+
+	if amount > available {
+		amount = available
+	}
+
+	amount = limitSwapAmount(amount, restrictions)
+	if amount != 0 {
+		log.Infof("Predictive swap of %v suggested for %v, net outflow "+
+			"over %v: %v, projected incoming: %v, projected "+
+			"outgoing: %v", amount, balance.channels,
+			projection.Lookback, projection.NetOutflow,
+			projection.Incoming, projection.Outgoing)
+	}
+
+	return amount
+}
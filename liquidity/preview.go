@@ -0,0 +1,62 @@
+package liquidity
+
+import (
+	"context"
+	"time"
+)
+
+// PreviewSwaps returns the swaps that we would suggest if the parameters
+// provided were set, without saving them. The budget state of our current
+// parameters is carried over to the parameters provided, so that previewed
+// suggestions are subject to the budget that we have already used, and the
+// preview does not refresh any budgets.
+func (m *Manager) PreviewSwaps(ctx context.Context,
+	params Parameters) (*Suggestions, error) {
+
+	if err := m.validateParameters(ctx, params); err != nil {
+		return nil, err
+	}
+
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	preview := NewManager(m.cfg)
+	preview.params = cloneParameters(params)
+	preview.params.carryBudgetState(m.params, m.cfg.Clock.Now())
+
+	suggestions, _, err := preview.suggestSwaps(ctx)
+
+	return suggestions, err
+}
+
+// carryBudgetState copies the last refresh of our autoloop budget, and of the
+// budgets of any rules that are also set in the current parameters provided,
+// to our parameters. Budgets for rules that are not currently set start at
+// the time provided.
+func (p *Parameters) carryBudgetState(current Parameters, now time.Time) {
+	p.AutoloopBudgetLastRefresh = current.AutoloopBudgetLastRefresh
+
+	lastRefresh := func(rule *SwapRule) time.Time {
+		if rule == nil || rule.Budget == nil {
+			return now
+		}
+
+		return rule.Budget.LastRefresh
+	}
+
+	for id, rule := range p.ChannelRules {
+		if rule.Budget != nil {
+			rule.Budget.LastRefresh = lastRefresh(
+				current.ChannelRules[id],
+			)
+		}
+	}
+
+	for peer, rule := range p.PeerRules {
+		if rule.Budget != nil {
+			rule.Budget.LastRefresh = lastRefresh(
+				current.PeerRules[peer],
+			)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPreviewSwaps tests that we suggest swaps for previewed parameters
+// without changing our current parameters.
+func TestPreviewSwaps(t *testing.T) {
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{channel1}
+
+	manager := NewManager(cfg)
+
+	params := defaultParameters
+	params.AutoloopBudgetLastRefresh = testBudgetStart
+	err := manager.setParameters(context.Background(), params)
+	require.NoError(t, err)
+
+	preview := defaultParameters
+	preview.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+	}
+
+	suggestions, err := manager.PreviewSwaps(
+		context.Background(), preview,
+	)
+	require.NoError(t, err)
+	require.Equal(t, &Suggestions{
+		OutSwaps: []loop.OutRequest{
+			chan1Rec,
+		},
+		DisqualifiedChans: noneDisqualified,
+		DisqualifiedPeers: noPeersDisqualified,
+	}, suggestions)
+
+	// Our current parameters should not have been changed by our preview.
+	require.Equal(t, params, manager.GetParameters())
+
+	_, err = manager.SuggestSwaps(context.Background())
+	require.ErrorIs(t, err, ErrNoRules)
+
+	// Parameters that are invalid should fail.
+	preview.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		lnwire.NewShortChanIDFromInt(0): chanRule,
+	}
+	_, err = manager.PreviewSwaps(context.Background(), preview)
+	require.Error(t, err)
+}
+
+// TestCarryBudgetState tests carrying over the budget state of our current
+// parameters to a set of parameters.
+func TestCarryBudgetState(t *testing.T) {
+	var (
+		now         = testBudgetStart.Add(time.Hour * 24)
+		lastRefresh = testBudgetStart.Add(time.Hour)
+	)
+
+	budgetRule := func(lastRefresh time.Time) *SwapRule {
+		return &SwapRule{
+			ThresholdRule: NewThresholdRule(10, 10),
+			Budget: &TargetBudget{
+				Amount:        1000,
+				RefreshPeriod: time.Hour * 48,
+				LastRefresh:   lastRefresh,
+			},
+		}
+	}
+
+	current := defaultParameters
+	current.AutoloopBudgetLastRefresh = testBudgetStart
+	current.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: budgetRule(lastRefresh),
+		chanID2: chanRule,
+	}
+	current.PeerRules = map[route.Vertex]*SwapRule{
+		peer1: budgetRule(lastRefresh),
+	}
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: budgetRule(time.Time{}),
+		chanID2: budgetRule(time.Time{}),
+	}
+	params.PeerRules = map[route.Vertex]*SwapRule{
+		peer1: budgetRule(time.Time{}),
+		peer2: budgetRule(time.Time{}),
+	}
+
+	params.carryBudgetState(current, now)
+
+	// Our global budget and the budgets of rules that are currently set
+	// should be carried over, and new budgets should start now.
+	require.Equal(t, testBudgetStart, params.AutoloopBudgetLastRefresh)
+	require.Equal(
+		t, lastRefresh, params.ChannelRules[chanID1].Budget.LastRefresh,
+	)
+	require.Equal(t, now, params.ChannelRules[chanID2].Budget.LastRefresh)
+	require.Equal(t, lastRefresh, params.PeerRules[peer1].Budget.LastRefresh)
+	require.Equal(t, now, params.PeerRules[peer2].Budget.LastRefresh)
+}
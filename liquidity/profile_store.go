@@ -0,0 +1,333 @@
+package liquidity
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+	clientrpc "github.com/lightninglabs/loop/looprpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// A compile-time check that SQLStore implements ProfileStore.
+var _ ProfileStore = (*SQLStore)(nil)
+
+// AddProfileVersion stores a new version of the named profile, creating the
+// profile if it does not exist yet, and returns the version number that it
+// was stored under.
+func (s *SQLStore) AddProfileVersion(ctx context.Context, name string,
+	params *clientrpc.LiquidityParameters, schedule []ScheduleWindow,
+	created time.Time) (uint32, error) {
+
+	paramsBytes, err := proto.Marshal(params)
+	if err != nil {
+		return 0, err
+	}
+
+	var version int32
+	err = s.baseDb.ExecTx(ctx, loopdb.NewSqlWriteOpts(),
+		func(q Querier) error {
+			profileID, err := getOrCreateProfile(
+				ctx, q, name, created,
+			)
+			if err != nil {
+				return err
+			}
+
+			latest, err := q.GetLatestLiquidityProfileVersion(
+				ctx, profileID,
+			)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+
+			case err != nil:
+				return err
+			}
+			version = latest.Version + 1
+
+			versionID, err := q.InsertLiquidityProfileVersion(
+				ctx, sqlc.InsertLiquidityProfileVersionParams{
+					ProfileID: profileID,
+					Version:   version,
+					Params:    paramsBytes,
+					CreatedAt: created.UTC(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return insertProfileSchedule(
+				ctx, q, versionID, schedule,
+			)
+		})
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(version), nil
+}
+
+// GetProfileVersion returns a version of the named profile. If the version is
+// zero, the latest version is returned.
+func (s *SQLStore) GetProfileVersion(ctx context.Context, name string,
+	version uint32) (*ProfileVersion, error) {
+
+	if version > math.MaxInt32 {
+		return nil, ErrProfileVersionNotFound
+	}
+
+	var profileVersion *ProfileVersion
+	err := s.baseDb.ExecTx(ctx, loopdb.NewSqlReadOpts(),
+		func(q Querier) error {
+			profile, err := q.GetLiquidityProfile(ctx, name)
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrProfileNotFound
+			}
+			if err != nil {
+				return err
+			}
+
+			row, err := getProfileVersionRow(
+				ctx, q, profile.ID, version,
+			)
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrProfileVersionNotFound
+			}
+			if err != nil {
+				return err
+			}
+
+			profileVersion, err = sqlProfileVersion(ctx, q, row)
+
+			return err
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return profileVersion, nil
+}
+
+// ListProfiles returns all of our profiles along with their versions, ordered
+// by name.
+func (s *SQLStore) ListProfiles(ctx context.Context) ([]*Profile, error) {
+	var profiles []*Profile
+	err := s.baseDb.ExecTx(ctx, loopdb.NewSqlReadOpts(),
+		func(q Querier) error {
+			rows, err := q.ListLiquidityProfiles(ctx)
+			if err != nil {
+				return err
+			}
+
+			for _, row := range rows {
+				versions, err := q.ListLiquidityProfileVersions(
+					ctx, row.ID,
+				)
+				if err != nil {
+					return err
+				}
+
+				profile := &Profile{
+					Name:      row.Name,
+					CreatedAt: row.CreatedAt,
+				}
+
+				for _, versionRow := range versions {
+					version, err := sqlProfileVersion(
+						ctx, q, versionRow,
+					)
+					if err != nil {
+						return err
+					}
+
+					profile.Versions = append(
+						profile.Versions, version,
+					)
+				}
+
+				profiles = append(profiles, profile)
+			}
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// AddProfileActivation records the activation of a profile.
+func (s *SQLStore) AddProfileActivation(ctx context.Context,
+	activation *ProfileActivation) error {
+
+	return s.baseDb.ExecTx(ctx, loopdb.NewSqlWriteOpts(),
+		func(q Querier) error {
+			profile, err := q.GetLiquidityProfile(
+				ctx, activation.Name,
+			)
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrProfileNotFound
+			}
+			if err != nil {
+				return err
+			}
+
+			args := sqlc.InsertLiquidityProfileActivationParams{
+				ProfileID:   profile.ID,
+				Version:     int32(activation.Version),
+				ActivatedAt: activation.Timestamp.UTC(),
+				Scheduled:   activation.Scheduled,
+			}
+
+			return q.InsertLiquidityProfileActivation(ctx, args)
+		})
+}
+
+// ListProfileActivations returns up to max of our most recent profile
+// activations, ordered from newest to oldest.
+func (s *SQLStore) ListProfileActivations(ctx context.Context,
+	max uint32) ([]*ProfileActivation, error) {
+
+	if max > math.MaxInt32 {
+		max = math.MaxInt32
+	}
+
+	rows, err := s.baseDb.ListLiquidityProfileActivations(ctx, int32(max))
+	if err != nil {
+		return nil, err
+	}
+
+	activations := make([]*ProfileActivation, len(rows))
+	for i, row := range rows {
+		activations[i] = &ProfileActivation{
+			Name:      row.Name,
+			Version:   uint32(row.Version),
+			Timestamp: row.ActivatedAt,
+			Scheduled: row.Scheduled,
+		}
+	}
+
+	return activations, nil
+}
+
+// getOrCreateProfile returns the id of the named profile, creating the
+// profile if it does not exist.
+func getOrCreateProfile(ctx context.Context, q Querier, name string,
+	created time.Time) (int32, error) {
+
+	profile, err := q.GetLiquidityProfile(ctx, name)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return q.InsertLiquidityProfile(
+			ctx, sqlc.InsertLiquidityProfileParams{
+				Name:      name,
+				CreatedAt: created.UTC(),
+			},
+		)
+
+	case err != nil:
+		return 0, err
+	}
+
+	return profile.ID, nil
+}
+
+// insertProfileSchedule stores the activation windows of a profile version.
+func insertProfileSchedule(ctx context.Context, q Querier, versionID int32,
+	schedule []ScheduleWindow) error {
+
+	for _, window := range schedule {
+		args := sqlc.InsertLiquidityProfileScheduleParams{
+			ProfileVersionID: versionID,
+			Days:             scheduleDaysToMask(window.Days),
+			StartHour:        int32(window.StartHour),
+			EndHour:          int32(window.EndHour),
+		}
+
+		err := q.InsertLiquidityProfileSchedule(ctx, args)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getProfileVersionRow fetches a version of a profile from the database. If
+// the version is zero, the latest version is fetched.
+func getProfileVersionRow(ctx context.Context, q Querier, profileID int32,
+	version uint32) (sqlc.LiquidityProfileVersion, error) {
+
+	if version == 0 {
+		return q.GetLatestLiquidityProfileVersion(ctx, profileID)
+	}
+
+	return q.GetLiquidityProfileVersion(
+		ctx, sqlc.GetLiquidityProfileVersionParams{
+			ProfileID: profileID,
+			Version:   int32(version),
+		},
+	)
+}
+
+// sqlProfileVersion converts a profile version from the database, fetching
+// its activation windows.
+func sqlProfileVersion(ctx context.Context, q Querier,
+	row sqlc.LiquidityProfileVersion) (*ProfileVersion, error) {
+
+	params := &clientrpc.LiquidityParameters{}
+	if err := proto.Unmarshal(row.Params, params); err != nil {
+		return nil, err
+	}
+
+	windows, err := q.GetLiquidityProfileSchedules(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	version := &ProfileVersion{
+		Version:   uint32(row.Version),
+		Params:    params,
+		CreatedAt: row.CreatedAt,
+	}
+
+	for _, window := range windows {
+		version.Schedule = append(version.Schedule, ScheduleWindow{
+			Days:      scheduleMaskToDays(window.Days),
+			StartHour: int(window.StartHour),
+			EndHour:   int(window.EndHour),
+		})
+	}
+
+	return version, nil
+}
+
+// scheduleDaysToMask encodes a set of days of the week as a bitmask, where bit
+// zero is Sunday.
+func scheduleDaysToMask(days []time.Weekday) int32 {
+	var mask int32
+	for _, day := range days {
+		mask |= 1 << uint(day)
+	}
+
+	return mask
+}
+
+// scheduleMaskToDays decodes a bitmask of days of the week. An empty mask is
+// decoded to nil, which applies to every day of the week.
+func scheduleMaskToDays(mask int32) []time.Weekday {
+	var days []time.Weekday
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if mask&(1<<uint(day)) != 0 {
+			days = append(days, day)
+		}
+	}
+
+	return days
+}
@@ -0,0 +1,120 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	clientrpc "github.com/lightninglabs/loop/looprpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestProfileStore tests storing and querying liquidity profiles, their
+// versions and activations.
+func TestProfileStore(t *testing.T) {
+	ctx := context.Background()
+	testDb := loopdb.NewTestDB(t)
+	defer testDb.Close()
+
+	store := NewSQLStore(loopdb.NewTypedStore[Querier](testDb))
+
+	t1 := testTime.Add(time.Hour)
+
+	weekday := &clientrpc.LiquidityParameters{
+		AutoMaxInFlight: 1,
+	}
+	weekend := &clientrpc.LiquidityParameters{
+		AutoMaxInFlight: 2,
+	}
+	weekendSchedule := []ScheduleWindow{
+		{
+			Days:      []time.Weekday{time.Sunday, time.Saturday},
+			StartHour: 0,
+			EndHour:   24,
+		},
+	}
+
+	// Looking up a profile that does not exist fails.
+	_, err := store.GetProfileVersion(ctx, "weekday", 0)
+	require.ErrorIs(t, err, ErrProfileNotFound)
+
+	// Each version that we add to a profile is numbered in order.
+	version, err := store.AddProfileVersion(
+		ctx, "weekday", weekday, nil, testTime,
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), version)
+
+	version, err = store.AddProfileVersion(
+		ctx, "weekday", weekend, nil, t1,
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), version)
+
+	version, err = store.AddProfileVersion(
+		ctx, "weekend", weekend, weekendSchedule, t1,
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), version)
+
+	// A zero version returns the latest version of a profile.
+	latest, err := store.GetProfileVersion(ctx, "weekday", 0)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), latest.Version)
+	require.True(t, proto.Equal(weekend, latest.Params))
+	require.Equal(t, t1, latest.CreatedAt)
+
+	first, err := store.GetProfileVersion(ctx, "weekday", 1)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(weekday, first.Params))
+	require.Nil(t, first.Schedule)
+
+	_, err = store.GetProfileVersion(ctx, "weekday", 3)
+	require.ErrorIs(t, err, ErrProfileVersionNotFound)
+
+	// Our profiles are listed by name, with their activation windows.
+	profiles, err := store.ListProfiles(ctx)
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+
+	require.Equal(t, "weekday", profiles[0].Name)
+	require.Equal(t, testTime, profiles[0].CreatedAt)
+	require.Len(t, profiles[0].Versions, 2)
+
+	require.Equal(t, "weekend", profiles[1].Name)
+	require.Len(t, profiles[1].Versions, 1)
+	require.Equal(t, weekendSchedule, profiles[1].Versions[0].Schedule)
+
+	// Activations are listed from newest to oldest.
+	activation1 := &ProfileActivation{
+		Name:      "weekday",
+		Version:   1,
+		Timestamp: testTime,
+	}
+	activation2 := &ProfileActivation{
+		Name:      "weekend",
+		Version:   1,
+		Timestamp: t1,
+		Scheduled: true,
+	}
+
+	require.NoError(t, store.AddProfileActivation(ctx, activation1))
+	require.NoError(t, store.AddProfileActivation(ctx, activation2))
+
+	err = store.AddProfileActivation(ctx, &ProfileActivation{
+		Name: "unknown",
+	})
+	require.ErrorIs(t, err, ErrProfileNotFound)
+
+	activations, err := store.ListProfileActivations(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*ProfileActivation{
+		activation2, activation1,
+	}, activations)
+
+	activations, err = store.ListProfileActivations(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []*ProfileActivation{activation2}, activations)
+}
@@ -0,0 +1,676 @@
+package liquidity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	clientrpc "github.com/lightninglabs/loop/looprpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultMaxActivations is the number of profile activations that we return
+// when a query does not specify a maximum.
+const defaultMaxActivations = 100
+
+var (
+	// ErrNoProfileStore is returned when liquidity profiles are used, but
+	// no store is configured.
+	ErrNoProfileStore = errors.New("liquidity profiles not enabled")
+
+	// ErrNoProfileName is returned when a profile is saved without a name.
+	ErrNoProfileName = errors.New("liquidity profile name required")
+
+	// ErrProfileNotFound is returned when a profile does not exist.
+	ErrProfileNotFound = errors.New("liquidity profile not found")
+
+	// ErrProfileVersionNotFound is returned when a profile does not have
+	// the version requested.
+	ErrProfileVersionNotFound = errors.New("liquidity profile version " +
+		"not found")
+
+	// ErrNoEarlierVersion is returned when we try to roll a profile back
+	// to a version that is not earlier than its latest version.
+	ErrNoEarlierVersion = errors.New("liquidity profiles can only be " +
+		"rolled back to an earlier version")
+)
+
+// ProfileStore is the interface required to persist named, versioned sets of
+// liquidity parameters.
+type ProfileStore interface {
+	// AddProfileVersion stores a new version of the named profile,
+	// creating the profile if it does not exist yet, and returns the
+	// version number that it was stored under.
+	AddProfileVersion(ctx context.Context, name string,
+		params *clientrpc.LiquidityParameters,
+		schedule []ScheduleWindow, created time.Time) (uint32, error)
+
+	// GetProfileVersion returns a version of the named profile. If the
+	// version is zero, the latest version is returned.
+	GetProfileVersion(ctx context.Context, name string,
+		version uint32) (*ProfileVersion, error)
+
+	// ListProfiles returns all of our profiles along with their versions,
+	// ordered by name.
+	ListProfiles(ctx context.Context) ([]*Profile, error)
+
+	// AddProfileActivation records the activation of a profile.
+	AddProfileActivation(ctx context.Context,
+		activation *ProfileActivation) error
+
+	// ListProfileActivations returns up to max of our most recent profile
+	// activations, ordered from newest to oldest.
+	ListProfileActivations(ctx context.Context,
+		max uint32) ([]*ProfileActivation, error)
+}
+
+// Profile is a named set of liquidity parameters, along with the history of
+// changes made to it.
+type Profile struct {
+	// Name is the unique name of the profile.
+	Name string
+
+	// CreatedAt is the time at which the profile was first saved.
+	CreatedAt time.Time
+
+	// Versions is the set of versions of the profile, ordered from oldest
+	// to newest.
+	Versions []*ProfileVersion
+}
+
+// latest returns the latest version of a profile.
+func (p *Profile) latest() *ProfileVersion {
+	if len(p.Versions) == 0 {
+		return nil
+	}
+
+	return p.Versions[len(p.Versions)-1]
+}
+
+// ProfileVersion is a single version of a liquidity profile.
+type ProfileVersion struct {
+	// Version is the version number of the profile, starting at one.
+	Version uint32
+
+	// Params is the set of liquidity parameters of the version.
+	Params *clientrpc.LiquidityParameters
+
+	// Schedule is the set of windows in which the version is
+	// automatically activated. If empty, the profile is only activated
+	// manually.
+	Schedule []ScheduleWindow
+
+	// CreatedAt is the time at which the version was saved.
+	CreatedAt time.Time
+}
+
+// scheduleEntered returns the time at which we entered the version's
+// activation windows, if the time provided falls in one of them. If
+// overlapping windows contain the time, the earliest time is returned. Unlike
+// our autoloop schedule, a version without any windows is never in schedule.
+func (v *ProfileVersion) scheduleEntered(now time.Time) (time.Time, bool) {
+	var (
+		entered time.Time
+		ok      bool
+	)
+	for _, window := range v.Schedule {
+		if !window.contains(now) {
+			continue
+		}
+
+		opened := window.opened(now)
+		if !ok || opened.Before(entered) {
+			entered, ok = opened, true
+		}
+	}
+
+	return entered, ok
+}
+
+// ProfileActivation records the activation of a profile.
+type ProfileActivation struct {
+	// Name is the name of the profile that was activated.
+	Name string
+
+	// Version is the version of the profile that was activated.
+	Version uint32
+
+	// Timestamp is the time at which the profile was activated.
+	Timestamp time.Time
+
+	// Scheduled indicates whether the profile was activated by its
+	// schedule rather than by the user.
+	Scheduled bool
+}
+
+// ProfileRef identifies a version of a profile. A zero version refers to the
+// latest version of the profile.
+type ProfileRef struct {
+	// Name is the name of the profile.
+	Name string
+
+	// Version is the version of the profile.
+	Version uint32
+}
+
+// ProfileChange describes a field that differs between two profile versions.
+// Values are expressed as their json encoding.
+type ProfileChange struct {
+	// Field is the name of the field that changed.
+	Field string
+
+	// From is the value of the field in the version that we compare from.
+	From string
+
+	// To is the value of the field in the version that we compare to.
+	To string
+}
+
+// ProfileDiff describes the differences between two profile versions.
+type ProfileDiff struct {
+	// From is the profile version that we compared from.
+	From ProfileRef
+
+	// To is the profile version that we compared to.
+	To ProfileRef
+
+	// Changes is the set of fields that differ between the versions,
+	// ordered by field name.
+	Changes []ProfileChange
+}
+
+// SaveProfile saves a new version of the named profile. If no parameters are
+// provided, the manager's current parameters are saved. If the profile is
+// currently active, the new version is activated.
+func (m *Manager) SaveProfile(ctx context.Context, name string,
+	req *clientrpc.LiquidityParameters,
+	schedule []ScheduleWindow) (uint32, error) {
+
+	if m.cfg.ProfileStore == nil {
+		return 0, ErrNoProfileStore
+	}
+
+	if name == "" {
+		return 0, ErrNoProfileName
+	}
+
+	for _, window := range schedule {
+		if err := window.validate(); err != nil {
+			return 0, fmt.Errorf("activation window: %v invalid: "+
+				"%w", window, err)
+		}
+	}
+
+	if req == nil {
+		var err error
+		req, err = ParametersToRpc(m.GetParameters())
+		if err != nil {
+			return 0, err
+		}
+	} else if err := m.validateProfileParams(ctx, req); err != nil {
+		return 0, err
+	}
+
+	version, err := m.cfg.ProfileStore.AddProfileVersion(
+		ctx, name, req, schedule, m.cfg.Clock.Now(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := m.refreshActiveProfile(ctx, name, version); err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// ActivateProfile sets the manager's parameters to a version of the named
+// profile. If the version is zero, the latest version is activated.
+func (m *Manager) ActivateProfile(ctx context.Context, name string,
+	version uint32) error {
+
+	if m.cfg.ProfileStore == nil {
+		return ErrNoProfileStore
+	}
+
+	return m.activateProfile(ctx, name, version, false)
+}
+
+// RollbackProfile rolls the named profile back to an earlier version by saving
+// a copy of that version as the profile's latest version, so that the rollback
+// itself is kept in the profile's history. If the version is zero, the profile
+// is rolled back to the version before its latest version. If the profile is
+// currently active, the rolled back version is activated.
+func (m *Manager) RollbackProfile(ctx context.Context, name string,
+	version uint32) (uint32, error) {
+
+	if m.cfg.ProfileStore == nil {
+		return 0, ErrNoProfileStore
+	}
+
+	latest, err := m.cfg.ProfileStore.GetProfileVersion(ctx, name, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	if version == 0 {
+		version = latest.Version - 1
+	}
+
+	if version == 0 || version >= latest.Version {
+		return 0, ErrNoEarlierVersion
+	}
+
+	target, err := m.cfg.ProfileStore.GetProfileVersion(ctx, name, version)
+	if err != nil {
+		return 0, err
+	}
+
+	// Our channels may have changed since the version was saved, so we
+	// check that its parameters are still valid before we restore them.
+	if err := m.validateProfileParams(ctx, target.Params); err != nil {
+		return 0, err
+	}
+
+	newVersion, err := m.cfg.ProfileStore.AddProfileVersion(
+		ctx, name, target.Params, target.Schedule, m.cfg.Clock.Now(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := m.refreshActiveProfile(ctx, name, newVersion); err != nil {
+		return 0, err
+	}
+
+	return newVersion, nil
+}
+
+// ListProfiles returns all of our liquidity profiles.
+func (m *Manager) ListProfiles(ctx context.Context) ([]*Profile, error) {
+	if m.cfg.ProfileStore == nil {
+		return nil, ErrNoProfileStore
+	}
+
+	return m.cfg.ProfileStore.ListProfiles(ctx)
+}
+
+// ListProfileActivations returns up to max of our most recent profile
+// activations, ordered from newest to oldest. If max is zero, a default
+// number of activations is returned.
+func (m *Manager) ListProfileActivations(ctx context.Context,
+	max uint32) ([]*ProfileActivation, error) {
+
+	if m.cfg.ProfileStore == nil {
+		return nil, ErrNoProfileStore
+	}
+
+	if max == 0 {
+		max = defaultMaxActivations
+	}
+
+	return m.cfg.ProfileStore.ListProfileActivations(ctx, max)
+}
+
+// DiffProfiles compares two profile versions. If the profile that we compare
+// to is not named, we compare two versions of the same profile. A zero version
+// for the profile that we compare to refers to its latest version. A zero
+// version for the profile that we compare from refers to the version before
+// the one we compare to if both refer to the same profile, and the latest
+// version otherwise.
+func (m *Manager) DiffProfiles(ctx context.Context, from,
+	to ProfileRef) (*ProfileDiff, error) {
+
+	if m.cfg.ProfileStore == nil {
+		return nil, ErrNoProfileStore
+	}
+
+	if to.Name == "" {
+		to.Name = from.Name
+	}
+
+	toVersion, err := m.cfg.ProfileStore.GetProfileVersion(
+		ctx, to.Name, to.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+	to.Version = toVersion.Version
+
+	if from.Version == 0 && from.Name == to.Name {
+		if to.Version == 1 {
+			return nil, ErrNoEarlierVersion
+		}
+
+		from.Version = to.Version - 1
+	}
+
+	fromVersion, err := m.cfg.ProfileStore.GetProfileVersion(
+		ctx, from.Name, from.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+	from.Version = fromVersion.Version
+
+	changes, err := diffProfileVersions(fromVersion, toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProfileDiff{
+		From:    from,
+		To:      to,
+		Changes: changes,
+	}, nil
+}
+
+// validateProfileParams checks that a set of rpc parameters may be set on the
+// manager, without setting them.
+func (m *Manager) validateProfileParams(ctx context.Context,
+	req *clientrpc.LiquidityParameters) error {
+
+	params, err := RpcToParameters(req)
+	if err != nil {
+		return err
+	}
+
+	return m.validateParameters(ctx, *params)
+}
+
+// activeProfile returns our most recent profile activation, or nil if no
+// profile has been activated.
+func (m *Manager) activeProfile(
+	ctx context.Context) (*ProfileActivation, error) {
+
+	activations, err := m.cfg.ProfileStore.ListProfileActivations(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(activations) == 0 {
+		return nil, nil
+	}
+
+	return activations[0], nil
+}
+
+// refreshActiveProfile activates a new version of the named profile if the
+// profile is currently active.
+func (m *Manager) refreshActiveProfile(ctx context.Context, name string,
+	version uint32) error {
+
+	active, err := m.activeProfile(ctx)
+	if err != nil {
+		return err
+	}
+
+	if active == nil || active.Name != name {
+		return nil
+	}
+
+	return m.activateProfile(ctx, name, version, active.Scheduled)
+}
+
+// activateProfile sets our parameters to a version of the named profile and
+// records the activation.
+func (m *Manager) activateProfile(ctx context.Context, name string,
+	version uint32, scheduled bool) error {
+
+	profileVersion, err := m.cfg.ProfileStore.GetProfileVersion(
+		ctx, name, version,
+	)
+	if err != nil {
+		return err
+	}
+
+	req, ok := proto.Clone(
+		profileVersion.Params,
+	).(*clientrpc.LiquidityParameters)
+	if !ok {
+		return fmt.Errorf("unexpected profile params type: %T",
+			profileVersion.Params)
+	}
+
+	// The last refresh of our autoloop budget, and of the budgets of our
+	// rules, is runtime state rather than configuration, so we carry it
+	// over when we switch profiles. This makes sure that switching
+	// profiles does not reset our budgets.
+	m.paramsLock.Lock()
+	current, err := ParametersToRpc(m.params)
+	m.paramsLock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	carryRuleBudgets(req, current)
+
+	if err := m.SetParameters(ctx, req); err != nil {
+		return err
+	}
+
+	log.Infof("Activated liquidity profile: %v version: %v, "+
+		"scheduled: %v", name, profileVersion.Version, scheduled)
+
+	return m.cfg.ProfileStore.AddProfileActivation(
+		ctx, &ProfileActivation{
+			Name:      name,
+			Version:   profileVersion.Version,
+			Timestamp: m.cfg.Clock.Now(),
+			Scheduled: scheduled,
+		},
+	)
+}
+
+// carryRuleBudgets copies the last refresh of our autoloop budget from the
+// current parameters provided to the parameters that we switch to, along with
+// the last refresh of the budgets of any rules that are set in both.
+func carryRuleBudgets(req, current *clientrpc.LiquidityParameters) {
+	req.AutoloopBudgetLastRefresh = current.AutoloopBudgetLastRefresh
+
+	lastRefresh := make(map[string]uint64, len(current.Rules))
+	for _, rule := range current.Rules {
+		if rule.BudgetRefreshPeriodSec != 0 {
+			lastRefresh[ruleKey(rule)] = rule.BudgetLastRefresh
+		}
+	}
+
+	for _, rule := range req.Rules {
+		if rule.BudgetRefreshPeriodSec == 0 {
+			continue
+		}
+
+		if refresh, ok := lastRefresh[ruleKey(rule)]; ok {
+			rule.BudgetLastRefresh = refresh
+		}
+	}
+}
+
+// ruleKey returns a string that identifies the target of a rule.
+func ruleKey(rule *clientrpc.LiquidityRule) string {
+	return fmt.Sprintf("%v/%v/%x/%v", rule.AssetId, rule.ChannelId,
+		rule.Pubkey, rule.Node)
+}
+
+// activateScheduledProfile activates the first profile, ordered by name, that
+// has an activation window containing the current time. A profile is only
+// activated when its window is entered, so that a profile that the user
+// activates manually is not overridden until the next window opens. Whether
+// a window was entered is derived from our activation history, so that this
+// holds across restarts.
+func (m *Manager) activateScheduledProfile(ctx context.Context) error {
+	if m.cfg.ProfileStore == nil {
+		return nil
+	}
+
+	profiles, err := m.cfg.ProfileStore.ListProfiles(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := m.cfg.Clock.Now()
+
+	var (
+		scheduled *Profile
+		entered   time.Time
+	)
+	for _, profile := range profiles {
+		latest := profile.latest()
+		if latest == nil {
+			continue
+		}
+
+		var ok bool
+		entered, ok = latest.scheduleEntered(now)
+		if ok {
+			scheduled = profile
+			break
+		}
+	}
+
+	if scheduled == nil {
+		return nil
+	}
+
+	// If we already activated the profile by its schedule since we
+	// entered the window, the window has been handled and any manual
+	// activation since then takes precedence.
+	activations, err := m.cfg.ProfileStore.ListProfileActivations(
+		ctx, math.MaxUint32,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, activation := range activations {
+		if activation.Timestamp.Before(entered) {
+			break
+		}
+
+		if activation.Scheduled && activation.Name == scheduled.Name {
+			return nil
+		}
+	}
+
+	// We record the activation even if the profile is already active, so
+	// that our history shows that the window was entered.
+	return m.activateProfile(
+		ctx, scheduled.Name, scheduled.latest().Version, true,
+	)
+}
+
+// ignoredProfileFields is the set of rpc fields that we do not compare when we
+// diff profiles, because they contain runtime state rather than
+// configuration.
+var ignoredProfileFields = map[string]bool{
+	"autoloop_budget_last_refresh": true,
+}
+
+// diffProfileVersions returns the set of fields that differ between two
+// profile versions, ordered by field name.
+func diffProfileVersions(from, to *ProfileVersion) ([]ProfileChange, error) {
+	fromFields, err := profileFields(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toFields, err := profileFields(to)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(toFields))
+	for name := range toFields {
+		names = append(names, name)
+	}
+	for name := range fromFields {
+		if _, ok := toFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var changes []ProfileChange
+	for _, name := range names {
+		if fromFields[name] == toFields[name] {
+			continue
+		}
+
+		changes = append(changes, ProfileChange{
+			Field: name,
+			From:  fromFields[name],
+			To:    toFields[name],
+		})
+	}
+
+	return changes, nil
+}
+
+// profileFields returns the json encoding of each of a profile version's
+// fields, keyed by field name.
+func profileFields(version *ProfileVersion) (map[string]string, error) {
+	params, ok := proto.Clone(
+		version.Params,
+	).(*clientrpc.LiquidityParameters)
+	if !ok {
+		return nil, fmt.Errorf("unexpected profile params type: %T",
+			version.Params)
+	}
+
+	// The budget state of our rules is also runtime state, so we clear it
+	// before we compare rules.
+	for _, rule := range params.Rules {
+		rule.BudgetLastRefresh = 0
+		rule.BudgetRemainingSat = 0
+	}
+
+	// Our rules are produced from maps, so we sort them to make sure that
+	// their order does not show up as a change.
+	sortRules(params.Rules)
+
+	opts := protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}
+	paramsJSON, err := opts.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawFields map[string]json.RawMessage
+	if err := json.Unmarshal(paramsJSON, &rawFields); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]string, len(rawFields)+1)
+	for name, value := range rawFields {
+		if ignoredProfileFields[name] {
+			continue
+		}
+
+		// The json produced by protojson is not stable, so we compact
+		// each value before we compare it.
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, value); err != nil {
+			return nil, err
+		}
+
+		fields[name] = compacted.String()
+	}
+
+	schedule := make([]string, len(version.Schedule))
+	for i, window := range version.Schedule {
+		schedule[i] = window.String()
+	}
+	fields["activation_schedule"] = strings.Join(schedule, ", ")
+
+	return fields, nil
+}
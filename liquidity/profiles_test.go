@@ -0,0 +1,276 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	clientrpc "github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// newProfileTestManager creates a manager that stores its liquidity profiles
+// in a test database.
+func newProfileTestManager(t *testing.T) (*Manager, *clock.TestClock) {
+	cfg, _ := newTestConfig()
+
+	testClock := clock.NewTestClock(testTime)
+	cfg.Clock = testClock
+	cfg.PutLiquidityParams = func(context.Context, []byte) error {
+		return nil
+	}
+
+	testDb := loopdb.NewTestDB(t)
+	t.Cleanup(func() {
+		testDb.Close()
+	})
+
+	cfg.ProfileStore = NewSQLStore(loopdb.NewTypedStore[Querier](testDb))
+
+	return NewManager(cfg), testClock
+}
+
+// maxInFlightParams returns our default parameters in their rpc form, with
+// the maximum number of in flight swaps provided.
+func maxInFlightParams(t *testing.T,
+	maxInFlight uint64) *clientrpc.LiquidityParameters {
+
+	params, err := ParametersToRpc(defaultParameters)
+	require.NoError(t, err)
+
+	params.AutoMaxInFlight = maxInFlight
+
+	return params
+}
+
+// TestProfiles tests saving, activating, diffing and rolling back liquidity
+// profiles.
+func TestProfiles(t *testing.T) {
+	ctx := context.Background()
+	manager, _ := newProfileTestManager(t)
+
+	// Our budget refresh time is carried over when we switch profiles.
+	manager.params.AutoloopBudgetLastRefresh = testBudgetStart
+
+	// Profiles can't be saved without a name.
+	_, err := manager.SaveProfile(ctx, "", nil, nil)
+	require.ErrorIs(t, err, ErrNoProfileName)
+
+	// When we don't provide parameters, our current parameters are saved.
+	version, err := manager.SaveProfile(ctx, "weekday", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), version)
+
+	// Saving a profile that is not active does not change our parameters.
+	version, err = manager.SaveProfile(
+		ctx, "weekday", maxInFlightParams(t, 3), nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), version)
+	require.Equal(t, defaultMaxInFlight, manager.params.MaxAutoInFlight)
+
+	// Invalid parameters are not saved.
+	_, err = manager.SaveProfile(
+		ctx, "weekday", maxInFlightParams(t, 0), nil,
+	)
+	require.Error(t, err)
+
+	// The first version of a profile has no version before it to compare
+	// to.
+	_, err = manager.DiffProfiles(
+		ctx, ProfileRef{Name: "weekday"}, ProfileRef{Version: 1},
+	)
+	require.ErrorIs(t, err, ErrNoEarlierVersion)
+
+	// By default, we compare the latest version of a profile to the
+	// version before it.
+	diff, err := manager.DiffProfiles(
+		ctx, ProfileRef{Name: "weekday"}, ProfileRef{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, &ProfileDiff{
+		From: ProfileRef{Name: "weekday", Version: 1},
+		To:   ProfileRef{Name: "weekday", Version: 2},
+		Changes: []ProfileChange{
+			{
+				Field: "auto_max_in_flight",
+				From:  `"1"`,
+				To:    `"3"`,
+			},
+		},
+	}, diff)
+
+	// Activating a profile sets our parameters, but keeps our budget
+	// refresh time.
+	require.NoError(t, manager.ActivateProfile(ctx, "weekday", 0))
+	require.Equal(t, 3, manager.params.MaxAutoInFlight)
+	require.True(t, testBudgetStart.Equal(
+		manager.params.AutoloopBudgetLastRefresh,
+	))
+
+	err = manager.ActivateProfile(ctx, "weekend", 0)
+	require.ErrorIs(t, err, ErrProfileNotFound)
+
+	// Rolling back the active profile adds a copy of its previous version
+	// and activates it.
+	version, err = manager.RollbackProfile(ctx, "weekday", 0)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), version)
+	require.Equal(t, defaultMaxInFlight, manager.params.MaxAutoInFlight)
+
+	_, err = manager.RollbackProfile(ctx, "weekday", 3)
+	require.ErrorIs(t, err, ErrNoEarlierVersion)
+
+	activations, err := manager.ListProfileActivations(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, activations, 2)
+	require.Equal(t, "weekday", activations[0].Name)
+	require.Equal(t, uint32(3), activations[0].Version)
+	require.Equal(t, uint32(2), activations[1].Version)
+
+	// Saving a new version of the active profile activates it.
+	_, err = manager.SaveProfile(
+		ctx, "weekday", maxInFlightParams(t, 4), nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 4, manager.params.MaxAutoInFlight)
+
+	// We can also compare versions of different profiles.
+	_, err = manager.SaveProfile(
+		ctx, "weekend", maxInFlightParams(t, 4), nil,
+	)
+	require.NoError(t, err)
+
+	diff, err = manager.DiffProfiles(
+		ctx, ProfileRef{Name: "weekday"}, ProfileRef{Name: "weekend"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint32(4), diff.From.Version)
+	require.Equal(t, uint32(1), diff.To.Version)
+	require.Empty(t, diff.Changes)
+}
+
+// TestScheduledProfiles tests automatic activation of profiles that have an
+// activation schedule.
+func TestScheduledProfiles(t *testing.T) {
+	ctx := context.Background()
+	manager, testClock := newProfileTestManager(t)
+
+	_, err := manager.SaveProfile(
+		ctx, "daytime", maxInFlightParams(t, 2), nil,
+	)
+	require.NoError(t, err)
+	require.NoError(t, manager.ActivateProfile(ctx, "daytime", 0))
+
+	// Our overnight profile is activated in the first hour of every day,
+	// which our test time falls in.
+	schedule := []ScheduleWindow{
+		{
+			StartHour: testTime.Hour(),
+			EndHour:   testTime.Hour() + 1,
+		},
+	}
+
+	_, err = manager.SaveProfile(
+		ctx, "overnight", maxInFlightParams(t, 5), schedule,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, manager.params.MaxAutoInFlight)
+
+	require.NoError(t, manager.activateScheduledProfile(ctx))
+	require.Equal(t, 5, manager.params.MaxAutoInFlight)
+
+	activations, err := manager.ListProfileActivations(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "overnight", activations[0].Name)
+	require.True(t, activations[0].Scheduled)
+
+	// If we manually activate another profile within the window, our
+	// scheduled profile is not activated again.
+	require.NoError(t, manager.ActivateProfile(ctx, "daytime", 0))
+	require.NoError(t, manager.activateScheduledProfile(ctx))
+	require.Equal(t, 2, manager.params.MaxAutoInFlight)
+
+	// This holds across restarts, since we know from our activation
+	// history that the window was already entered.
+	restarted := NewManager(manager.cfg)
+	require.NoError(t, restarted.activateScheduledProfile(ctx))
+
+	activations, err = manager.ListProfileActivations(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "daytime", activations[0].Name)
+	require.False(t, activations[0].Scheduled)
+
+	// Once we leave the window, nothing changes.
+	testClock.SetTime(testTime.Add(time.Hour * 2))
+	require.NoError(t, manager.activateScheduledProfile(ctx))
+	require.Equal(t, 2, manager.params.MaxAutoInFlight)
+
+	// When we enter the window again, the profile is activated.
+	testClock.SetTime(testTime.Add(time.Hour * 24))
+	require.NoError(t, manager.activateScheduledProfile(ctx))
+	require.Equal(t, 5, manager.params.MaxAutoInFlight)
+}
+
+// TestProfileRules tests that the order of rules does not show up when we
+// diff profiles, and that the budget state of rules is carried over when we
+// switch profiles.
+func TestProfileRules(t *testing.T) {
+	chanRule := func(id,
+		lastRefresh uint64) *clientrpc.LiquidityRule {
+
+		return &clientrpc.LiquidityRule{
+			ChannelId:              id,
+			IncomingThreshold:      10,
+			BudgetSat:              1000,
+			BudgetRefreshPeriodSec: 3600,
+			BudgetLastRefresh:      lastRefresh,
+		}
+	}
+
+	peerRule := &clientrpc.LiquidityRule{
+		Pubkey:            peer1[:],
+		IncomingThreshold: 10,
+	}
+
+	from := &ProfileVersion{
+		Version: 1,
+		Params: &clientrpc.LiquidityParameters{
+			Rules: []*clientrpc.LiquidityRule{
+				chanRule(1, 100), chanRule(2, 200), peerRule,
+			},
+		},
+	}
+	to := &ProfileVersion{
+		Version: 2,
+		Params: &clientrpc.LiquidityParameters{
+			Rules: []*clientrpc.LiquidityRule{
+				peerRule, chanRule(2, 300), chanRule(1, 400),
+			},
+		},
+	}
+
+	changes, err := diffProfileVersions(from, to)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	// The last refresh of rules that are set in both sets of parameters
+	// is carried over, while rules that are only set in the parameters
+	// that we switch to keep their own.
+	current := &clientrpc.LiquidityParameters{
+		AutoloopBudgetLastRefresh: 50,
+		Rules: []*clientrpc.LiquidityRule{
+			chanRule(1, 500), peerRule,
+		},
+	}
+
+	req := proto.Clone(to.Params).(*clientrpc.LiquidityParameters)
+	carryRuleBudgets(req, current)
+
+	require.Equal(t, uint64(50), req.AutoloopBudgetLastRefresh)
+	require.Equal(t, uint64(300), req.Rules[1].BudgetLastRefresh)
+	require.Equal(t, uint64(500), req.Rules[2].BudgetLastRefresh)
+}
@@ -0,0 +1,559 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// ErrNoQueueStore is returned when the approval queue is used, but no
+	// store is configured.
+	ErrNoQueueStore = errors.New("autoloop approval queue not enabled")
+
+	// ErrQueuedSwapNotFound is returned when a queued swap does not exist.
+	ErrQueuedSwapNotFound = errors.New("queued swap not found")
+
+	// ErrQueuedSwapResolved is returned when we try to approve or reject
+	// a queued swap that is no longer pending.
+	ErrQueuedSwapResolved = errors.New("queued swap is no longer pending")
+
+	// ErrQueuedSwapExpired is returned when we try to approve or reject a
+	// queued swap that has passed its expiry.
+	ErrQueuedSwapExpired = errors.New("queued swap has expired")
+
+	// ErrQueuedSwapIneligible is returned when a queued swap is approved,
+	// but may not be dispatched under our current parameters and budget.
+	ErrQueuedSwapIneligible = errors.New("queued swap may not be " +
+		"dispatched")
+)
+
+// QueueState is an enum which represents the state of a swap in our approval
+// queue.
+type QueueState uint8
+
+const (
+	// QueueStatePending indicates that a queued swap is waiting to be
+	// approved or rejected.
+	QueueStatePending QueueState = iota
+
+	// QueueStateApproved indicates that a queued swap was approved and
+	// dispatched.
+	QueueStateApproved
+
+	// QueueStateRejected indicates that a queued swap was rejected.
+	QueueStateRejected
+
+	// QueueStateExpired indicates that a queued swap was not approved
+	// before its expiry.
+	QueueStateExpired
+)
+
+// String returns a string representation of a queue state.
+func (q QueueState) String() string {
+	switch q {
+	case QueueStatePending:
+		return "pending"
+
+	case QueueStateApproved:
+		return "approved"
+
+	case QueueStateRejected:
+		return "rejected"
+
+	case QueueStateExpired:
+		return "expired"
+
+	default:
+		return "unknown"
+	}
+}
+
+// QueueStore is the interface required to persist the swaps that autoloop
+// has queued for approval.
+type QueueStore interface {
+	// AddQueuedSwap persists a queued swap and returns the id that it was
+	// stored under.
+	AddQueuedSwap(ctx context.Context, queued *QueuedSwap) (uint64, error)
+
+	// GetQueuedSwap returns the queued swap with the id provided.
+	GetQueuedSwap(ctx context.Context, id uint64) (*QueuedSwap, error)
+
+	// ListQueuedSwaps returns our queued swaps, ordered by the time that
+	// they were queued. If includeResolved is false, only pending swaps
+	// are returned.
+	ListQueuedSwaps(ctx context.Context,
+		includeResolved bool) ([]*QueuedSwap, error)
+
+	// UpdateQueuedSwap sets the state of a queued swap, along with the
+	// hash of the swap that was dispatched for it, if any.
+	UpdateQueuedSwap(ctx context.Context, id uint64, state QueueState,
+		updated time.Time, hash *lntypes.Hash) error
+
+	// ExpireQueuedSwaps marks all pending swaps that have reached their
+	// expiry as expired.
+	ExpireQueuedSwaps(ctx context.Context, now time.Time) error
+}
+
+// QueuedSwap is a swap that was suggested by autoloop and is waiting for
+// approval before it is dispatched.
+type QueuedSwap struct {
+	// ID is the unique identifier that the swap is stored under. This
+	// value is set by the queue store.
+	ID uint64
+
+	// Type is the type of swap. Loop ins are funded by static address
+	// deposits if our parameters require it at the time of approval.
+	Type swap.Type
+
+	// Amount is the amount that autoloop suggested swapping.
+	Amount btcutil.Amount
+
+	// Peer is the peer that the swap was suggested for.
+	Peer route.Vertex
+
+	// Channels is the set of outgoing channels for a loop out.
+	Channels []lnwire.ShortChannelID
+
+	// Fees is the worst-case fee amount of the swap when it was
+	// suggested.
+	Fees btcutil.Amount
+
+	// DecisionID is the id of the autoloop decision that suggested the
+	// swap, or zero if the decision was not recorded.
+	DecisionID uint64
+
+	// SwapIndex is the index of the swap in the decision's suggestions.
+	SwapIndex int
+
+	// State is the current state of the queued swap.
+	State QueueState
+
+	// Created is the time at which the swap was queued.
+	Created time.Time
+
+	// Expiry is the time after which the swap may no longer be approved.
+	Expiry time.Time
+
+	// Updated is the time at which the swap's state last changed.
+	Updated time.Time
+
+	// SwapHash is the hash of the swap that was dispatched when the
+	// queued swap was approved, if any.
+	SwapHash *lntypes.Hash
+}
+
+// target returns a key that identifies the swap type and target that a swap
+// was queued for.
+func (q *QueuedSwap) target() string {
+	return fmt.Sprintf("%v:%v:%v", q.Type, q.Peer, q.Channels)
+}
+
+// queueSuggestions adds the swaps that autoloop suggested to our approval
+// queue, skipping any swaps that already have a pending swap of the same type
+// queued for their target.
+func (m *Manager) queueSuggestions(ctx context.Context,
+	suggestions *Suggestions, decisionID uint64) error {
+
+	if m.cfg.QueueStore == nil {
+		return ErrNoQueueStore
+	}
+
+	now := m.cfg.Clock.Now()
+	err := m.cfg.QueueStore.ExpireQueuedSwaps(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	pending, err := m.cfg.QueueStore.ListQueuedSwaps(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	queuedTargets := make(map[string]bool, len(pending))
+	for _, queued := range pending {
+		queuedTargets[queued.target()] = true
+	}
+
+	// We need to know the peers of our loop out channels to record the
+	// target that a loop out was suggested for.
+	channelPeers := make(map[uint64]route.Vertex)
+	if len(suggestions.OutSwaps) != 0 {
+		channels, err := m.cfg.Lnd.Client.ListChannels(
+			ctx, false, false,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, channel := range channels {
+			channelPeers[channel.ChannelID] = channel.PubKeyBytes
+		}
+	}
+
+	// Our suggested swaps are indexed with loop outs first, followed by
+	// loop ins and then static address loop ins, matching our decision
+	// journal.
+	var swaps []swapSuggestion
+	for i := range suggestions.OutSwaps {
+		swaps = append(swaps, &loopOutSwapSuggestion{
+			OutRequest: suggestions.OutSwaps[i],
+		})
+	}
+
+	for i := range suggestions.InSwaps {
+		swaps = append(swaps, &loopInSwapSuggestion{
+			LoopInRequest: suggestions.InSwaps[i],
+		})
+	}
+
+	for i := range suggestions.StaticInSwaps {
+		swaps = append(swaps, &staticLoopInSwapSuggestion{
+			StaticLoopInRequest: suggestions.StaticInSwaps[i],
+		})
+	}
+
+	for i, suggestion := range swaps {
+		queued := &QueuedSwap{
+			Type:       swap.TypeIn,
+			Amount:     suggestion.amount(),
+			Channels:   suggestion.channels(),
+			Fees:       suggestion.fees(),
+			DecisionID: decisionID,
+			SwapIndex:  i,
+			State:      QueueStatePending,
+			Created:    now,
+			Expiry:     now.Add(m.params.ApprovalExpiry),
+			Updated:    now,
+		}
+
+		if _, ok := suggestion.(*loopOutSwapSuggestion); ok {
+			queued.Type = swap.TypeOut
+		}
+
+		peers := suggestion.peers(channelPeers)
+		if len(peers) == 1 {
+			queued.Peer = peers[0]
+		}
+
+		sort.Slice(queued.Channels, func(i, j int) bool {
+			return queued.Channels[i].ToUint64() <
+				queued.Channels[j].ToUint64()
+		})
+
+		if queuedTargets[queued.target()] {
+			log.Debugf("%v swap for peer: %v, channels: %v "+
+				"already queued", queued.Type, queued.Peer,
+				queued.Channels)
+
+			continue
+		}
+
+		id, err := m.cfg.QueueStore.AddQueuedSwap(ctx, queued)
+		if err != nil {
+			return err
+		}
+		queuedTargets[queued.target()] = true
+
+		log.Infof("%v swap of %v queued for approval: id: %v, "+
+			"expiry: %v", queued.Type, queued.Amount, id,
+			queued.Expiry)
+	}
+
+	return nil
+}
+
+// ListQueuedSwaps returns the swaps in our approval queue. If includeResolved
+// is false, only swaps that are still pending are returned.
+func (m *Manager) ListQueuedSwaps(ctx context.Context,
+	includeResolved bool) ([]*QueuedSwap, error) {
+
+	if m.cfg.QueueStore == nil {
+		return nil, ErrNoQueueStore
+	}
+
+	// Expire any stale swaps first, so that we don't list swaps as
+	// pending when they may no longer be approved.
+	err := m.cfg.QueueStore.ExpireQueuedSwaps(ctx, m.cfg.Clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return m.cfg.QueueStore.ListQueuedSwaps(ctx, includeResolved)
+}
+
+// RejectQueuedSwap rejects a pending queued swap so that it is not
+// dispatched.
+func (m *Manager) RejectQueuedSwap(ctx context.Context, id uint64) error {
+	m.queueLock.Lock()
+	defer m.queueLock.Unlock()
+
+	if _, err := m.pendingQueuedSwap(ctx, id); err != nil {
+		return err
+	}
+
+	return m.cfg.QueueStore.UpdateQueuedSwap(
+		ctx, id, QueueStateRejected, m.cfg.Clock.Now(), nil,
+	)
+}
+
+// ApproveQueuedSwap approves a pending queued swap. The swap is re-quoted and
+// checked against our current parameters and autoloop budget before it is
+// dispatched. The hash of the swap that was dispatched and its worst-case
+// fees are returned.
+func (m *Manager) ApproveQueuedSwap(ctx context.Context, id uint64) (
+	lntypes.Hash, btcutil.Amount, error) {
+
+	m.queueLock.Lock()
+	defer m.queueLock.Unlock()
+
+	queued, err := m.pendingQueuedSwap(ctx, id)
+	if err != nil {
+		return lntypes.Hash{}, 0, err
+	}
+
+	// Refresh our budget so that we check the swap against our budget for
+	// the current period.
+	m.paramsLock.Lock()
+	m.refreshAutoloopBudget(ctx)
+	suggestion, err := m.requoteQueuedSwap(ctx, queued)
+	destAddr := m.params.DestAddr
+	destDescriptor := m.params.DestDescriptor
+	m.paramsLock.Unlock()
+
+	var reasonErr *reasonError
+	if errors.As(err, &reasonErr) {
+		return lntypes.Hash{}, 0, fmt.Errorf("%w: %v",
+			ErrQueuedSwapIneligible, reasonErr.reason)
+	}
+	if err != nil {
+		return lntypes.Hash{}, 0, err
+	}
+
+	var hash lntypes.Hash
+	switch s := suggestion.(type) {
+	case *loopOutSwapSuggestion:
+		request := s.OutRequest
+		if destAddr != nil {
+			request.DestAddr = destAddr
+			request.IsExternalAddr = true
+		}
+
+		err := m.setDescriptorDest(ctx, &request, destDescriptor)
+		if err != nil {
+			return lntypes.Hash{}, 0, err
+		}
+
+		info, err := m.cfg.LoopOut(ctx, &request)
+		if err != nil {
+			return lntypes.Hash{}, 0, err
+		}
+		hash = info.SwapHash
+
+	case *loopInSwapSuggestion:
+		info, err := m.cfg.LoopIn(ctx, &s.LoopInRequest)
+		if err != nil {
+			return lntypes.Hash{}, 0, err
+		}
+		hash = info.SwapHash
+
+	case *staticLoopInSwapSuggestion:
+		hash, err = m.cfg.StaticLoopIn(
+			ctx, &s.StaticAddressLoopInRequest,
+		)
+		if err != nil {
+			return lntypes.Hash{}, 0, err
+		}
+
+	default:
+		return lntypes.Hash{}, 0, fmt.Errorf("unexpected swap type: "+
+			"%T", suggestion)
+	}
+
+	log.Infof("queued swap %v approved and dispatched: hash: %v", id,
+		hash)
+
+	err = m.cfg.QueueStore.UpdateQueuedSwap(
+		ctx, id, QueueStateApproved, m.cfg.Clock.Now(), &hash,
+	)
+	if err != nil {
+		return lntypes.Hash{}, 0, err
+	}
+
+	if queued.DecisionID != 0 && m.cfg.DecisionStore != nil {
+		m.recordDecisionSwap(
+			ctx, queued.DecisionID, queued.SwapIndex, hash,
+		)
+	}
+
+	return hash, suggestion.fees(), nil
+}
+
+// pendingQueuedSwap fetches a queued swap and checks that it may still be
+// approved or rejected. If the swap has passed its expiry, it is marked as
+// expired.
+func (m *Manager) pendingQueuedSwap(ctx context.Context,
+	id uint64) (*QueuedSwap, error) {
+
+	if m.cfg.QueueStore == nil {
+		return nil, ErrNoQueueStore
+	}
+
+	queued, err := m.cfg.QueueStore.GetQueuedSwap(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if queued.State != QueueStatePending {
+		return nil, fmt.Errorf("%w: %v", ErrQueuedSwapResolved,
+			queued.State)
+	}
+
+	now := m.cfg.Clock.Now()
+	if !now.Before(queued.Expiry) {
+		err := m.cfg.QueueStore.UpdateQueuedSwap(
+			ctx, id, QueueStateExpired, now, nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, ErrQueuedSwapExpired
+	}
+
+	return queued, nil
+}
+
+// requoteQueuedSwap builds a new swap for a queued swap, checking it against
+// our current restrictions, traffic and budget. A reason error is returned if
+// the swap may not be dispatched.
+//
+// NOTE: the params lock must be held when calling this function.
+func (m *Manager) requoteQueuedSwap(ctx context.Context,
+	queued *QueuedSwap) (swapSuggestion, error) {
+
+	restrictions, err := m.getSwapRestrictions(ctx, queued.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if queued.Amount < restrictions.Minimum ||
+		queued.Amount > restrictions.Maximum {
+
+		return nil, fmt.Errorf("%w: amount %v outside of swap "+
+			"restrictions %v", ErrQueuedSwapIneligible,
+			queued.Amount, restrictions)
+	}
+
+	loopOut, err := m.cfg.ListLoopOut(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	loopIn, err := m.listLoopIns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := m.checkExistingAutoLoops(ctx, loopOut, loopIn)
+	if err := m.checkSummaryBudget(summary); err != nil {
+		return nil, newReasonError(ReasonBudgetElapsed)
+	}
+
+	if _, err := m.checkSummaryInflight(summary); err != nil {
+		return nil, newReasonError(ReasonInFlight)
+	}
+
+	var builder swapBuilder
+	switch queued.Type {
+	case swap.TypeOut:
+		builder = newLoopOutBuilder(m.cfg)
+
+	case swap.TypeIn:
+		builder = newLoopInBuilder(m.cfg)
+
+		if m.params.StaticLoopIn {
+			deposits, err := m.staticDeposits(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			builder = newStaticLoopInBuilder(
+				m.cfg, deposits, restrictions,
+			)
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported swap type: %v",
+			queued.Type)
+	}
+
+	if err := builder.maySwap(ctx, m.params); err != nil {
+		return nil, err
+	}
+
+	traffic := m.currentSwapTraffic(loopOut, loopIn)
+	err = builder.inUse(traffic, queued.Peer, queued.Channels)
+	if err != nil {
+		return nil, err
+	}
+
+	// Approved swaps are labelled as autoloop swaps so that they are
+	// accounted for in our autoloop budget.
+	params := m.params
+	params.Autoloop = true
+	params.EasyAutoloop = false
+
+	suggestion, err := builder.buildSwap(
+		ctx, queued.Peer, queued.Channels, queued.Amount, params,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule := m.queuedSwapRule(queued); rule != nil {
+		balance := &balances{
+			pubkey:   queued.Peer,
+			channels: queued.Channels,
+		}
+
+		err := checkTargetBudget(summary, rule, balance, suggestion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	available := m.params.AutoFeeBudget - summary.totalFees()
+	if suggestion.fees() > available {
+		log.Infof("Queued swap %v fee: %v exceeds remaining budget: "+
+			"%v", queued.ID, suggestion.fees(), available)
+
+		return nil, newReasonError(ReasonBudgetInsufficient)
+	}
+
+	return suggestion, nil
+}
+
+// queuedSwapRule returns the rule that a queued swap was suggested for, if it
+// is still set.
+//
+// NOTE: the params lock must be held when calling this function.
+func (m *Manager) queuedSwapRule(queued *QueuedSwap) *SwapRule {
+	if rule, ok := m.params.PeerRules[queued.Peer]; ok {
+		return rule
+	}
+
+	if len(queued.Channels) == 1 {
+		return m.params.ChannelRules[queued.Channels[0]]
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package liquidity
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// A compile-time check that SQLStore implements QueueStore.
+var _ QueueStore = (*SQLStore)(nil)
+
+// AddQueuedSwap persists a queued swap and returns the id that it was stored
+// under.
+func (s *SQLStore) AddQueuedSwap(ctx context.Context,
+	queued *QueuedSwap) (uint64, error) {
+
+	var peer []byte
+	if queued.Peer != (route.Vertex{}) {
+		peer = queued.Peer[:]
+	}
+
+	args := sqlc.InsertAutoloopQueueSwapParams{
+		CreatedAt:  queued.Created.UTC(),
+		Expiry:     queued.Expiry.UTC(),
+		SwapType:   int32(queued.Type),
+		Amount:     int64(queued.Amount),
+		Peer:       peer,
+		Fees:       int64(queued.Fees),
+		DecisionID: int64(queued.DecisionID),
+		SwapIndex:  int32(queued.SwapIndex),
+		State:      int32(queued.State),
+		UpdatedAt:  queued.Updated.UTC(),
+	}
+
+	var id int32
+	err := s.baseDb.ExecTx(ctx, loopdb.NewSqlWriteOpts(),
+		func(q Querier) error {
+			var err error
+			id, err = q.InsertAutoloopQueueSwap(ctx, args)
+			if err != nil {
+				return err
+			}
+
+			for _, channel := range queued.Channels {
+				args := sqlc.InsertAutoloopQueueChannelParams{
+					QueueID:   id,
+					ChannelID: int64(channel.ToUint64()),
+				}
+
+				err := q.InsertAutoloopQueueChannel(ctx, args)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(id), nil
+}
+
+// GetQueuedSwap returns the queued swap with the id provided.
+func (s *SQLStore) GetQueuedSwap(ctx context.Context,
+	id uint64) (*QueuedSwap, error) {
+
+	if id > math.MaxInt32 {
+		return nil, ErrQueuedSwapNotFound
+	}
+
+	var queued *QueuedSwap
+	err := s.baseDb.ExecTx(ctx, loopdb.NewSqlReadOpts(),
+		func(q Querier) error {
+			row, err := q.GetAutoloopQueueSwap(ctx, int32(id))
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrQueuedSwapNotFound
+			}
+			if err != nil {
+				return err
+			}
+
+			queued, err = sqlQueuedSwap(ctx, q, row)
+
+			return err
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return queued, nil
+}
+
+// ListQueuedSwaps returns our queued swaps, ordered by the time that they
+// were queued. If includeResolved is false, only pending swaps are returned.
+func (s *SQLStore) ListQueuedSwaps(ctx context.Context,
+	includeResolved bool) ([]*QueuedSwap, error) {
+
+	var queuedSwaps []*QueuedSwap
+	err := s.baseDb.ExecTx(ctx, loopdb.NewSqlReadOpts(),
+		func(q Querier) error {
+			var (
+				rows []sqlc.AutoloopQueue
+				err  error
+			)
+			if includeResolved {
+				rows, err = q.ListAutoloopQueueSwaps(ctx)
+			} else {
+				rows, err = q.ListAutoloopQueueSwapsByState(
+					ctx, int32(QueueStatePending),
+				)
+			}
+			if err != nil {
+				return err
+			}
+
+			for _, row := range rows {
+				queued, err := sqlQueuedSwap(ctx, q, row)
+				if err != nil {
+					return err
+				}
+
+				queuedSwaps = append(queuedSwaps, queued)
+			}
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return queuedSwaps, nil
+}
+
+// UpdateQueuedSwap sets the state of a queued swap, along with the hash of
+// the swap that was dispatched for it, if any.
+func (s *SQLStore) UpdateQueuedSwap(ctx context.Context, id uint64,
+	state QueueState, updated time.Time, hash *lntypes.Hash) error {
+
+	if id > math.MaxInt32 {
+		return ErrQueuedSwapNotFound
+	}
+
+	args := sqlc.UpdateAutoloopQueueSwapParams{
+		ID:        int32(id),
+		State:     int32(state),
+		UpdatedAt: updated.UTC(),
+	}
+	if hash != nil {
+		args.SwapHash = hash[:]
+	}
+
+	return s.baseDb.UpdateAutoloopQueueSwap(ctx, args)
+}
+
+// ExpireQueuedSwaps marks all pending swaps that have reached their expiry as
+// expired.
+func (s *SQLStore) ExpireQueuedSwaps(ctx context.Context,
+	now time.Time) error {
+
+	return s.baseDb.ExpireAutoloopQueueSwaps(
+		ctx, sqlc.ExpireAutoloopQueueSwapsParams{
+			ExpiredState: int32(QueueStateExpired),
+			Now:          now.UTC(),
+			PendingState: int32(QueueStatePending),
+		},
+	)
+}
+
+// sqlQueuedSwap converts a queued swap from the database, fetching its
+// outgoing channels.
+func sqlQueuedSwap(ctx context.Context, q Querier,
+	row sqlc.AutoloopQueue) (*QueuedSwap, error) {
+
+	channels, err := q.GetAutoloopQueueChannels(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	queued := &QueuedSwap{
+		ID:         uint64(row.ID),
+		Type:       swap.Type(row.SwapType),
+		Amount:     btcutil.Amount(row.Amount),
+		Fees:       btcutil.Amount(row.Fees),
+		DecisionID: uint64(row.DecisionID),
+		SwapIndex:  int(row.SwapIndex),
+		State:      QueueState(row.State),
+		Created:    row.CreatedAt,
+		Expiry:     row.Expiry,
+		Updated:    row.UpdatedAt,
+	}
+
+	if row.Peer != nil {
+		queued.Peer, err = route.NewVertexFromBytes(row.Peer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, channel := range channels {
+		queued.Channels = append(
+			queued.Channels,
+			lnwire.NewShortChanIDFromInt(uint64(channel.ChannelID)),
+		)
+	}
+
+	if row.SwapHash != nil {
+		hash, err := lntypes.MakeHash(row.SwapHash)
+		if err != nil {
+			return nil, err
+		}
+
+		queued.SwapHash = &hash
+	}
+
+	return queued, nil
+}
@@ -0,0 +1,103 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQueueStore tests storing, updating and expiring queued swaps.
+func TestQueueStore(t *testing.T) {
+	ctx := context.Background()
+	testDb := loopdb.NewTestDB(t)
+	defer testDb.Close()
+
+	store := NewSQLStore(loopdb.NewTypedStore[Querier](testDb))
+
+	_, err := store.GetQueuedSwap(ctx, 1)
+	require.ErrorIs(t, err, ErrQueuedSwapNotFound)
+
+	loopOut := &QueuedSwap{
+		Type:       swap.TypeOut,
+		Amount:     5000,
+		Peer:       peer1,
+		Channels:   []lnwire.ShortChannelID{chanID1, chanID2},
+		Fees:       100,
+		DecisionID: 3,
+		SwapIndex:  0,
+		State:      QueueStatePending,
+		Created:    testTime,
+		Expiry:     testTime.Add(time.Hour),
+		Updated:    testTime,
+	}
+
+	loopIn := &QueuedSwap{
+		Type:      swap.TypeIn,
+		Amount:    6000,
+		Peer:      peer2,
+		Fees:      200,
+		SwapIndex: 1,
+		State:     QueueStatePending,
+		Created:   testTime,
+		Expiry:    testTime.Add(time.Hour * 2),
+		Updated:   testTime,
+	}
+
+	loopOut.ID, err = store.AddQueuedSwap(ctx, loopOut)
+	require.NoError(t, err)
+
+	loopIn.ID, err = store.AddQueuedSwap(ctx, loopIn)
+	require.NoError(t, err)
+
+	queued, err := store.GetQueuedSwap(ctx, loopOut.ID)
+	require.NoError(t, err)
+	require.Equal(t, loopOut, queued)
+
+	// Once we approve our loop out, it is no longer listed as pending.
+	hash := lntypes.Hash{1, 2, 3}
+	updated := testTime.Add(time.Minute)
+	err = store.UpdateQueuedSwap(
+		ctx, loopOut.ID, QueueStateApproved, updated, &hash,
+	)
+	require.NoError(t, err)
+
+	pending, err := store.ListQueuedSwaps(ctx, false)
+	require.NoError(t, err)
+	require.Equal(t, []*QueuedSwap{loopIn}, pending)
+
+	// Expiring our swaps before our loop in's expiry does not change it.
+	err = store.ExpireQueuedSwaps(ctx, testTime.Add(time.Hour))
+	require.NoError(t, err)
+
+	pending, err = store.ListQueuedSwaps(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	expired := loopIn.Expiry
+	require.NoError(t, store.ExpireQueuedSwaps(ctx, expired))
+
+	pending, err = store.ListQueuedSwaps(ctx, false)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	// When we include resolved swaps, all of our swaps are returned with
+	// their updated state.
+	all, err := store.ListQueuedSwaps(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	loopOut.State = QueueStateApproved
+	loopOut.Updated = updated
+	loopOut.SwapHash = &hash
+
+	loopIn.State = QueueStateExpired
+	loopIn.Updated = expired
+
+	require.Equal(t, []*QueuedSwap{loopOut, loopIn}, all)
+}
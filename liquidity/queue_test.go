@@ -0,0 +1,139 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestApprovalQueue tests queueing of autoloop's suggestions when approval is
+// required, and approval, rejection and expiry of queued swaps.
+func TestApprovalQueue(t *testing.T) {
+	ctx := context.Background()
+	cfg, lnd := newTestConfig()
+
+	testClock := clock.NewTestClock(testTime)
+	cfg.Clock = testClock
+	cfg.PutLiquidityParams = func(context.Context, []byte) error {
+		return nil
+	}
+
+	testDb := loopdb.NewTestDB(t)
+	defer testDb.Close()
+
+	cfg.QueueStore = NewSQLStore(loopdb.NewTypedStore[Querier](testDb))
+
+	var dispatched []loop.OutRequest
+	swapHash := lntypes.Hash{1}
+	cfg.LoopOut = func(_ context.Context,
+		req *loop.OutRequest) (*loop.LoopOutSwapInfo, error) {
+
+		dispatched = append(dispatched, *req)
+
+		return &loop.LoopOutSwapInfo{
+			SwapHash: swapHash,
+		}, nil
+	}
+
+	lnd.Channels = []lndclient.ChannelInfo{channel1}
+
+	manager := NewManager(cfg)
+	manager.params.Autoloop = true
+	manager.params.RequireApproval = true
+	manager.params.AutoFeeBudget = 100000
+	manager.params.AutoFeeRefreshPeriod = testBudgetRefresh
+	manager.params.AutoloopBudgetLastRefresh = testBudgetStart
+	manager.params.MaxAutoInFlight = 2
+	manager.params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+	}
+
+	// When approval is required, autoloop queues its suggestion rather
+	// than dispatching it. Running autoloop again does not queue a
+	// duplicate swap for the same channel.
+	require.NoError(t, manager.autoloop(ctx))
+	require.NoError(t, manager.autoloop(ctx))
+	require.Empty(t, dispatched)
+
+	queued, err := manager.ListQueuedSwaps(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+	require.Equal(t, swap.TypeOut, queued[0].Type)
+	require.Equal(t, chan1Rec.Amount, queued[0].Amount)
+	require.Equal(t, peer1, queued[0].Peer)
+	require.Equal(t, []lnwire.ShortChannelID{chanID1}, queued[0].Channels)
+	require.Equal(t, testTime.Add(defaultApprovalExpiry), queued[0].Expiry)
+
+	// If our budget no longer covers the swap's fees, it may not be
+	// approved and remains pending.
+	manager.params.AutoFeeBudget = 1
+	_, _, err = manager.ApproveQueuedSwap(ctx, queued[0].ID)
+	require.ErrorIs(t, err, ErrQueuedSwapIneligible)
+	require.Empty(t, dispatched)
+
+	// Once our budget allows it, approving the swap re-quotes and
+	// dispatches it as an autoloop swap.
+	manager.params.AutoFeeBudget = 100000
+	hash, fees, err := manager.ApproveQueuedSwap(ctx, queued[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, swapHash, hash)
+
+	// Our swap is swept to a new wallet address, which our mock provides.
+	require.Len(t, dispatched, 1)
+	require.NotNil(t, dispatched[0].DestAddr)
+
+	expected := chan1Rec
+	expected.DestAddr = dispatched[0].DestAddr
+	expected.Label = labels.AutoloopLabel(swap.TypeOut)
+	require.Equal(t, expected, dispatched[0])
+	require.Equal(t, worstCaseOutFees(
+		expected.MaxPrepayRoutingFee, expected.MaxSwapRoutingFee,
+		expected.MaxSwapFee, expected.MaxMinerFee,
+	), fees)
+
+	// A swap can only be approved once.
+	_, _, err = manager.ApproveQueuedSwap(ctx, queued[0].ID)
+	require.ErrorIs(t, err, ErrQueuedSwapResolved)
+
+	// Our next suggestion is queued again, since our approved swap is no
+	// longer pending. We reject this swap.
+	require.NoError(t, manager.autoloop(ctx))
+	queued, err = manager.ListQueuedSwaps(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+
+	require.NoError(t, manager.RejectQueuedSwap(ctx, queued[0].ID))
+
+	err = manager.RejectQueuedSwap(ctx, queued[0].ID)
+	require.ErrorIs(t, err, ErrQueuedSwapResolved)
+
+	// Swaps that are not approved before their expiry can no longer be
+	// approved.
+	require.NoError(t, manager.autoloop(ctx))
+	queued, err = manager.ListQueuedSwaps(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+
+	testClock.SetTime(queued[0].Expiry.Add(time.Second))
+	_, _, err = manager.ApproveQueuedSwap(ctx, queued[0].ID)
+	require.ErrorIs(t, err, ErrQueuedSwapExpired)
+	require.Len(t, dispatched, 1)
+
+	all, err := manager.ListQueuedSwaps(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, QueueStateApproved, all[0].State)
+	require.Equal(t, &swapHash, all[0].SwapHash)
+	require.Equal(t, QueueStateRejected, all[1].State)
+	require.Equal(t, QueueStateExpired, all[2].State)
+}
@@ -73,6 +73,37 @@ const (
 	// ReasonCustomChannelData indicates that the channel is not standard
 	// and should not be used for swaps.
 	ReasonCustomChannelData
+
+	// ReasonOutsideSchedule indicates that the current time is outside of
+	// the schedule windows that automated swaps are restricted to.
+	ReasonOutsideSchedule
+
+	// ReasonFeeRateTooHigh indicates that the current on-chain fee rate
+	// estimate is above our fee rate ceiling.
+	ReasonFeeRateTooHigh
+
+	// ReasonTargetBudget indicates that the budget set for a specific
+	// channel or peer does not have enough remaining to cover a swap.
+	ReasonTargetBudget
+
+	// ReasonNoStaticDeposits indicates that loop ins are funded by static
+	// address deposits, and we do not have enough unused deposits to cover
+	// the minimum swap amount.
+	ReasonNoStaticDeposits
+
+	// ReasonChannelTooYoung indicates that a channel has fewer
+	// confirmations than our minimum channel age, or that a peer only
+	// has channels that are too young.
+	ReasonChannelTooYoung
+
+	// ReasonPeerUnreliable indicates that a peer's uptime is below our
+	// minimum peer uptime.
+	ReasonPeerUnreliable
+
+	// ReasonInsufficientRevenue indicates that a loop out costs more than
+	// the routing revenue that we project its channels to earn over our
+	// roi payback period.
+	ReasonInsufficientRevenue
 )
 
 // String returns a string representation of a reason.
@@ -123,6 +154,27 @@ func (r Reason) String() string {
 	case ReasonLoopInUnreachable:
 		return "loop in unreachable"
 
+	case ReasonOutsideSchedule:
+		return "outside schedule"
+
+	case ReasonFeeRateTooHigh:
+		return "fee rate too high"
+
+	case ReasonTargetBudget:
+		return "target budget insufficient"
+
+	case ReasonNoStaticDeposits:
+		return "no static deposits"
+
+	case ReasonChannelTooYoung:
+		return "channel too young"
+
+	case ReasonPeerUnreliable:
+		return "peer unreliable"
+
+	case ReasonInsufficientRevenue:
+		return "insufficient revenue"
+
 	default:
 		return "unknown"
 	}
@@ -0,0 +1,131 @@
+package liquidity
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// channelFilter excludes channels that are too young, or that belong to
+// peers that are not reliable enough, from our swaps.
+type channelFilter struct {
+	// height is our current block height. This value is only set if we
+	// have a minimum channel age.
+	height uint32
+
+	// minAge is the minimum number of confirmations that a channel must
+	// have.
+	minAge uint32
+
+	// minUptime is the minimum percentage of time that a peer must have
+	// been online.
+	minUptime uint32
+
+	// uptime maps our peers to the percentage of time that they have been
+	// online.
+	uptime map[route.Vertex]float64
+}
+
+// newChannelFilter creates a filter for our current channel age and peer
+// uptime parameters, scoring our peers' uptime using the channels provided.
+func (m *Manager) newChannelFilter(ctx context.Context,
+	channels []lndclient.ChannelInfo) (*channelFilter, error) {
+
+	filter := &channelFilter{
+		minAge:    m.params.MinChannelAge,
+		minUptime: m.params.MinPeerUptime,
+	}
+
+	if filter.minAge != 0 {
+		info, err := m.cfg.Lnd.Client.GetInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.height = info.BlockHeight
+	}
+
+	if filter.minUptime != 0 {
+		filter.uptime = peerUptime(channels)
+	}
+
+	return filter, nil
+}
+
+// check returns the reason that a channel should be excluded from our swaps,
+// or ReasonNone if the channel may be used.
+func (f *channelFilter) check(channel lndclient.ChannelInfo) Reason {
+	if f.minAge != 0 {
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		confirmed := chanID.BlockHeight
+
+		if confirmed > f.height || f.height-confirmed < f.minAge {
+			return ReasonChannelTooYoung
+		}
+	}
+
+	if f.minUptime != 0 &&
+		f.uptime[channel.PubKeyBytes] < float64(f.minUptime) {
+
+		return ReasonPeerUnreliable
+	}
+
+	return ReasonNone
+}
+
+// peerUptime scores our peers by the percentage of time that they have been
+// online, using the uptime and lifetime that lnd has recorded for their
+// channels from its channel events. A peer's score is its total uptime over
+// all of its channels divided by the total time that lnd has monitored them.
+// Peers that lnd has not monitored yet have a score of zero.
+func peerUptime(channels []lndclient.ChannelInfo) map[route.Vertex]float64 {
+	var (
+		uptime   = make(map[route.Vertex]time.Duration)
+		lifetime = make(map[route.Vertex]time.Duration)
+	)
+	for _, channel := range channels {
+		uptime[channel.PubKeyBytes] += channel.Uptime
+		lifetime[channel.PubKeyBytes] += channel.LifeTime
+	}
+
+	scores := make(map[route.Vertex]float64, len(lifetime))
+	for peer, monitored := range lifetime {
+		if monitored == 0 {
+			scores[peer] = 0
+			continue
+		}
+
+		scores[peer] = float64(uptime[peer]) / float64(monitored) * 100
+	}
+
+	return scores
+}
+
+// eligibleChannels returns the channels provided that pass our channel age and
+// peer uptime filters.
+func (m *Manager) eligibleChannels(ctx context.Context,
+	channels []lndclient.ChannelInfo) ([]lndclient.ChannelInfo, error) {
+
+	filter, err := m.newChannelFilter(ctx, channels)
+	if err != nil {
+		return nil, err
+	}
+
+	eligible := make([]lndclient.ChannelInfo, 0, len(channels))
+	for _, channel := range channels {
+		reason := filter.check(channel)
+		if reason != ReasonNone {
+			log.Debugf("Channel: %v excluded from autoloop: %v",
+				channel.ChannelID, reason)
+
+			continue
+		}
+
+		eligible = append(eligible, channel)
+	}
+
+	return eligible, nil
+}
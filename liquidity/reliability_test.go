@@ -0,0 +1,161 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPeerUptime tests scoring of our peers' uptime across their channels.
+func TestPeerUptime(t *testing.T) {
+	channels := []lndclient.ChannelInfo{
+		{
+			PubKeyBytes: peer1,
+			Uptime:      time.Hour * 9,
+			LifeTime:    time.Hour * 10,
+		},
+		{
+			PubKeyBytes: peer1,
+			Uptime:      time.Hour * 3,
+			LifeTime:    time.Hour * 10,
+		},
+		{
+			PubKeyBytes: peer2,
+		},
+	}
+
+	require.Equal(t, map[route.Vertex]float64{
+		peer1: 60,
+		peer2: 0,
+	}, peerUptime(channels))
+}
+
+// TestChannelFilters tests exclusion of channels that are too young or belong
+// to unreliable peers from our suggestions.
+func TestChannelFilters(t *testing.T) {
+	// Our mock's block height is 600, so a channel confirmed at 595 has
+	// fewer than 10 confirmations.
+	youngChanID := lnwire.ShortChannelID{
+		BlockHeight: 595,
+		TxIndex:     1,
+	}
+
+	reliable := channel1
+	reliable.Uptime = time.Hour * 9
+	reliable.LifeTime = time.Hour * 10
+
+	young := channel2
+	young.ChannelID = youngChanID.ToUint64()
+	young.Uptime = time.Hour * 9
+	young.LifeTime = time.Hour * 10
+
+	unreliable := channel2
+	unreliable.Uptime = time.Hour * 5
+	unreliable.LifeTime = time.Hour * 10
+
+	youngDisqualified := map[lnwire.ShortChannelID]Reason{
+		youngChanID: ReasonChannelTooYoung,
+	}
+	unreliableDisqualified := map[lnwire.ShortChannelID]Reason{
+		chanID2: ReasonPeerUnreliable,
+	}
+
+	tests := []struct {
+		name        string
+		channels    []lndclient.ChannelInfo
+		rules       map[lnwire.ShortChannelID]*SwapRule
+		peerRules   map[route.Vertex]*SwapRule
+		minAge      uint32
+		minUptime   uint32
+		suggestions *Suggestions
+	}{
+		{
+			name:     "young channel",
+			channels: []lndclient.ChannelInfo{reliable, young},
+			rules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1:     chanRule,
+				youngChanID: chanRule,
+			},
+			minAge: 10,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: youngDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "unreliable peer",
+			channels: []lndclient.ChannelInfo{reliable, unreliable},
+			rules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+				chanID2: chanRule,
+			},
+			minUptime: 80,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: unreliableDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "peer with only young channels",
+			channels: []lndclient.ChannelInfo{reliable, young},
+			peerRules: map[route.Vertex]*SwapRule{
+				peer2: chanRule,
+			},
+			minAge: 10,
+			suggestions: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: map[route.Vertex]Reason{
+					peer2: ReasonChannelTooYoung,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = testCase.channels
+
+			params := defaultParameters
+			params.AutoloopBudgetLastRefresh = testBudgetStart
+			params.MinChannelAge = testCase.minAge
+			params.MinPeerUptime = testCase.minUptime
+
+			if testCase.rules != nil {
+				params.ChannelRules = testCase.rules
+			}
+
+			if testCase.peerRules != nil {
+				params.PeerRules = testCase.peerRules
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestInvalidPeerUptime tests that our minimum peer uptime must be a
+// percentage.
+func TestInvalidPeerUptime(t *testing.T) {
+	params := defaultParameters
+	params.MinPeerUptime = 101
+
+	err := params.validate(1, nil, testRestrictions)
+	require.ErrorIs(t, err, ErrInvalidPeerUptime)
+}
@@ -0,0 +1,115 @@
+package liquidity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// errZeroROILookback is returned when our roi gate does not have a
+	// lookback period set.
+	errZeroROILookback = errors.New("roi lookback must be > 0")
+
+	// errZeroROIPayback is returned when our roi gate does not have a
+	// payback period set.
+	errZeroROIPayback = errors.New("roi payback period must be > 0")
+)
+
+// ROIGate limits our loop outs to those that we expect to pay for themselves
+// in routing revenue. The inbound liquidity that a loop out regains allows
+// forwards to enter our node over the swap's channels, so we estimate the
+// revenue that a loop out will earn from the fees of the forwards that
+// arrived over its channels during the lookback period.
+type ROIGate struct {
+	// Lookback is the period of forwarding history that we use to
+	// estimate our routing revenue.
+	Lookback time.Duration
+
+	// PaybackPeriod is the period that a loop out's routing revenue must
+	// cover its cost within.
+	PaybackPeriod time.Duration
+}
+
+// String returns a string representation of a roi gate.
+func (r *ROIGate) String() string {
+	return fmt.Sprintf("roi: %v lookback, %v payback", r.Lookback,
+		r.PaybackPeriod)
+}
+
+// validate checks that a roi gate is valid.
+func (r *ROIGate) validate() error {
+	if r.Lookback <= 0 {
+		return errZeroROILookback
+	}
+
+	if r.PaybackPeriod <= 0 {
+		return errZeroROIPayback
+	}
+
+	return nil
+}
+
+// revenue returns the routing revenue that we project the channels provided
+// to earn over our payback period, based on the fees earned by forwards that
+// arrived over them since the start of our lookback period.
+func (r *ROIGate) revenue(events []lndclient.ForwardingEvent,
+	channels []lnwire.ShortChannelID, now time.Time) btcutil.Amount {
+
+	chanSet := make(map[uint64]bool, len(channels))
+	for _, channel := range channels {
+		chanSet[channel.ToUint64()] = true
+	}
+
+	var (
+		since = now.Add(-r.Lookback)
+		fees  lnwire.MilliSatoshi
+	)
+	for _, event := range events {
+		if event.Timestamp.Before(since) {
+			continue
+		}
+
+		if chanSet[event.ChannelIn] {
+			fees += event.FeeMsat
+		}
+	}
+
+	// Scale the fees that we earned over our lookback period to our
+	// payback period.
+	return btcutil.Amount(
+		float64(fees.ToSatoshis()) * float64(r.PaybackPeriod) /
+			float64(r.Lookback),
+	)
+}
+
+// check returns a reason error if a loop out costs more than the routing
+// revenue that we project its channels to earn over our payback period. Loop
+// ins are not subject to our roi gate, and no suggestions are gated if our
+// gate is nil.
+func (r *ROIGate) check(events []lndclient.ForwardingEvent, now time.Time,
+	suggestion swapSuggestion) error {
+
+	if r == nil {
+		return nil
+	}
+
+	if _, ok := suggestion.(*loopOutSwapSuggestion); !ok {
+		return nil
+	}
+
+	revenue := r.revenue(events, suggestion.channels(), now)
+	if suggestion.fees() > revenue {
+		log.Debugf("Loop out over channels %v costs: %v, more than "+
+			"projected revenue: %v", suggestion.channels(),
+			suggestion.fees(), revenue)
+
+		return newReasonError(ReasonInsufficientRevenue)
+	}
+
+	return nil
+}
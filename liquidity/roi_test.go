@@ -0,0 +1,199 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestROIGateValidate tests validation of roi gates.
+func TestROIGateValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		roi  *ROIGate
+		err  error
+	}{
+		{
+			name: "valid",
+			roi: &ROIGate{
+				Lookback:      time.Hour,
+				PaybackPeriod: time.Hour,
+			},
+		},
+		{
+			name: "no lookback",
+			roi: &ROIGate{
+				PaybackPeriod: time.Hour,
+			},
+			err: errZeroROILookback,
+		},
+		{
+			name: "no payback period",
+			roi: &ROIGate{
+				Lookback: time.Hour,
+			},
+			err: errZeroROIPayback,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			require.ErrorIs(t, testCase.roi.validate(), testCase.err)
+		})
+	}
+}
+
+// TestROIRevenue tests projection of the routing revenue that a set of
+// channels will earn over our payback period.
+func TestROIRevenue(t *testing.T) {
+	roi := &ROIGate{
+		Lookback:      time.Hour,
+		PaybackPeriod: time.Hour * 2,
+	}
+
+	events := []lndclient.ForwardingEvent{
+		// A forward that arrived over our channel before our lookback
+		// is not counted.
+		{
+			Timestamp: testTime.Add(time.Hour * -2),
+			ChannelIn: chanID1.ToUint64(),
+			FeeMsat:   100000,
+		},
+		// Forwards that arrived over our channels are counted.
+		{
+			Timestamp: testTime.Add(time.Minute * -30),
+			ChannelIn: chanID1.ToUint64(),
+			FeeMsat:   10000,
+		},
+		{
+			Timestamp: testTime.Add(time.Minute * -20),
+			ChannelIn: chanID2.ToUint64(),
+			FeeMsat:   20000,
+		},
+		// A forward that left over our channel does not rely on the
+		// channel's inbound liquidity, so it is not counted.
+		{
+			Timestamp:  testTime.Add(time.Minute * -10),
+			ChannelIn:  chanID3.ToUint64(),
+			ChannelOut: chanID1.ToUint64(),
+			FeeMsat:    40000,
+		},
+	}
+
+	revenue := roi.revenue(
+		events, []lnwire.ShortChannelID{chanID1, chanID2}, testTime,
+	)
+	require.Equal(t, btcutil.Amount(60), revenue)
+}
+
+// TestROIGateSuggestions tests that loop outs that cost more than the
+// routing revenue projected for their channels are not suggested.
+func TestROIGateSuggestions(t *testing.T) {
+	roi := &ROIGate{
+		Lookback:      time.Hour * 24,
+		PaybackPeriod: time.Hour * 24 * 7,
+	}
+
+	chanRules := map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+	}
+
+	event := func(feeMsat lnwire.MilliSatoshi) lndclient.ForwardingEvent {
+		return lndclient.ForwardingEvent{
+			Timestamp: testTime.Add(time.Hour * -1),
+			ChannelIn: chanID1.ToUint64(),
+			FeeMsat:   feeMsat,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		events      []lndclient.ForwardingEvent
+		suggestions *Suggestions
+	}{
+		{
+			name: "revenue covers cost",
+			events: []lndclient.ForwardingEvent{
+				event(1000000),
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "insufficient revenue",
+			events: []lndclient.ForwardingEvent{
+				event(1000),
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonInsufficientRevenue,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "no forwards",
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonInsufficientRevenue,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{channel1}
+			lnd.ForwardingEvents = testCase.events
+
+			params := defaultParameters
+			params.AutoloopBudgetLastRefresh = testBudgetStart
+			params.ChannelRules = chanRules
+			params.ROI = roi
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestROIGateCheck tests that only loop outs are subject to our roi gate, and
+// that no swaps are gated if we do not have a gate set.
+func TestROIGateCheck(t *testing.T) {
+	roi := &ROIGate{
+		Lookback:      time.Hour,
+		PaybackPeriod: time.Hour,
+	}
+
+	err := roi.check(nil, testTime, &loopInSwapSuggestion{})
+	require.NoError(t, err)
+
+	var noROI *ROIGate
+	err = noROI.check(nil, testTime, &loopOutSwapSuggestion{})
+	require.NoError(t, err)
+
+	err = roi.check(nil, testTime, &loopOutSwapSuggestion{
+		OutRequest: loop.OutRequest{
+			MaxSwapFee: 1,
+		},
+	})
+	require.Equal(t, newReasonError(ReasonInsufficientRevenue), err)
+}
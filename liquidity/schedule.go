@@ -0,0 +1,244 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	clientrpc "github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrInvalidScheduleDay is returned when a schedule window contains a
+	// day that is not a valid day of the week.
+	ErrInvalidScheduleDay = errors.New("schedule window days must be in " +
+		"[0, 6], with Sunday as 0")
+
+	// ErrInvalidScheduleHours is returned when a schedule window's hours
+	// do not describe a valid range within a day.
+	ErrInvalidScheduleHours = errors.New("schedule window must have " +
+		"start hour in [0, 23] and end hour in [0, 24], different " +
+		"from the start hour")
+)
+
+// ScheduleWindow describes a recurring window of time in which we allow
+// automated swaps to be dispatched. Windows are expressed in UTC. A window
+// with an end hour before its start hour wraps past midnight, so 22-4 covers
+// 22:00 to 04:00 on the next day.
+type ScheduleWindow struct {
+	// Days is the set of days of the week that the window applies to. If
+	// no days are set, the window applies to every day of the week. A
+	// window that wraps past midnight applies to the day that it opens
+	// on.
+	Days []time.Weekday
+
+	// StartHour is the hour of the day at which the window opens
+	// (inclusive).
+	StartHour int
+
+	// EndHour is the hour of the day at which the window closes
+	// (exclusive).
+	EndHour int
+}
+
+// String returns the string representation of a schedule window.
+func (w ScheduleWindow) String() string {
+	days := "every day"
+	if len(w.Days) != 0 {
+		dayStrs := make([]string, len(w.Days))
+		for i, day := range w.Days {
+			dayStrs[i] = day.String()
+		}
+
+		days = strings.Join(dayStrs, ",")
+	}
+
+	return fmt.Sprintf("%v %02d:00-%02d:00 UTC", days, w.StartHour,
+		w.EndHour)
+}
+
+// validate checks that a schedule window is well formed.
+func (w ScheduleWindow) validate() error {
+	for _, day := range w.Days {
+		if day < time.Sunday || day > time.Saturday {
+			return ErrInvalidScheduleDay
+		}
+	}
+
+	if w.StartHour < 0 || w.StartHour > 23 {
+		return ErrInvalidScheduleHours
+	}
+
+	if w.EndHour < 0 || w.EndHour > 24 || w.EndHour == w.StartHour {
+		return ErrInvalidScheduleHours
+	}
+
+	return nil
+}
+
+// contains returns a boolean indicating whether the time provided falls
+// within the window.
+func (w ScheduleWindow) contains(now time.Time) bool {
+	now = now.UTC()
+
+	hour, weekday := now.Hour(), now.Weekday()
+
+	switch {
+	case w.EndHour > w.StartHour:
+		if hour < w.StartHour || hour >= w.EndHour {
+			return false
+		}
+
+	// If the window wraps past midnight, the hours after midnight belong
+	// to the window that opened on the day before.
+	case hour >= w.StartHour:
+
+	case hour < w.EndHour:
+		weekday = (weekday + 6) % 7
+
+	default:
+		return false
+	}
+
+	if len(w.Days) == 0 {
+		return true
+	}
+
+	for _, day := range w.Days {
+		if weekday == day {
+			return true
+		}
+	}
+
+	return false
+}
+
+// opened returns the time at which the window opened most recently before the
+// time provided. This is the time that the window was entered at if it
+// contains the time provided.
+func (w ScheduleWindow) opened(now time.Time) time.Time {
+	now = now.UTC()
+
+	opened := time.Date(
+		now.Year(), now.Month(), now.Day(), w.StartHour, 0, 0, 0,
+		time.UTC,
+	)
+	if now.Before(opened) {
+		opened = opened.AddDate(0, 0, -1)
+	}
+
+	return opened
+}
+
+// inSchedule returns a boolean indicating whether the time provided falls in
+// any of our schedule windows. If no windows are set, we are always within our
+// schedule.
+func (p Parameters) inSchedule(now time.Time) bool {
+	if len(p.ScheduleWindows) == 0 {
+		return true
+	}
+
+	for _, window := range p.ScheduleWindows {
+		if window.contains(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkDispatchWindow checks whether automated swaps may currently be
+// dispatched, given our schedule windows and fee rate ceiling. A reason error
+// is returned if we are outside of our schedule or the current fee rate
+// estimate exceeds our ceiling.
+func (m *Manager) checkDispatchWindow(ctx context.Context) error {
+	now := m.cfg.Clock.Now()
+	if !m.params.inSchedule(now) {
+		log.Debugf("Time: %v outside of autoloop schedule: %v", now,
+			m.params.ScheduleWindows)
+
+		return newReasonError(ReasonOutsideSchedule)
+	}
+
+	if m.params.FeeRateCeiling == 0 {
+		return nil
+	}
+
+	estimate, err := m.cfg.Lnd.WalletKit.EstimateFeeRate(
+		ctx, m.params.SweepConfTarget,
+	)
+	if err != nil {
+		return err
+	}
+
+	if estimate > m.params.FeeRateCeiling {
+		log.Debugf("Current fee rate estimate: %v sat/vbyte above "+
+			"ceiling: %v sat/vbyte", satPerKwToSatPerVByte(estimate),
+			satPerKwToSatPerVByte(m.params.FeeRateCeiling))
+
+		return newReasonError(ReasonFeeRateTooHigh)
+	}
+
+	return nil
+}
+
+// RpcToScheduleWindows converts a set of rpc schedule windows to our native
+// type.
+func RpcToScheduleWindows(
+	windows []*clientrpc.ScheduleWindow) []ScheduleWindow {
+
+	if len(windows) == 0 {
+		return nil
+	}
+
+	schedule := make([]ScheduleWindow, len(windows))
+	for i, window := range windows {
+		var days []time.Weekday
+		for _, day := range window.Days {
+			days = append(days, time.Weekday(day))
+		}
+
+		schedule[i] = ScheduleWindow{
+			Days:      days,
+			StartHour: int(window.StartHour),
+			EndHour:   int(window.EndHour),
+		}
+	}
+
+	return schedule
+}
+
+// ScheduleWindowsToRpc converts a set of schedule windows to their rpc
+// representation.
+func ScheduleWindowsToRpc(
+	windows []ScheduleWindow) []*clientrpc.ScheduleWindow {
+
+	if len(windows) == 0 {
+		return nil
+	}
+
+	rpcWindows := make([]*clientrpc.ScheduleWindow, len(windows))
+	for i, window := range windows {
+		var days []uint32
+		for _, day := range window.Days {
+			days = append(days, uint32(day))
+		}
+
+		rpcWindows[i] = &clientrpc.ScheduleWindow{
+			Days:      days,
+			StartHour: uint32(window.StartHour),
+			EndHour:   uint32(window.EndHour),
+		}
+	}
+
+	return rpcWindows
+}
+
+// satPerVByteToSatPerKw converts a fee rate expressed in sat/vbyte to
+// sat/kw.
+func satPerVByteToSatPerKw(satPerVByte uint64) chainfee.SatPerKWeight {
+	return chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
+}
@@ -0,0 +1,137 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestScheduleWindow tests validation of schedule windows and checking
+// whether a time falls within a window.
+func TestScheduleWindow(t *testing.T) {
+	// Wednesday 15 January 2020, 10:30 UTC.
+	wednesday := time.Date(2020, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		window   ScheduleWindow
+		err      error
+		contains bool
+	}{
+		{
+			name: "every day, in hours",
+			window: ScheduleWindow{
+				StartHour: 10,
+				EndHour:   11,
+			},
+			contains: true,
+		},
+		{
+			name: "end hour exclusive",
+			window: ScheduleWindow{
+				StartHour: 8,
+				EndHour:   10,
+			},
+			contains: false,
+		},
+		{
+			name: "matching day",
+			window: ScheduleWindow{
+				Days: []time.Weekday{
+					time.Monday, time.Wednesday,
+				},
+				StartHour: 0,
+				EndHour:   24,
+			},
+			contains: true,
+		},
+		{
+			name: "other day",
+			window: ScheduleWindow{
+				Days:      []time.Weekday{time.Tuesday},
+				StartHour: 0,
+				EndHour:   24,
+			},
+			contains: false,
+		},
+		{
+			name: "invalid day",
+			window: ScheduleWindow{
+				Days:      []time.Weekday{7},
+				StartHour: 0,
+				EndHour:   24,
+			},
+			err: ErrInvalidScheduleDay,
+		},
+		{
+			name: "wraps past midnight, before midnight",
+			window: ScheduleWindow{
+				Days:      []time.Weekday{time.Wednesday},
+				StartHour: 10,
+				EndHour:   9,
+			},
+			contains: true,
+		},
+		{
+			name: "wraps past midnight, after midnight",
+			window: ScheduleWindow{
+				Days:      []time.Weekday{time.Tuesday},
+				StartHour: 22,
+				EndHour:   11,
+			},
+			contains: true,
+		},
+		{
+			name: "wraps past midnight, opens on day",
+			window: ScheduleWindow{
+				Days:      []time.Weekday{time.Wednesday},
+				StartHour: 22,
+				EndHour:   11,
+			},
+			contains: false,
+		},
+		{
+			name: "outside window that wraps past midnight",
+			window: ScheduleWindow{
+				StartHour: 22,
+				EndHour:   4,
+			},
+			contains: false,
+		},
+		{
+			name: "empty window",
+			window: ScheduleWindow{
+				StartHour: 10,
+				EndHour:   10,
+			},
+			err: ErrInvalidScheduleHours,
+		},
+		{
+			name: "end hour too large",
+			window: ScheduleWindow{
+				StartHour: 10,
+				EndHour:   25,
+			},
+			err: ErrInvalidScheduleHours,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.window.validate()
+			require.Equal(t, testCase.err, err)
+
+			if err != nil {
+				return
+			}
+
+			require.Equal(
+				t, testCase.contains,
+				testCase.window.contains(wednesday),
+			)
+		})
+	}
+}
@@ -0,0 +1,675 @@
+package liquidity
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// maxRecordedSnapshots is the number of balance snapshots that we
+	// keep in memory for simulations. With our default autoloop ticker,
+	// this is roughly two weeks of history.
+	maxRecordedSnapshots = 1008
+)
+
+var (
+	// ErrNoSnapshots is returned when a simulation is requested without
+	// any balance snapshots to replay.
+	ErrNoSnapshots = errors.New("no balance snapshots to simulate")
+
+	// errSnapshotOrder is returned when the snapshots provided for a
+	// simulation are not in ascending order of time.
+	errSnapshotOrder = errors.New("snapshots must be in ascending " +
+		"order of time")
+
+	// errEasyAutoloopSimulation is returned when a simulation is
+	// requested for parameters that use easy autoloop.
+	errEasyAutoloopSimulation = errors.New("simulation is only " +
+		"supported for liquidity rules, not easy autoloop")
+
+	// errAssetRulesSimulation is returned when a simulation is requested
+	// for parameters that have asset rules. Our snapshots do not include
+	// the asset balances of channels, and we have no asset prices to
+	// simulate with.
+	errAssetRulesSimulation = errors.New("simulation is not " +
+		"supported for asset rules")
+)
+
+// BalanceSnapshot is the state of our channels and the on-chain fee
+// environment at a point in time.
+type BalanceSnapshot struct {
+	// Timestamp is the time that the snapshot was taken.
+	Timestamp time.Time
+
+	// Channels is the set of channels that we had open at the time.
+	Channels []lndclient.ChannelInfo
+
+	// SweepFeeRate is the fee rate estimate for our sweep confirmation
+	// target at the time.
+	SweepFeeRate chainfee.SatPerKWeight
+}
+
+// SimulationRequest contains the parameters and history that a simulation
+// replays.
+type SimulationRequest struct {
+	// Parameters is the set of liquidity parameters to simulate.
+	Parameters Parameters
+
+	// Snapshots is the time series of balances and fee rates to replay,
+	// in ascending order of time.
+	Snapshots []BalanceSnapshot
+
+	// SwapFeePPM is the swap fee, expressed as parts per million of the
+	// swap amount, that the simulated server quotes.
+	SwapFeePPM uint64
+
+	// MinerFee is the on-chain fee that the simulated server quotes for
+	// every swap.
+	MinerFee btcutil.Amount
+
+	// PrepayAmount is the no-show prepay that the simulated server quotes
+	// for loop outs.
+	PrepayAmount btcutil.Amount
+
+	// Restrictions is the set of swap size restrictions applied in the
+	// simulation. If it is not set, the server's current restrictions are
+	// used.
+	Restrictions *Restrictions
+}
+
+// SimulatedSwap is a swap that would have been dispatched in a simulation.
+type SimulatedSwap struct {
+	// Timestamp is the time of the snapshot at which the swap was
+	// dispatched.
+	Timestamp time.Time
+
+	// Type is the type of swap.
+	Type swap.Type
+
+	// Amount is the amount of the swap.
+	Amount btcutil.Amount
+
+	// Channels is the set of outgoing channels for loop out swaps.
+	Channels []lnwire.ShortChannelID
+
+	// LastHop is the last hop peer for loop in swaps.
+	LastHop *route.Vertex
+
+	// Fees is the amount of fees that the swap is charged when it
+	// completes in the simulation.
+	Fees btcutil.Amount
+
+	// MaxFees is the worst-case fee amount that the swap is budgeted for
+	// while it is in flight.
+	MaxFees btcutil.Amount
+}
+
+// SimulationStep reports the state of our budget after a snapshot has been
+// replayed.
+type SimulationStep struct {
+	// Timestamp is the time of the snapshot.
+	Timestamp time.Time
+
+	// SwapsDispatched is the number of swaps dispatched at this step.
+	SwapsDispatched int
+
+	// SpentFees is the total fees of completed swaps that count towards
+	// our current budget period.
+	SpentFees btcutil.Amount
+
+	// PendingFees is the worst-case fees of swaps that are in flight.
+	PendingFees btcutil.Amount
+
+	// BudgetRemaining is the amount of our autoloop budget that remains
+	// available.
+	BudgetRemaining btcutil.Amount
+}
+
+// SimulationResult contains the outcome of a simulation.
+type SimulationResult struct {
+	// Swaps is the set of swaps that would have been dispatched.
+	Swaps []SimulatedSwap
+
+	// Steps reports our budget use after each snapshot.
+	Steps []SimulationStep
+
+	// TotalFees is the total fees that the simulated swaps were charged.
+	TotalFees btcutil.Amount
+}
+
+// recordSnapshot records the current state of our channels and fee estimate
+// so that it can be replayed in simulations.
+func (m *Manager) recordSnapshot(ctx context.Context) error {
+	channels, err := m.cfg.Lnd.Client.ListChannels(ctx, false, false)
+	if err != nil {
+		return err
+	}
+
+	feeRate, err := m.cfg.Lnd.WalletKit.EstimateFeeRate(
+		ctx, m.params.SweepConfTarget,
+	)
+	if err != nil {
+		return err
+	}
+
+	m.snapshotLock.Lock()
+	defer m.snapshotLock.Unlock()
+
+	m.snapshots = append(m.snapshots, BalanceSnapshot{
+		Timestamp:    m.cfg.Clock.Now(),
+		Channels:     channels,
+		SweepFeeRate: feeRate,
+	})
+
+	if len(m.snapshots) > maxRecordedSnapshots {
+		m.snapshots = m.snapshots[len(m.snapshots)-maxRecordedSnapshots:]
+	}
+
+	return nil
+}
+
+// Snapshots returns a copy of the balance snapshots that we have recorded.
+func (m *Manager) Snapshots() []BalanceSnapshot {
+	m.snapshotLock.Lock()
+	defer m.snapshotLock.Unlock()
+
+	return append([]BalanceSnapshot(nil), m.snapshots...)
+}
+
+// Simulate replays our suggestion logic over a time series of balance
+// snapshots, using a mocked clock and quote source. Swaps that are suggested
+// at a snapshot are treated as dispatched, shift liquidity in subsequent
+// snapshots and complete at the next snapshot, paying the quoted swap and
+// miner fees.
+func (m *Manager) Simulate(ctx context.Context,
+	req *SimulationRequest) (*SimulationResult, error) {
+
+	if len(req.Snapshots) == 0 {
+		return nil, ErrNoSnapshots
+	}
+
+	for i := 1; i < len(req.Snapshots); i++ {
+		if req.Snapshots[i].Timestamp.Before(
+			req.Snapshots[i-1].Timestamp,
+		) {
+
+			return nil, errSnapshotOrder
+		}
+	}
+
+	if req.Parameters.EasyAutoloop {
+		return nil, errEasyAutoloopSimulation
+	}
+
+	if len(req.Parameters.AssetRules) != 0 {
+		return nil, errAssetRulesSimulation
+	}
+
+	outRestrictions, inRestrictions := req.Restrictions, req.Restrictions
+	if req.Restrictions == nil {
+		var err error
+		outRestrictions, err = m.cfg.Restrictions(
+			ctx, swap.TypeOut, getInitiator(req.Parameters),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		inRestrictions, err = m.cfg.Restrictions(
+			ctx, swap.TypeIn, getInitiator(req.Parameters),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := req.Snapshots[0]
+	err := req.Parameters.validate(
+		m.cfg.MinimumConfirmations, start.Channels, outRestrictions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sim := &simulator{
+		req:    req,
+		clock:  clock.NewTestClock(start.Timestamp),
+		deltas: make(map[uint64]btcutil.Amount),
+	}
+
+	simMgr := NewManager(&Config{
+		Restrictions: func(_ context.Context, swapType swap.Type,
+			_ string) (*Restrictions, error) {
+
+			if swapType == swap.TypeOut {
+				return outRestrictions, nil
+			}
+
+			return inRestrictions, nil
+		},
+		Lnd: &lndclient.LndServices{
+			Client:    &simLightningClient{sim: sim},
+			WalletKit: &simWalletKitClient{sim: sim},
+		},
+		ListLoopOut: func(context.Context) ([]*loopdb.LoopOut, error) {
+			return sim.loopOuts, nil
+		},
+		ListLoopIn: func(context.Context) ([]*loopdb.LoopIn, error) {
+			return sim.loopIns, nil
+		},
+		LoopOutQuote: sim.loopOutQuote,
+		LoopInQuote:  sim.loopInQuote,
+		Clock:        sim.clock,
+		PutLiquidityParams: func(context.Context, []byte) error {
+			return nil
+		},
+		MinimumConfirmations: m.cfg.MinimumConfirmations,
+	})
+
+	// We do not want our simulation to allocate addresses, so we run it
+	// with autoloop disabled and label the swaps that it dispatches
+	// ourselves. All budgets start at the beginning of our time series.
+	simMgr.params = cloneParameters(req.Parameters)
+	simMgr.params.Autoloop = false
+	simMgr.params.AutoloopBudgetLastRefresh = start.Timestamp
+	for _, rule := range simMgr.params.ChannelRules {
+		if rule.Budget != nil {
+			rule.Budget.LastRefresh = start.Timestamp
+		}
+	}
+	for _, rule := range simMgr.params.PeerRules {
+		if rule.Budget != nil {
+			rule.Budget.LastRefresh = start.Timestamp
+		}
+	}
+
+	// We do not record the history of our static address deposits, so we
+	// simulate loop ins as being funded by our on-chain wallet.
+	simMgr.params.StaticLoopIn = false
+
+	// Our snapshots do not include block heights or peer uptime, so we do
+	// not filter channels by their age or their peer's uptime.
+	simMgr.params.MinChannelAge = 0
+	simMgr.params.MinPeerUptime = 0
+
+	// Our snapshots do not include pending htlcs or channels, and our
+	// simulated swaps shift liquidity as soon as they are dispatched, so
+	// there is no pending liquidity to count.
+	simMgr.params.IncludePending = false
+
+	// Our snapshots do not include forwarding history, so we have no
+	// routing revenue to gate our loop outs on.
+	simMgr.params.ROI = nil
+
+	result := &SimulationResult{}
+	for _, snapshot := range req.Snapshots {
+		sim.clock.SetTime(snapshot.Timestamp)
+		sim.settle(snapshot.Timestamp)
+		sim.setSnapshot(snapshot)
+
+		simMgr.refreshAutoloopBudget(ctx)
+
+		suggestions, err := simMgr.SuggestSwaps(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		step := SimulationStep{
+			Timestamp: snapshot.Timestamp,
+		}
+
+		for _, out := range suggestions.OutSwaps {
+			swap := sim.dispatchLoopOut(out)
+			result.Swaps = append(result.Swaps, swap)
+			result.TotalFees += swap.Fees
+			step.SwapsDispatched++
+		}
+
+		for _, in := range suggestions.InSwaps {
+			swap := sim.dispatchLoopIn(in)
+			result.Swaps = append(result.Swaps, swap)
+			result.TotalFees += swap.Fees
+			step.SwapsDispatched++
+		}
+
+		summary := simMgr.checkExistingAutoLoops(
+			ctx, sim.loopOuts, sim.loopIns,
+		)
+		step.SpentFees = summary.spentFees
+		step.PendingFees = summary.pendingFees
+
+		remaining := simMgr.params.AutoFeeBudget - summary.totalFees()
+		if remaining > 0 {
+			step.BudgetRemaining = remaining
+		}
+
+		result.Steps = append(result.Steps, step)
+	}
+
+	return result, nil
+}
+
+// simulator holds the state of a simulation as it replays our balance
+// snapshots.
+type simulator struct {
+	req *SimulationRequest
+
+	clock *clock.TestClock
+
+	// snapshot is the snapshot that we are currently replaying, with the
+	// liquidity shifted by our simulated swaps applied.
+	snapshot BalanceSnapshot
+
+	// deltas tracks the cumulative change in local balance per channel
+	// that our simulated swaps have caused.
+	deltas map[uint64]btcutil.Amount
+
+	loopOuts []*loopdb.LoopOut
+	loopIns  []*loopdb.LoopIn
+}
+
+// setSnapshot sets the snapshot that we are currently replaying, adjusting
+// its balances for the swaps that we have simulated so far.
+func (s *simulator) setSnapshot(snapshot BalanceSnapshot) {
+	channels := make([]lndclient.ChannelInfo, len(snapshot.Channels))
+	for i, channel := range snapshot.Channels {
+		delta := s.deltas[channel.ChannelID]
+
+		channel.LocalBalance = clampBalance(
+			channel.LocalBalance+delta, channel.Capacity,
+		)
+		channel.RemoteBalance = clampBalance(
+			channel.RemoteBalance-delta, channel.Capacity,
+		)
+
+		channels[i] = channel
+	}
+
+	s.snapshot = BalanceSnapshot{
+		Timestamp:    snapshot.Timestamp,
+		Channels:     channels,
+		SweepFeeRate: snapshot.SweepFeeRate,
+	}
+}
+
+// clampBalance limits a balance to the range [0, capacity].
+func clampBalance(amt, capacity btcutil.Amount) btcutil.Amount {
+	switch {
+	case amt < 0:
+		return 0
+
+	case amt > capacity:
+		return capacity
+
+	default:
+		return amt
+	}
+}
+
+// swapFee returns the swap fee that our simulated server quotes for an
+// amount.
+func (s *simulator) swapFee(amount btcutil.Amount) btcutil.Amount {
+	return ppmToSat(amount, s.req.SwapFeePPM)
+}
+
+// loopOutQuote returns a quote from our simulated server.
+func (s *simulator) loopOutQuote(_ context.Context,
+	req *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote, error) {
+
+	return &loop.LoopOutQuote{
+		SwapFee:      s.swapFee(req.Amount),
+		PrepayAmount: s.req.PrepayAmount,
+		MinerFee:     s.req.MinerFee,
+	}, nil
+}
+
+// loopInQuote returns a quote from our simulated server.
+func (s *simulator) loopInQuote(_ context.Context,
+	req *loop.LoopInQuoteRequest) (*loop.LoopInQuote, error) {
+
+	return &loop.LoopInQuote{
+		SwapFee:  s.swapFee(req.Amount),
+		MinerFee: s.req.MinerFee,
+	}, nil
+}
+
+// swapHash returns a unique hash for the next simulated swap.
+func (s *simulator) swapHash() [32]byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(len(s.loopOuts)+len(s.loopIns)))
+
+	return sha256.Sum256(b[:])
+}
+
+// pendingEvent returns the initial event for a simulated swap.
+func (s *simulator) pendingEvent() []*loopdb.LoopEvent {
+	return []*loopdb.LoopEvent{
+		{
+			SwapStateData: loopdb.SwapStateData{
+				State: loopdb.StateInitiated,
+			},
+			Time: s.clock.Now(),
+		},
+	}
+}
+
+// dispatchLoopOut records a suggested loop out as dispatched, and shifts
+// liquidity on its outgoing channels.
+func (s *simulator) dispatchLoopOut(out loop.OutRequest) SimulatedSwap {
+	contract := &loopdb.LoopOutContract{
+		SwapContract: loopdb.SwapContract{
+			AmountRequested: out.Amount,
+			MaxSwapFee:      out.MaxSwapFee,
+			MaxMinerFee:     out.MaxMinerFee,
+			InitiationTime:  s.clock.Now(),
+			Label:           labels.AutoloopLabel(swap.TypeOut),
+		},
+		MaxSwapRoutingFee:   out.MaxSwapRoutingFee,
+		SweepConfTarget:     out.SweepConfTarget,
+		OutgoingChanSet:     out.OutgoingChanSet,
+		MaxPrepayRoutingFee: out.MaxPrepayRoutingFee,
+	}
+
+	s.loopOuts = append(s.loopOuts, &loopdb.LoopOut{
+		Loop: loopdb.Loop{
+			Hash:   s.swapHash(),
+			Events: s.pendingEvent(),
+		},
+		Contract: contract,
+	})
+
+	// Move the swap amount from our local balance to the remote balance
+	// of the channels in our outgoing set.
+	chanSet := make(map[uint64]bool, len(out.OutgoingChanSet))
+	channels := make([]lnwire.ShortChannelID, len(out.OutgoingChanSet))
+	for i, id := range out.OutgoingChanSet {
+		chanSet[id] = true
+		channels[i] = lnwire.NewShortChanIDFromInt(id)
+	}
+
+	s.shift(out.Amount, false, func(channel lndclient.ChannelInfo) bool {
+		return chanSet[channel.ChannelID]
+	})
+
+	return SimulatedSwap{
+		Timestamp: s.clock.Now(),
+		Type:      swap.TypeOut,
+		Amount:    out.Amount,
+		Channels:  channels,
+		Fees:      s.swapFee(out.Amount) + s.req.MinerFee,
+		MaxFees: worstCaseOutFees(
+			out.MaxPrepayRoutingFee, out.MaxSwapRoutingFee,
+			out.MaxSwapFee, out.MaxMinerFee,
+		),
+	}
+}
+
+// dispatchLoopIn records a suggested loop in as dispatched, and shifts
+// liquidity on the channels with its last hop.
+func (s *simulator) dispatchLoopIn(in loop.LoopInRequest) SimulatedSwap {
+	contract := &loopdb.LoopInContract{
+		SwapContract: loopdb.SwapContract{
+			AmountRequested: in.Amount,
+			MaxSwapFee:      in.MaxSwapFee,
+			MaxMinerFee:     in.MaxMinerFee,
+			InitiationTime:  s.clock.Now(),
+			Label:           labels.AutoloopLabel(swap.TypeIn),
+		},
+		HtlcConfTarget: in.HtlcConfTarget,
+		LastHop:        in.LastHop,
+	}
+
+	s.loopIns = append(s.loopIns, &loopdb.LoopIn{
+		Loop: loopdb.Loop{
+			Hash:   s.swapHash(),
+			Events: s.pendingEvent(),
+		},
+		Contract: contract,
+	})
+
+	// Move the swap amount from the remote balance to our local balance
+	// of the channels that we have with the last hop.
+	s.shift(in.Amount, true, func(channel lndclient.ChannelInfo) bool {
+		return in.LastHop != nil && channel.PubKeyBytes == *in.LastHop
+	})
+
+	return SimulatedSwap{
+		Timestamp: s.clock.Now(),
+		Type:      swap.TypeIn,
+		Amount:    in.Amount,
+		LastHop:   in.LastHop,
+		Fees:      s.swapFee(in.Amount) + s.req.MinerFee,
+		MaxFees: worstCaseInFees(
+			in.MaxMinerFee, in.MaxSwapFee, defaultLoopInSweepFee,
+		),
+	}
+}
+
+// shift distributes a swap amount over the channels in our current snapshot
+// that match the filter provided, moving liquidity to our local balance if
+// toLocal is set, and away from it otherwise.
+func (s *simulator) shift(amount btcutil.Amount, toLocal bool,
+	filter func(lndclient.ChannelInfo) bool) {
+
+	remaining := amount
+	for i, channel := range s.snapshot.Channels {
+		if remaining == 0 {
+			return
+		}
+
+		if !filter(channel) {
+			continue
+		}
+
+		sign, available := btcutil.Amount(-1), channel.LocalBalance
+		if toLocal {
+			sign, available = 1, channel.RemoteBalance
+		}
+
+		shifted := remaining
+		if shifted > available {
+			shifted = available
+		}
+
+		remaining -= shifted
+		s.deltas[channel.ChannelID] += sign * shifted
+
+		s.snapshot.Channels[i].LocalBalance += sign * shifted
+		s.snapshot.Channels[i].RemoteBalance -= sign * shifted
+	}
+}
+
+// settle completes all of our in flight swaps at the time provided, charging
+// them their quoted fees.
+func (s *simulator) settle(now time.Time) {
+	for _, out := range s.loopOuts {
+		if out.State().State.Type() != loopdb.StateTypePending {
+			continue
+		}
+
+		out.Events = append(out.Events, &loopdb.LoopEvent{
+			SwapStateData: loopdb.SwapStateData{
+				State: loopdb.StateSuccess,
+				Cost: loopdb.SwapCost{
+					Server: s.swapFee(
+						out.Contract.AmountRequested,
+					),
+					Onchain: s.req.MinerFee,
+				},
+			},
+			Time: now,
+		})
+	}
+
+	for _, in := range s.loopIns {
+		if in.State().State.Type() != loopdb.StateTypePending {
+			continue
+		}
+
+		in.Events = append(in.Events, &loopdb.LoopEvent{
+			SwapStateData: loopdb.SwapStateData{
+				State: loopdb.StateSuccess,
+				Cost: loopdb.SwapCost{
+					Server: s.swapFee(
+						in.Contract.AmountRequested,
+					),
+					Onchain: s.req.MinerFee,
+				},
+			},
+			Time: now,
+		})
+	}
+}
+
+// simLightningClient provides our simulation's channels to the liquidity
+// manager. Calls that are not implemented will panic.
+type simLightningClient struct {
+	lndclient.LightningClient
+
+	sim *simulator
+}
+
+// ListChannels returns the channels in the snapshot being replayed.
+func (s *simLightningClient) ListChannels(_ context.Context, _, _ bool,
+	_ ...lndclient.ListChannelsOption) ([]lndclient.ChannelInfo, error) {
+
+	return s.sim.snapshot.Channels, nil
+}
+
+// ForwardingHistory returns no forwarding events, because our snapshots do
+// not include forwarding history.
+func (s *simLightningClient) ForwardingHistory(_ context.Context,
+	_ lndclient.ForwardingHistoryRequest) (
+	*lndclient.ForwardingHistoryResponse, error) {
+
+	return &lndclient.ForwardingHistoryResponse{}, nil
+}
+
+// simWalletKitClient provides our simulation's fee estimates to the liquidity
+// manager. Calls that are not implemented will panic.
+type simWalletKitClient struct {
+	lndclient.WalletKitClient
+
+	sim *simulator
+}
+
+// EstimateFeeRate returns the fee rate in the snapshot being replayed.
+func (s *simWalletKitClient) EstimateFeeRate(_ context.Context,
+	_ int32) (chainfee.SatPerKWeight, error) {
+
+	return s.sim.snapshot.SweepFeeRate, nil
+}
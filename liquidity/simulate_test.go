@@ -0,0 +1,159 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSimulate tests replaying our suggestion logic over a series of balance
+// snapshots.
+func TestSimulate(t *testing.T) {
+	var (
+		t1 = testTime.Add(time.Hour)
+		t2 = testTime.Add(time.Hour * 2)
+
+		// drained is a channel with no incoming liquidity, which
+		// requires a loop out for a 50% incoming threshold.
+		drained = lndclient.ChannelInfo{
+			ChannelID:     chanID1.ToUint64(),
+			PubKeyBytes:   peer1,
+			LocalBalance:  10000,
+			RemoteBalance: 0,
+			Capacity:      10000,
+		}
+
+		snapshot = func(ts time.Time) BalanceSnapshot {
+			return BalanceSnapshot{
+				Timestamp:    ts,
+				Channels:     []lndclient.ChannelInfo{drained},
+				SweepFeeRate: defaultSweepFeeRateLimit,
+			}
+		}
+
+		// With a 50% incoming threshold, we aim for the midpoint of
+		// 75% incoming liquidity.
+		amount = btcutil.Amount(7500)
+
+		// Our swap is charged a swap fee of 1000 ppm and our miner
+		// fee.
+		fees = btcutil.Amount(7 + 50)
+	)
+
+	cfg, _ := newTestConfig()
+	manager := NewManager(cfg)
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: {
+			ThresholdRule: NewThresholdRule(50, 0),
+			Type:          swap.TypeOut,
+		},
+	}
+
+	req := &SimulationRequest{
+		Parameters: params,
+		Snapshots: []BalanceSnapshot{
+			snapshot(testTime), snapshot(t1), snapshot(t2),
+		},
+		SwapFeePPM:   1000,
+		MinerFee:     50,
+		PrepayAmount: 100,
+		Restrictions: testRestrictions,
+	}
+
+	result, err := manager.Simulate(context.Background(), req)
+	require.NoError(t, err)
+
+	// We expect a single swap to be dispatched at our first snapshot.
+	// Our recorded balances do not reflect the swap, but the simulation
+	// shifts liquidity for it, so no further swaps are required.
+	require.Len(t, result.Swaps, 1)
+	dispatched := result.Swaps[0]
+	require.Equal(t, testTime, dispatched.Timestamp)
+	require.Equal(t, swap.TypeOut, dispatched.Type)
+	require.Equal(t, amount, dispatched.Amount)
+	require.Equal(t, []lnwire.ShortChannelID{chanID1}, dispatched.Channels)
+	require.Equal(t, fees, dispatched.Fees)
+	require.Equal(t, fees, result.TotalFees)
+
+	// While our swap is in flight, our budget is charged its worst case
+	// fees. Once it completes at the next snapshot, the fees it paid are
+	// counted.
+	require.Equal(t, []SimulationStep{
+		{
+			Timestamp:       testTime,
+			SwapsDispatched: 1,
+			PendingFees:     dispatched.MaxFees,
+			BudgetRemaining: defaultBudget - dispatched.MaxFees,
+		},
+		{
+			Timestamp:       t1,
+			SpentFees:       fees,
+			BudgetRemaining: defaultBudget - fees,
+		},
+		{
+			Timestamp:       t2,
+			SpentFees:       fees,
+			BudgetRemaining: defaultBudget - fees,
+		},
+	}, result.Steps)
+
+	// If our budget cannot cover the swap's worst case fees, no swaps are
+	// dispatched.
+	req.Parameters.AutoFeeBudget = dispatched.MaxFees - 1
+	result, err = manager.Simulate(context.Background(), req)
+	require.NoError(t, err)
+	require.Empty(t, result.Swaps)
+	require.Zero(t, result.TotalFees)
+}
+
+// TestSimulateInvalid tests the requests that we cannot simulate.
+func TestSimulateInvalid(t *testing.T) {
+	cfg, _ := newTestConfig()
+	manager := NewManager(cfg)
+
+	ctx := context.Background()
+
+	_, err := manager.Simulate(ctx, &SimulationRequest{
+		Parameters: defaultParameters,
+	})
+	require.ErrorIs(t, err, ErrNoSnapshots)
+
+	_, err = manager.Simulate(ctx, &SimulationRequest{
+		Parameters: defaultParameters,
+		Snapshots: []BalanceSnapshot{
+			{Timestamp: testTime},
+			{Timestamp: testTime.Add(time.Hour * -1)},
+		},
+	})
+	require.ErrorIs(t, err, errSnapshotOrder)
+
+	params := defaultParameters
+	params.EasyAutoloop = true
+	_, err = manager.Simulate(ctx, &SimulationRequest{
+		Parameters: params,
+		Snapshots:  []BalanceSnapshot{{Timestamp: testTime}},
+	})
+	require.ErrorIs(t, err, errEasyAutoloopSimulation)
+
+	params = defaultParameters
+	params.AssetRules = map[string]*AssetRules{
+		testAssetIDStr: {
+			ChannelRules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			},
+		},
+	}
+	_, err = manager.Simulate(ctx, &SimulationRequest{
+		Parameters: params,
+		Snapshots:  []BalanceSnapshot{{Timestamp: testTime}},
+	})
+	require.ErrorIs(t, err, errAssetRulesSimulation)
+}
@@ -0,0 +1,302 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// minStaticDepositExpiry is the minimum number of blocks that a
+	// deposit must have left before its timeout path opens for us to use
+	// it in a loop in. The htlc of a static address loop in may expire up
+	// to MaxLoopInAcceptDelta blocks after the swap is initiated, so we
+	// require our deposits to remain locked to the static address for at
+	// least that long.
+	minStaticDepositExpiry = int64(loop.MaxLoopInAcceptDelta)
+
+	// ErrNoStaticLoopIn is returned when our parameters require loop ins
+	// to be funded by static address deposits, but static address loop
+	// ins are not available.
+	ErrNoStaticLoopIn = errors.New("static address loop ins not " +
+		"available")
+)
+
+// StaticDeposit describes a static address deposit that may be used to fund
+// an automated loop in.
+type StaticDeposit struct {
+	// OutPoint is the outpoint of the deposit.
+	OutPoint wire.OutPoint
+
+	// Value is the amount of the deposit.
+	Value btcutil.Amount
+
+	// BlocksUntilExpiry is the number of blocks left until the deposit's
+	// timeout path opens.
+	BlocksUntilExpiry int64
+}
+
+// StaticLoopInSwap describes a static address loop in swap that has already
+// been dispatched, so that it can be accounted for in our autoloop budget and
+// swap traffic.
+type StaticLoopInSwap struct {
+	// Hash is the hash of the swap.
+	Hash lntypes.Hash
+
+	// LastHop is the peer that the swap payment is restricted to, if any.
+	LastHop *route.Vertex
+
+	// Label is the label of the swap.
+	Label string
+
+	// Initiator is the identification string that the swap was
+	// initiated with.
+	Initiator string
+
+	// MaxSwapFee is the maximum swap fee that we accepted for the swap.
+	MaxSwapFee btcutil.Amount
+
+	// SwapFee is the swap fee that we paid, set for successful swaps.
+	SwapFee btcutil.Amount
+
+	// InitiationTime is the time that the swap was initiated.
+	InitiationTime time.Time
+
+	// State is the regular loop in state that the static loop in's state
+	// corresponds to.
+	State loopdb.SwapState
+}
+
+// isAutoloop returns a boolean indicating whether the swap was dispatched by
+// autoloop, which is the case if it has our autoloop label or was initiated
+// by autoloop.
+func (s *StaticLoopInSwap) isAutoloop() bool {
+	if isAutoloopLabel(s.Label) {
+		return true
+	}
+
+	switch s.Initiator {
+	case autoloopSwapInitiator, easyAutoloopSwapInitiator:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// loopIn returns a loop in swap that represents the static loop in, so that
+// it can be accounted for along with our regular loop ins. Static loop ins
+// that were initiated by autoloop are labelled as autoloop swaps.
+func (s *StaticLoopInSwap) loopIn() *loopdb.LoopIn {
+	label := s.Label
+	if s.isAutoloop() {
+		label = labels.AutoloopLabel(swap.TypeIn)
+	}
+
+	return &loopdb.LoopIn{
+		Loop: loopdb.Loop{
+			Hash: s.Hash,
+			Events: []*loopdb.LoopEvent{
+				{
+					SwapStateData: loopdb.SwapStateData{
+						State: s.State,
+						Cost: loopdb.SwapCost{
+							Server: s.SwapFee,
+						},
+					},
+					Time: s.InitiationTime,
+				},
+			},
+		},
+		Contract: &loopdb.LoopInContract{
+			SwapContract: loopdb.SwapContract{
+				MaxSwapFee:     s.MaxSwapFee,
+				InitiationTime: s.InitiationTime,
+				Label:          label,
+			},
+			LastHop: s.LastHop,
+		},
+	}
+}
+
+// listLoopIns returns our loop in swaps along with our static address loop
+// ins, if static loop ins are available.
+func (m *Manager) listLoopIns(ctx context.Context) ([]*loopdb.LoopIn,
+	error) {
+
+	loopIns, err := m.cfg.ListLoopIn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.cfg.ListStaticLoopIns == nil {
+		return loopIns, nil
+	}
+
+	staticLoopIns, err := m.cfg.ListStaticLoopIns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, staticLoopIn := range staticLoopIns {
+		loopIns = append(loopIns, staticLoopIn.loopIn())
+	}
+
+	return loopIns, nil
+}
+
+// StaticLoopInRequest is a loop in swap that is funded by static address
+// deposits.
+type StaticLoopInRequest struct {
+	loop.StaticAddressLoopInRequest
+
+	// Amount is the total value of the deposits that fund the swap.
+	Amount btcutil.Amount
+}
+
+// Compile time assertion that static loop in suggestions satisfy our
+// interface.
+var _ swapSuggestion = (*staticLoopInSwapSuggestion)(nil)
+
+type staticLoopInSwapSuggestion struct {
+	StaticLoopInRequest
+}
+
+// amount returns the amount of the swap suggestion.
+func (s *staticLoopInSwapSuggestion) amount() btcutil.Amount {
+	return s.StaticLoopInRequest.Amount
+}
+
+// fees returns the highest fees that we could pay for the swap suggestion. The
+// server publishes the htlc of a static address loop in, so we do not pay any
+// miner fees unless we need to sweep the htlc's timeout path.
+func (s *staticLoopInSwapSuggestion) fees() btcutil.Amount {
+	return worstCaseInFees(
+		0, s.StaticLoopInRequest.MaxSwapFee, defaultLoopInSweepFee,
+	)
+}
+
+// channels returns no channels for static loop in swap suggestions because we
+// do not restrict loop in swaps by channel id.
+func (s *staticLoopInSwapSuggestion) channels() []lnwire.ShortChannelID {
+	return nil
+}
+
+// peers returns the peer that a static loop in swap is restricted to, if it
+// is set.
+func (s *staticLoopInSwapSuggestion) peers(
+	_ map[uint64]route.Vertex) []route.Vertex {
+
+	if s.StaticLoopInRequest.LastHop == nil {
+		return nil
+	}
+
+	return []route.Vertex{
+		*s.StaticLoopInRequest.LastHop,
+	}
+}
+
+// depositPool tracks the static address deposits that are available to fund
+// the loop ins suggested by a single autoloop run, so that each deposit is
+// only used once.
+type depositPool struct {
+	deposits []*StaticDeposit
+}
+
+// newDepositPool creates a pool from the deposits provided, excluding any
+// deposits that are too close to expiry. Deposits are ordered by the number of
+// blocks until they expire and then by descending value, so that we use the
+// deposits that are closest to expiry first.
+func newDepositPool(deposits []*StaticDeposit) *depositPool {
+	pool := &depositPool{}
+	for _, deposit := range deposits {
+		if deposit.BlocksUntilExpiry < minStaticDepositExpiry {
+			log.Debugf("Static deposit %v not used for autoloop, "+
+				"expires in %v blocks", deposit.OutPoint,
+				deposit.BlocksUntilExpiry)
+
+			continue
+		}
+
+		pool.deposits = append(pool.deposits, deposit)
+	}
+
+	sort.SliceStable(pool.deposits, func(i, j int) bool {
+		a, b := pool.deposits[i], pool.deposits[j]
+		if a.BlocksUntilExpiry != b.BlocksUntilExpiry {
+			return a.BlocksUntilExpiry < b.BlocksUntilExpiry
+		}
+
+		return a.Value > b.Value
+	})
+
+	return pool
+}
+
+// selectDeposits selects the deposits that fund a loop in of at most the
+// amount provided, returning the deposits and their total value. Deposits are
+// looped in whole, so the total may be less than the amount.
+func (p *depositPool) selectDeposits(amount btcutil.Amount) ([]*StaticDeposit,
+	btcutil.Amount) {
+
+	var (
+		selected []*StaticDeposit
+		total    btcutil.Amount
+	)
+
+	for _, deposit := range p.deposits {
+		if total+deposit.Value > amount {
+			continue
+		}
+
+		selected = append(selected, deposit)
+		total += deposit.Value
+	}
+
+	return selected, total
+}
+
+// remove removes deposits that have been used from the pool.
+func (p *depositPool) remove(used []*StaticDeposit) {
+	isUsed := make(map[wire.OutPoint]bool, len(used))
+	for _, deposit := range used {
+		isUsed[deposit.OutPoint] = true
+	}
+
+	remaining := p.deposits[:0]
+	for _, deposit := range p.deposits {
+		if isUsed[deposit.OutPoint] {
+			continue
+		}
+
+		remaining = append(remaining, deposit)
+	}
+
+	p.deposits = remaining
+}
+
+// staticDeposits returns a pool of the static address deposits that we can
+// use to fund loop ins.
+func (m *Manager) staticDeposits(ctx context.Context) (*depositPool, error) {
+	if m.cfg.ListStaticDeposits == nil || m.cfg.StaticLoopIn == nil {
+		return nil, ErrNoStaticLoopIn
+	}
+
+	deposits, err := m.cfg.ListStaticDeposits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDepositPool(deposits), nil
+}
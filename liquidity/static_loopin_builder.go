@@ -0,0 +1,97 @@
+package liquidity
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// Compile-time assertion that staticLoopInBuilder satisfies the swapBuilder
+// interface.
+var _ swapBuilder = (*staticLoopInBuilder)(nil)
+
+func newStaticLoopInBuilder(cfg *Config, deposits *depositPool,
+	restrictions *Restrictions) *staticLoopInBuilder {
+
+	return &staticLoopInBuilder{
+		loopInBuilder: newLoopInBuilder(cfg),
+		deposits:      deposits,
+		restrictions:  restrictions,
+	}
+}
+
+// staticLoopInBuilder builds loop in swaps that are funded by static address
+// deposits. It shares the fee and in-use checks of our regular loop in
+// builder.
+type staticLoopInBuilder struct {
+	*loopInBuilder
+
+	// deposits is the pool of deposits that are available to fund our
+	// swaps.
+	deposits *depositPool
+
+	// restrictions are the restrictions that apply to loop in swaps.
+	restrictions *Restrictions
+}
+
+// buildSwap creates a swap for the target peer/channels provided, funded by
+// deposits from our pool. The deposits that are used are removed from the
+// pool so that they are not suggested for another swap.
+//
+// For static loop in, we do not add the autoloop label for dry runs.
+func (b *staticLoopInBuilder) buildSwap(ctx context.Context,
+	pubkey route.Vertex, _ []lnwire.ShortChannelID, amount btcutil.Amount,
+	params Parameters, _ ...buildSwapOption) (swapSuggestion, error) {
+
+	// Deposits cannot be split, so we pick the set of deposits that fits
+	// within our amount and check that it still meets the minimum swap
+	// size.
+	deposits, total := b.deposits.selectDeposits(amount)
+	if len(deposits) == 0 || total < b.restrictions.Minimum {
+		return nil, newReasonError(ReasonNoStaticDeposits)
+	}
+
+	quote, err := b.cfg.LoopInQuote(ctx, &loop.LoopInQuoteRequest{
+		Amount:      total,
+		LastHop:     &pubkey,
+		Initiator:   getInitiator(params),
+		NumDeposits: uint32(len(deposits)),
+	})
+	if err != nil {
+		return nil, loopInQuoteError(err)
+	}
+
+	if err := params.FeeLimit.loopInLimits(total, quote); err != nil {
+		return nil, err
+	}
+
+	outpoints := make([]string, len(deposits))
+	for i, deposit := range deposits {
+		outpoints[i] = deposit.OutPoint.String()
+	}
+
+	request := StaticLoopInRequest{
+		StaticAddressLoopInRequest: loop.StaticAddressLoopInRequest{
+			DepositOutpoints: outpoints,
+			MaxSwapFee:       quote.SwapFee,
+			LastHop:          &pubkey,
+			Initiator:        getInitiator(params),
+		},
+		Amount: total,
+	}
+
+	if params.Autoloop {
+		request.Label = labels.AutoloopLabel(swap.TypeIn)
+	}
+
+	b.deposits.remove(deposits)
+
+	return &staticLoopInSwapSuggestion{
+		StaticLoopInRequest: request,
+	}, nil
+}
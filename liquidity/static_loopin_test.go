@@ -0,0 +1,291 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// staticDeposit1 is the static deposit that is closest to expiry in
+	// our tests.
+	staticDeposit1 = &StaticDeposit{
+		OutPoint:          wire.OutPoint{Index: 1},
+		Value:             5000,
+		BlocksUntilExpiry: 2000,
+	}
+
+	// staticDeposit2 expires at the same height as staticDeposit3, but has
+	// a larger value.
+	staticDeposit2 = &StaticDeposit{
+		OutPoint:          wire.OutPoint{Index: 2},
+		Value:             4000,
+		BlocksUntilExpiry: 3000,
+	}
+
+	staticDeposit3 = &StaticDeposit{
+		OutPoint:          wire.OutPoint{Index: 3},
+		Value:             3000,
+		BlocksUntilExpiry: 3000,
+	}
+
+	// staticDepositExpiring is too close to expiry to be used for
+	// autoloop.
+	staticDepositExpiring = &StaticDeposit{
+		OutPoint:          wire.OutPoint{Index: 4},
+		Value:             2000,
+		BlocksUntilExpiry: minStaticDepositExpiry - 1,
+	}
+)
+
+// TestDepositPool tests ordering and selection of static address deposits.
+func TestDepositPool(t *testing.T) {
+	pool := newDepositPool([]*StaticDeposit{
+		staticDeposit3, staticDepositExpiring, staticDeposit2,
+		staticDeposit1,
+	})
+
+	// Our deposits are ordered by expiry and then by value, excluding the
+	// deposit that is about to expire.
+	require.Equal(t, []*StaticDeposit{
+		staticDeposit1, staticDeposit2, staticDeposit3,
+	}, pool.deposits)
+
+	// Deposits can't be split, so we skip over deposits that would take
+	// us over our amount.
+	selected, total := pool.selectDeposits(8500)
+	require.Equal(t, []*StaticDeposit{
+		staticDeposit1, staticDeposit3,
+	}, selected)
+	require.Equal(t, btcutil.Amount(8000), total)
+
+	// Once deposits are removed, they are no longer selected.
+	pool.remove(selected)
+	selected, total = pool.selectDeposits(8500)
+	require.Equal(t, []*StaticDeposit{staticDeposit2}, selected)
+	require.Equal(t, btcutil.Amount(4000), total)
+
+	// If all of our deposits are larger than our amount, we select
+	// nothing.
+	selected, total = pool.selectDeposits(1000)
+	require.Empty(t, selected)
+	require.Zero(t, total)
+}
+
+// TestSuggestStaticLoopIn tests suggesting loop ins funded by static address
+// deposits, where our deposits are shared between multiple targets.
+func TestSuggestStaticLoopIn(t *testing.T) {
+	cfg, lnd := newTestConfig()
+
+	// Each of our peers has a single channel with all of its balance on
+	// the remote side, and requires a loop in of 7500 to meet its rule.
+	peer3 := route.Vertex{3}
+
+	var channels []lndclient.ChannelInfo
+	for i, peer := range []route.Vertex{peer1, peer2, peer3} {
+		channels = append(channels, lndclient.ChannelInfo{
+			ChannelID:     uint64(i + 1),
+			PubKeyBytes:   peer,
+			RemoteBalance: 10000,
+			Capacity:      10000,
+		})
+	}
+	lnd.Channels = channels
+
+	quote := &loop.LoopInQuote{
+		SwapFee: 5,
+	}
+
+	cfg.LoopInQuote = func(_ context.Context,
+		req *loop.LoopInQuoteRequest) (*loop.LoopInQuote, error) {
+
+		require.NotZero(t, req.NumDeposits)
+
+		return quote, nil
+	}
+
+	cfg.ListStaticDeposits = func(context.Context) ([]*StaticDeposit,
+		error) {
+
+		return []*StaticDeposit{
+			staticDeposit1, staticDeposit2, staticDeposit3,
+			staticDepositExpiring,
+		}, nil
+	}
+
+	cfg.StaticLoopIn = func(context.Context,
+		*loop.StaticAddressLoopInRequest) (lntypes.Hash, error) {
+
+		return lntypes.Hash{}, nil
+	}
+
+	rule := &SwapRule{
+		AmountRule: NewAmountRule(0, 5000),
+		Type:       swap.TypeIn,
+	}
+
+	params := defaultParameters
+	params.AutoloopBudgetLastRefresh = testBudgetStart
+	params.StaticLoopIn = true
+
+	// Our test swaps are small, so we allow a large portion of the swap
+	// amount to cover our worst case sweep fees.
+	params.FeeLimit = NewFeePortion(200000)
+
+	params.PeerRules = map[route.Vertex]*SwapRule{
+		peer1: rule,
+		peer2: rule,
+		peer3: rule,
+	}
+
+	staticLoopIn := func(peer route.Vertex, amount btcutil.Amount,
+		deposits ...*StaticDeposit) StaticLoopInRequest {
+
+		outpoints := make([]string, len(deposits))
+		for i, deposit := range deposits {
+			outpoints[i] = deposit.OutPoint.String()
+		}
+
+		return StaticLoopInRequest{
+			StaticAddressLoopInRequest: loop.StaticAddressLoopInRequest{
+				DepositOutpoints: outpoints,
+				MaxSwapFee:       quote.SwapFee,
+				LastHop:          &peer,
+				Initiator:        autoloopSwapInitiator,
+			},
+			Amount: amount,
+		}
+	}
+
+	// Our first peer is funded with the deposit that is closest to
+	// expiry, our second peer uses the remaining deposits and our third
+	// peer has no deposits left. Our suggestions are sorted by descending
+	// amount.
+	expected := &Suggestions{
+		StaticInSwaps: []StaticLoopInRequest{
+			staticLoopIn(
+				peer2, 7000, staticDeposit2, staticDeposit3,
+			),
+			staticLoopIn(peer1, 5000, staticDeposit1),
+		},
+		DisqualifiedChans: noneDisqualified,
+		DisqualifiedPeers: map[route.Vertex]Reason{
+			peer3: ReasonNoStaticDeposits,
+		},
+	}
+
+	testSuggestSwaps(
+		t, newSuggestSwapsSetup(cfg, lnd, params), expected, nil,
+	)
+}
+
+// TestStaticLoopInAccounting tests that static address loop ins that were
+// dispatched by autoloop count towards our in flight limit and budget.
+func TestStaticLoopInAccounting(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxInFlight   int
+		staticLoopIns []*StaticLoopInSwap
+		suggestions   *Suggestions
+	}{
+		{
+			name:        "pending autoloop uses in flight slot",
+			maxInFlight: 1,
+			staticLoopIns: []*StaticLoopInSwap{
+				{
+					Initiator:      autoloopSwapInitiator,
+					InitiationTime: testBudgetStart,
+					State:          loopdb.StateInitiated,
+				},
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonInFlight,
+					chanID2: ReasonInFlight,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:        "pending autoloop uses budget",
+			maxInFlight: 3,
+			staticLoopIns: []*StaticLoopInSwap{
+				{
+					Label: labels.AutoloopLabel(
+						swap.TypeIn,
+					),
+					MaxSwapFee:     defaultBudget * 2,
+					InitiationTime: testBudgetStart,
+					State:          loopdb.StateInitiated,
+				},
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonBudgetElapsed,
+					chanID2: ReasonBudgetElapsed,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:        "manual loop in not counted",
+			maxInFlight: 2,
+			staticLoopIns: []*StaticLoopInSwap{
+				{
+					Initiator:      "loop-cli",
+					MaxSwapFee:     defaultBudget * 2,
+					InitiationTime: testBudgetStart,
+					State:          loopdb.StateInitiated,
+				},
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			cfg.ListStaticLoopIns = func(context.Context) (
+				[]*StaticLoopInSwap, error) {
+
+				return testCase.staticLoopIns, nil
+			}
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			params := defaultParameters
+			params.AutoloopBudgetLastRefresh = testBudgetStart
+			params.ChannelRules =
+				map[lnwire.ShortChannelID]*SwapRule{
+					chanID1: chanRule,
+					chanID2: chanRule,
+				}
+			params.MaxAutoInFlight = testCase.maxInFlight
+			params.AutoFeeBudget = defaultBudget * 2
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
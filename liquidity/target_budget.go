@@ -0,0 +1,257 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// errNegativeTargetBudget is returned when a target budget has a
+	// negative amount.
+	errNegativeTargetBudget = errors.New("target budget must be >= 0")
+
+	// errZeroTargetBudgetRefresh is returned when a target budget does not
+	// have a refresh period set.
+	errZeroTargetBudgetRefresh = errors.New("target budget refresh " +
+		"period must be > 0")
+)
+
+// TargetBudget is a fee budget for automatically dispatched swaps that is
+// specific to a single channel or peer. It is applied in addition to our
+// global autoloop budget, so that a single target cannot consume the full
+// global budget.
+type TargetBudget struct {
+	// Amount is the total amount of fees that automatically dispatched
+	// swaps for the target may consume in a budget period.
+	Amount btcutil.Amount
+
+	// RefreshPeriod is the amount of time that must pass before the
+	// target's budget is refreshed.
+	RefreshPeriod time.Duration
+
+	// LastRefresh is the last time at which the target's budget was
+	// refreshed.
+	LastRefresh time.Time
+}
+
+// String returns a string representation of a target budget.
+func (b *TargetBudget) String() string {
+	return fmt.Sprintf("budget: %v every %v (last refresh: %v)", b.Amount,
+		b.RefreshPeriod, b.LastRefresh)
+}
+
+// validate checks that a target budget is valid.
+func (b *TargetBudget) validate() error {
+	if b.Amount < 0 {
+		return errNegativeTargetBudget
+	}
+
+	if b.RefreshPeriod <= 0 {
+		return errZeroTargetBudgetRefresh
+	}
+
+	return nil
+}
+
+// refresh starts a new budget period if the refresh period has passed since
+// our last refresh, returning a boolean indicating whether we refreshed.
+func (b *TargetBudget) refresh(now time.Time) bool {
+	if now.Sub(b.LastRefresh) <= b.RefreshPeriod {
+		return false
+	}
+
+	b.LastRefresh = now
+
+	return true
+}
+
+// autoloopFees records the fees of a single automatically dispatched swap,
+// along with the targets that it was dispatched for.
+type autoloopFees struct {
+	// channels is the set of outgoing channels used by a loop out.
+	channels []lnwire.ShortChannelID
+
+	// peer is the last hop peer of a loop in, if set.
+	peer *route.Vertex
+
+	// fees is the worst-case fee amount for pending swaps, and the actual
+	// fees paid for completed swaps.
+	fees btcutil.Amount
+
+	// pending indicates whether the swap is still in flight.
+	pending bool
+
+	// lastUpdate is the time of the swap's last update.
+	lastUpdate time.Time
+}
+
+// usesTarget returns a boolean indicating whether the swap was dispatched for
+// a target with the swap type, peer and channels provided. Loop in swaps are
+// matched by their last hop peer, and loop out swaps by their outgoing
+// channels.
+func (a *autoloopFees) usesTarget(swapType swap.Type, peer route.Vertex,
+	channels []lnwire.ShortChannelID) bool {
+
+	if swapType == swap.TypeIn {
+		return a.peer != nil && *a.peer == peer
+	}
+
+	for _, swapChan := range a.channels {
+		for _, channel := range channels {
+			if swapChan == channel {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// targetFees returns the total fees that count towards the budget of a rule
+// for a target with the peer and set of channels provided. Pending swaps are
+// always included, and completed swaps are included if they completed after
+// the budget's last refresh.
+func (e *existingAutoLoopSummary) targetFees(rule *SwapRule,
+	peer route.Vertex, channels []lnwire.ShortChannelID) btcutil.Amount {
+
+	var total btcutil.Amount
+	for _, fees := range e.swapFees {
+		if !fees.usesTarget(rule.Type, peer, channels) {
+			continue
+		}
+
+		inBudget := fees.lastUpdate.After(rule.Budget.LastRefresh)
+		if fees.pending || inBudget {
+			total += fees.fees
+		}
+	}
+
+	return total
+}
+
+// targetBudgetRemaining returns the amount of a rule's budget that is still
+// available for the target with the peer and channels provided.
+func (e *existingAutoLoopSummary) targetBudgetRemaining(rule *SwapRule,
+	peer route.Vertex, channels []lnwire.ShortChannelID) btcutil.Amount {
+
+	remaining := rule.Budget.Amount - e.targetFees(rule, peer, channels)
+	if remaining < 0 {
+		return 0
+	}
+
+	return remaining
+}
+
+// checkTargetBudget checks whether a suggested swap for a rule fits within
+// the rule's own budget, if it has one set.
+func checkTargetBudget(summary *existingAutoLoopSummary, rule *SwapRule,
+	balance *balances, suggestion swapSuggestion) error {
+
+	if rule.Budget == nil {
+		return nil
+	}
+
+	remaining := summary.targetBudgetRemaining(
+		rule, balance.pubkey, balance.channels,
+	)
+	if suggestion.fees() > remaining {
+		log.Debugf("Target: %v %v budget: %v insufficient for swap "+
+			"fees: %v, remaining: %v", balance.pubkey,
+			balance.channels, rule.Budget.Amount,
+			suggestion.fees(), remaining)
+
+		return newReasonError(ReasonTargetBudget)
+	}
+
+	return nil
+}
+
+// refreshTargetBudgets refreshes the budgets of our rules that have passed
+// their refresh period, returning a boolean indicating whether any budgets
+// were refreshed.
+func (p Parameters) refreshTargetBudgets(now time.Time) bool {
+	var refreshed bool
+
+	for _, rule := range p.ChannelRules {
+		if rule.Budget != nil && rule.Budget.refresh(now) {
+			refreshed = true
+		}
+	}
+
+	for _, rule := range p.PeerRules {
+		if rule.Budget != nil && rule.Budget.refresh(now) {
+			refreshed = true
+		}
+	}
+
+	return refreshed
+}
+
+// TargetBudgets returns the remaining budget for each of our channel and peer
+// rules that have a budget set.
+func (m *Manager) TargetBudgets(ctx context.Context) (
+	map[lnwire.ShortChannelID]btcutil.Amount,
+	map[route.Vertex]btcutil.Amount, error) {
+
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	loopOut, err := m.cfg.ListLoopOut(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loopIn, err := m.listLoopIns(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	channels, err := m.cfg.Lnd.Client.ListChannels(ctx, false, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	peerChannels := make(map[route.Vertex][]lnwire.ShortChannelID)
+	for _, channel := range channels {
+		peerChannels[channel.PubKeyBytes] = append(
+			peerChannels[channel.PubKeyBytes],
+			lnwire.NewShortChanIDFromInt(channel.ChannelID),
+		)
+	}
+
+	summary := m.checkExistingAutoLoops(ctx, loopOut, loopIn)
+
+	var (
+		chanBudgets = make(map[lnwire.ShortChannelID]btcutil.Amount)
+		peerBudgets = make(map[route.Vertex]btcutil.Amount)
+	)
+
+	for channel, rule := range m.params.ChannelRules {
+		if rule.Budget == nil {
+			continue
+		}
+
+		chanBudgets[channel] = summary.targetBudgetRemaining(
+			rule, route.Vertex{}, []lnwire.ShortChannelID{channel},
+		)
+	}
+
+	for peer, rule := range m.params.PeerRules {
+		if rule.Budget == nil {
+			continue
+		}
+
+		peerBudgets[peer] = summary.targetBudgetRemaining(
+			rule, peer, peerChannels[peer],
+		)
+	}
+
+	return chanBudgets, peerBudgets, nil
+}
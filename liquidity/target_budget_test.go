@@ -0,0 +1,59 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRefreshTargetBudgets tests refreshing of the budgets set on our rules
+// once their refresh period has passed.
+func TestRefreshTargetBudgets(t *testing.T) {
+	var (
+		expired = &TargetBudget{
+			Amount:        100,
+			RefreshPeriod: time.Hour,
+			LastRefresh:   testTime.Add(time.Hour * -2),
+		}
+
+		current = &TargetBudget{
+			Amount:        100,
+			RefreshPeriod: time.Hour,
+			LastRefresh:   testTime.Add(time.Minute * -30),
+		}
+
+		currentRefresh = current.LastRefresh
+	)
+
+	params := Parameters{
+		ChannelRules: map[lnwire.ShortChannelID]*SwapRule{
+			chanID1: {
+				ThresholdRule: NewThresholdRule(10, 10),
+				Type:          swap.TypeOut,
+				Budget:        expired,
+			},
+			chanID2: {
+				ThresholdRule: NewThresholdRule(10, 10),
+				Type:          swap.TypeOut,
+			},
+		},
+		PeerRules: map[route.Vertex]*SwapRule{
+			peer1: {
+				ThresholdRule: NewThresholdRule(10, 10),
+				Type:          swap.TypeIn,
+				Budget:        current,
+			},
+		},
+	}
+
+	require.True(t, params.refreshTargetBudgets(testTime))
+	require.Equal(t, testTime, expired.LastRefresh)
+	require.Equal(t, currentRefresh, current.LastRefresh)
+
+	// Refreshing again at the same time should not have any effect.
+	require.False(t, params.refreshTargetBudgets(testTime))
+}
@@ -20,10 +20,116 @@ var (
 		"percentages must be < 100")
 )
 
-// SwapRule is a liquidity rule with a specific swap type.
+// SwapRule is a liquidity rule with a specific swap type. Exactly one of
+// ThresholdRule and AmountRule must be set.
 type SwapRule struct {
 	*ThresholdRule
+
+	// AmountRule is set for rules that express liquidity targets as
+	// absolute amounts rather than percentages of capacity.
+	AmountRule *AmountRule
+
 	swap.Type
+
+	// Opportunistic is an optional band above the rule's threshold within
+	// which we swap early when on-chain fees are low.
+	Opportunistic *OpportunisticBand
+
+	// Budget is an optional fee budget for automatically dispatched swaps
+	// for the rule's target, applied in addition to our global budget.
+	Budget *TargetBudget
+
+	// Predictive is optionally set for threshold rules that should also
+	// swap when forwarding flows project that the rule's threshold will
+	// be crossed.
+	Predictive *PredictiveRule
+}
+
+// validate validates a swap rule.
+func (r *SwapRule) validate() error {
+	switch {
+	case r.ThresholdRule != nil && r.AmountRule != nil:
+		return errMultipleRuleTypes
+
+	case r.AmountRule != nil:
+		if err := r.AmountRule.validate(); err != nil {
+			return err
+		}
+
+		if r.Opportunistic != nil {
+			return errOpportunisticAmountRule
+		}
+
+	case r.ThresholdRule != nil:
+		if err := r.ThresholdRule.validate(); err != nil {
+			return err
+		}
+
+	default:
+		return errNoRuleType
+	}
+
+	if r.Budget != nil {
+		if err := r.Budget.validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.Predictive != nil {
+		if r.ThresholdRule == nil {
+			return errPredictiveThreshold
+		}
+
+		if err := r.Predictive.validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.Opportunistic == nil {
+		return nil
+	}
+
+	if r.Type != swap.TypeOut {
+		return errOpportunisticLoopIn
+	}
+
+	return r.Opportunistic.validate(r.ThresholdRule)
+}
+
+// String returns a string representation of a swap rule.
+func (r *SwapRule) String() string {
+	var str string
+	if r.AmountRule != nil {
+		str = r.AmountRule.String()
+	} else {
+		str = r.ThresholdRule.String()
+	}
+
+	if r.Predictive != nil {
+		str = fmt.Sprintf("%v, %v", str, r.Predictive)
+	}
+
+	if r.Opportunistic != nil {
+		str = fmt.Sprintf("%v, %v", str, r.Opportunistic)
+	}
+
+	if r.Budget != nil {
+		str = fmt.Sprintf("%v, %v", str, r.Budget)
+	}
+
+	return str
+}
+
+// swapAmount suggests a swap based on the rule's liquidity targets, returning
+// zero if no swap is recommended.
+func (r *SwapRule) swapAmount(channel *balances,
+	restrictions *Restrictions, swapType swap.Type) btcutil.Amount {
+
+	if r.AmountRule != nil {
+		return r.AmountRule.swapAmount(channel, restrictions, swapType)
+	}
+
+	return r.ThresholdRule.swapAmount(channel, restrictions, swapType)
 }
 
 // ThresholdRule is a liquidity rule that implements minimum incoming and
@@ -110,7 +216,14 @@ func (r *ThresholdRule) swapAmount(channel *balances,
 		targetPercentage, reservePercentage,
 	)
 
-	// Limit our swap amount by the minimum/maximum thresholds set.
+	return limitSwapAmount(amount, restrictions)
+}
+
+// limitSwapAmount limits a swap amount to the minimum/maximum restrictions
+// provided, returning zero if the amount is below the minimum.
+func limitSwapAmount(amount btcutil.Amount,
+	restrictions *Restrictions) btcutil.Amount {
+
 	switch {
 	case amount < restrictions.Minimum:
 		return 0
@@ -143,6 +256,17 @@ func calculateSwapAmount(targetAmount, reserveAmount,
 		uint64(capacity) * reserveThresholdPercentage / 100,
 	)
 
+	return calculateSwapAmountSat(
+		targetAmount, reserveAmount, capacity, targetGoal,
+		reserveMinimum,
+	)
+}
+
+// calculateSwapAmountSat calculates the amount for a swap based on a target
+// goal and reserve minimum that are expressed in satoshis.
+func calculateSwapAmountSat(targetAmount, reserveAmount, capacity,
+	targetGoal, reserveMinimum btcutil.Amount) btcutil.Amount {
+
 	switch {
 	// If we have sufficient target capacity, we do not need to swap.
 	case targetAmount >= targetGoal:
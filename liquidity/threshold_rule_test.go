@@ -2,6 +2,7 @@ package liquidity
 
 import (
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/loop/swap"
@@ -92,6 +93,137 @@ func TestValidateThreshold(t *testing.T) {
 	}
 }
 
+// TestValidateSwapRule tests validation of swap rules with an opportunistic
+// band set.
+func TestValidateSwapRule(t *testing.T) {
+	tests := []struct {
+		name string
+		rule SwapRule
+		err  error
+	}{
+		{
+			name: "no band",
+			rule: SwapRule{
+				ThresholdRule: NewThresholdRule(20, 20),
+				Type:          swap.TypeOut,
+			},
+		},
+		{
+			name: "band ok",
+			rule: SwapRule{
+				ThresholdRule: NewThresholdRule(20, 20),
+				Type:          swap.TypeOut,
+				Opportunistic: &OpportunisticBand{
+					Percent:    10,
+					MaxFeeRate: 253,
+				},
+			},
+		},
+		{
+			name: "band on loop in",
+			rule: SwapRule{
+				ThresholdRule: NewThresholdRule(20, 20),
+				Type:          swap.TypeIn,
+				Opportunistic: &OpportunisticBand{
+					Percent:    10,
+					MaxFeeRate: 253,
+				},
+			},
+			err: errOpportunisticLoopIn,
+		},
+		{
+			name: "zero band",
+			rule: SwapRule{
+				ThresholdRule: NewThresholdRule(20, 20),
+				Type:          swap.TypeOut,
+				Opportunistic: &OpportunisticBand{
+					MaxFeeRate: 253,
+				},
+			},
+			err: errInvalidOpportunisticBand,
+		},
+		{
+			name: "band exceeds capacity",
+			rule: SwapRule{
+				ThresholdRule: NewThresholdRule(50, 20),
+				Type:          swap.TypeOut,
+				Opportunistic: &OpportunisticBand{
+					Percent:    30,
+					MaxFeeRate: 253,
+				},
+			},
+			err: errInvalidOpportunisticBand,
+		},
+		{
+			name: "no fee rate",
+			rule: SwapRule{
+				ThresholdRule: NewThresholdRule(20, 20),
+				Type:          swap.TypeOut,
+				Opportunistic: &OpportunisticBand{
+					Percent: 10,
+				},
+			},
+			err: errZeroOpportunisticFeeRate,
+		},
+		{
+			name: "predictive ok",
+			rule: SwapRule{
+				ThresholdRule: NewThresholdRule(20, 20),
+				Type:          swap.TypeIn,
+				Predictive: &PredictiveRule{
+					Lookback: time.Hour,
+					Horizon:  time.Hour,
+				},
+			},
+		},
+		{
+			name: "predictive amount rule",
+			rule: SwapRule{
+				AmountRule: NewAmountRule(100, 100),
+				Type:       swap.TypeOut,
+				Predictive: &PredictiveRule{
+					Lookback: time.Hour,
+					Horizon:  time.Hour,
+				},
+			},
+			err: errPredictiveThreshold,
+		},
+		{
+			name: "predictive no lookback",
+			rule: SwapRule{
+				ThresholdRule: NewThresholdRule(20, 20),
+				Type:          swap.TypeOut,
+				Predictive: &PredictiveRule{
+					Horizon: time.Hour,
+				},
+			},
+			err: errZeroForecastLookback,
+		},
+		{
+			name: "predictive no horizon",
+			rule: SwapRule{
+				ThresholdRule: NewThresholdRule(20, 20),
+				Type:          swap.TypeOut,
+				Predictive: &PredictiveRule{
+					Lookback: time.Hour,
+				},
+			},
+			err: errZeroForecastHorizon,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.rule.validate()
+			require.Equal(t, testCase.err, err)
+		})
+	}
+}
+
 // TestCalculateAmount tests calculation of the amount we recommend for a given
 // set of balances and threshold rule.
 func TestCalculateAmount(t *testing.T) {
@@ -12,6 +12,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/aperture/l402"
 	"github.com/lightninglabs/loop/assets"
+	"github.com/lightninglabs/loop/liquidity"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/cert"
@@ -189,6 +190,8 @@ type Config struct {
 	TotalPaymentTimeout time.Duration `long:"totalpaymenttimeout" description:"The timeout to use for off-chain payments."`
 	MaxPaymentRetries   int           `long:"maxpaymentretries" description:"The maximum number of times an off-chain payment may be retried."`
 
+	AutoloopDecisionRetention time.Duration `long:"autoloopdecisionretention" description:"The period that autoloop decisions are kept in the decision journal for. Set to 0 to keep decisions forever."`
+
 	MaxStaticAddrHtlcFeePercentage       float64 `long:"maxstaticaddrhtlcfeepercentage" description:"The maximum fee percentage that the server can charge for the htlc tx."`
 	MaxStaticAddrHtlcBackupFeePercentage float64 `long:"maxstaticaddrhtlcbackupfeepercentage" description:"The maximum fee percentage that the server can charge for the htlc backup tx. The backup transaction is only used in rare cases when the regular htlc tx is not confirmed on time. These backup transactions refer to high fee or extremely high fee transactions in the API."`
 
@@ -241,6 +244,7 @@ func DefaultConfig() Config {
 		LoopOutMaxParts:                      defaultLoopOutMaxParts,
 		TotalPaymentTimeout:                  defaultTotalPaymentTimeout,
 		MaxPaymentRetries:                    defaultMaxPaymentRetries,
+		AutoloopDecisionRetention:            liquidity.DefaultDecisionRetention,
 		MaxStaticAddrHtlcFeePercentage:       defaultMaxStaticAddrHtlcFeePercentage,
 		MaxStaticAddrHtlcBackupFeePercentage: defaultMaxStaticAddrHtlcBackupFeePercentage,
 		EnableExperimental:                   false,
@@ -387,6 +391,11 @@ func Validate(cfg *Config) error {
 		return fmt.Errorf("max payment retries must be at least 1")
 	}
 
+	if cfg.AutoloopDecisionRetention < 0 {
+		return fmt.Errorf("autoloop decision retention must not be " +
+			"negative")
+	}
+
 	// TLS Validity period to be at least 24 hours
 	if cfg.TLSValidity < time.Hour*24 {
 		return fmt.Errorf("TLS certificate minimum validity period is 24h")
@@ -16,11 +16,17 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/assets"
+	"github.com/lightninglabs/loop/chanopen"
+	"github.com/lightninglabs/loop/conditional"
+	"github.com/lightninglabs/loop/descriptor"
 	"github.com/lightninglabs/loop/instantout"
 	"github.com/lightninglabs/loop/instantout/reservation"
+	"github.com/lightninglabs/loop/liquidity"
 	"github.com/lightninglabs/loop/loopdb"
 	loop_looprpc "github.com/lightninglabs/loop/looprpc"
 	"github.com/lightninglabs/loop/notifications"
+	"github.com/lightninglabs/loop/recurring"
+	"github.com/lightninglabs/loop/split"
 	"github.com/lightninglabs/loop/staticaddr/address"
 	"github.com/lightninglabs/loop/staticaddr/deposit"
 	"github.com/lightninglabs/loop/staticaddr/loopin"
@@ -470,9 +476,22 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 		infof("Using asset client with version %v", getInfo.Version)
 	}
 
+	// Create the manager that negotiates the channels that the sweeps of
+	// loop outs fund. The sweep batcher of the client asks it for their
+	// funding outputs.
+	chanOpenMgr := chanopen.NewManager(&chanopen.Config{
+		Store: chanopen.NewSQLStore(
+			loopdb.NewTypedStore[chanopen.Querier](baseDb),
+		),
+		Lightning:   d.lnd.Client,
+		ChainParams: chainParams,
+		Clock:       clock.NewDefaultClock(),
+	})
+
 	// Create an instance of the loop client library.
 	swapClient, clientCleanup, err := getClient(
 		d.cfg, swapDb, sweeperDb, &d.lnd.LndServices, d.assetClient,
+		chanOpenMgr,
 	)
 	if err != nil {
 		return err
@@ -700,12 +719,49 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 		)
 	}
 
+	// Create the manager that hands out fresh addresses of watch-only
+	// descriptors as loop out destinations.
+	descriptorMgr := descriptor.NewManager(&descriptor.Config{
+		Store: descriptor.NewSQLStore(
+			loopdb.NewTypedStore[descriptor.Querier](baseDb),
+		),
+		ChainParams: d.lnd.ChainParams,
+		Clock:       clock.NewDefaultClock(),
+	})
+
+	// Create the liquidity manager, which journals its autoloop decisions
+	// and stores its liquidity profiles and approval queue in our
+	// database.
+	liquidityStore := liquidity.NewSQLStore(
+		loopdb.NewTypedStore[liquidity.Querier](baseDb),
+	)
+	liquidityMgr := getLiquidityManager(
+		swapClient, liquidityStore, d.cfg.AutoloopDecisionRetention,
+		liquidityStore, liquidityStore, staticAddressManager,
+		depositManager, staticLoopInManager, descriptorMgr,
+	)
+
+	// Create the manager that dispatches the swaps of our recurring swap
+	// schedules.
+	recurringMgr := recurring.NewManager(&recurring.Config{
+		Store: recurring.NewSQLStore(
+			loopdb.NewTypedStore[recurring.Querier](baseDb),
+		),
+		WalletKit:    d.lnd.WalletKit,
+		ChainParams:  d.lnd.ChainParams,
+		Clock:        clock.NewDefaultClock(),
+		LoopOutQuote: swapClient.LoopOutQuote,
+		LoopInQuote:  swapClient.LoopInQuote,
+		LoopOut:      swapClient.LoopOut,
+		LoopIn:       swapClient.LoopIn,
+	})
+
 	// Now finally fully initialize the swap client RPC server instance.
 	d.swapClientServer = swapClientServer{
 		config:               d.cfg,
 		network:              lndclient.Network(d.cfg.Network),
 		impl:                 swapClient,
-		liquidityMgr:         getLiquidityManager(swapClient),
+		liquidityMgr:         liquidityMgr,
 		lnd:                  &d.lnd.LndServices,
 		swaps:                make(map[lntypes.Hash]loop.SwapInfo),
 		subscribers:          make(map[int]chan<- interface{}),
@@ -717,9 +773,43 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 		depositManager:       depositManager,
 		withdrawalManager:    withdrawalManager,
 		staticLoopInManager:  staticLoopInManager,
+		recurringMgr:         recurringMgr,
+		descriptorMgr:        descriptorMgr,
+		chanOpenMgr:          chanOpenMgr,
 		assetClient:          d.assetClient,
 	}
 
+	// Create the manager that holds scheduled loop outs back until their
+	// conditions hold. It dispatches them through our rpc server, so
+	// that they are validated in the same way as other loop outs.
+	conditionalMgr := conditional.NewManager(&conditional.Config{
+		Store: conditional.NewSQLStore(
+			loopdb.NewTypedStore[conditional.Querier](baseDb),
+		),
+		ChainNotifier:   d.lnd.ChainNotifier,
+		WalletKit:       d.lnd.WalletKit,
+		Clock:           clock.NewDefaultClock(),
+		LoopOutQuote:    d.swapClientServer.LoopOutQuote,
+		ValidateLoopOut: d.swapClientServer.validateScheduledLoopOut,
+		LoopOut:         d.swapClientServer.LoopOut,
+	})
+	d.swapClientServer.conditionalMgr = conditionalMgr
+
+	// Create the manager that splits oversized loop outs into parts. Like
+	// the conditional manager, it dispatches each part through our rpc
+	// server.
+	splitMgr := split.NewManager(&split.Config{
+		Store: split.NewSQLStore(
+			loopdb.NewTypedStore[split.Querier](baseDb),
+		),
+		Lightning:    d.lnd.Client,
+		Clock:        clock.NewDefaultClock(),
+		LoopOutTerms: d.swapClientServer.LoopOutTerms,
+		LoopOut:      d.swapClientServer.LoopOut,
+		SwapState:    d.swapClientServer.swapState,
+	})
+	d.swapClientServer.splitMgr = splitMgr
+
 	// Retrieve all currently existing swaps from the database.
 	swapsList, err := d.impl.FetchSwaps(d.mainCtx)
 	if err != nil {
@@ -784,6 +874,45 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 		infof("Liquidity manager stopped")
 	}()
 
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		infof("Starting conditional loop out manager")
+		err := conditionalMgr.Run(d.mainCtx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			d.internalErrChan <- err
+		}
+
+		infof("Conditional loop out manager stopped")
+	}()
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		infof("Starting recurring swap manager")
+		err := recurringMgr.Run(d.mainCtx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			d.internalErrChan <- err
+		}
+
+		infof("Recurring swap manager stopped")
+	}()
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		infof("Starting split loop out manager")
+		err := splitMgr.Run(d.mainCtx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			d.internalErrChan <- err
+		}
+
+		infof("Split loop out manager stopped")
+	}()
+
 	initManagerTimeout := 10 * time.Second
 
 	// Start the reservation manager.
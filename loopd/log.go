@@ -7,12 +7,17 @@ import (
 	"github.com/lightninglabs/aperture/l402"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/chanopen"
+	"github.com/lightninglabs/loop/conditional"
+	"github.com/lightninglabs/loop/descriptor"
 	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/instantout"
 	"github.com/lightninglabs/loop/instantout/reservation"
 	"github.com/lightninglabs/loop/liquidity"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/notifications"
+	"github.com/lightninglabs/loop/recurring"
+	"github.com/lightninglabs/loop/split"
 	"github.com/lightninglabs/loop/staticaddr"
 	"github.com/lightninglabs/loop/sweep"
 	"github.com/lightninglabs/loop/sweepbatcher"
@@ -80,6 +85,19 @@ func SetupLoggers(root *build.SubLoggerManager, intercept signal.Interceptor) {
 		root, liquidity.Subsystem, intercept, liquidity.UseLogger,
 	)
 	lnd.AddSubLogger(root, fsm.Subsystem, intercept, fsm.UseLogger)
+	lnd.AddSubLogger(
+		root, conditional.Subsystem, intercept, conditional.UseLogger,
+	)
+	lnd.AddSubLogger(
+		root, recurring.Subsystem, intercept, recurring.UseLogger,
+	)
+	lnd.AddSubLogger(root, split.Subsystem, intercept, split.UseLogger)
+	lnd.AddSubLogger(
+		root, descriptor.Subsystem, intercept, descriptor.UseLogger,
+	)
+	lnd.AddSubLogger(
+		root, chanopen.Subsystem, intercept, chanopen.UseLogger,
+	)
 	lnd.AddSubLogger(
 		root, reservation.Subsystem, intercept, reservation.UseLogger,
 	)
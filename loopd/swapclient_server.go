@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"slices"
 	"sort"
@@ -22,6 +23,9 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/assets"
+	"github.com/lightninglabs/loop/chanopen"
+	"github.com/lightninglabs/loop/conditional"
+	"github.com/lightninglabs/loop/descriptor"
 	"github.com/lightninglabs/loop/fsm"
 	"github.com/lightninglabs/loop/instantout"
 	"github.com/lightninglabs/loop/instantout/reservation"
@@ -29,6 +33,8 @@ import (
 	"github.com/lightninglabs/loop/liquidity"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/recurring"
+	"github.com/lightninglabs/loop/split"
 	"github.com/lightninglabs/loop/staticaddr/address"
 	"github.com/lightninglabs/loop/staticaddr/deposit"
 	"github.com/lightninglabs/loop/staticaddr/loopin"
@@ -38,6 +44,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/rfqmath"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/queue"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
@@ -54,6 +62,10 @@ const (
 	minConfTarget = 2
 
 	defaultLoopdInitiator = "loopd"
+
+	// defaultScheduleRunsLimit is the number of runs of a swap schedule
+	// that we return if no limit is set.
+	defaultScheduleRunsLimit = 50
 )
 
 var (
@@ -78,6 +90,22 @@ var (
 	errInvalidAddress = errors.New(
 		"invalid or unsupported address",
 	)
+
+	// errDescriptorAndDest is returned when a destination descriptor is
+	// set along with a destination address or account.
+	errDescriptorAndDest = errors.New("destination descriptor cannot be " +
+		"set along with a destination address or account")
+
+	// errChannelOpenAndDest is returned when a loop out that opens a
+	// channel sets a destination, since its sweep pays to the channel and
+	// falls back to our wallet.
+	errChannelOpenAndDest = errors.New("channel open cannot be set " +
+		"along with a destination address, account or descriptor")
+
+	// errChannelOpenSplit is returned when a split loop out opens a
+	// channel.
+	errChannelOpenSplit = errors.New("split loop outs can't open a " +
+		"channel")
 )
 
 // swapClientServer implements the grpc service exposed by loopd.
@@ -97,6 +125,11 @@ type swapClientServer struct {
 	depositManager       *deposit.Manager
 	withdrawalManager    *withdraw.Manager
 	staticLoopInManager  *loopin.Manager
+	conditionalMgr       *conditional.Manager
+	recurringMgr         *recurring.Manager
+	splitMgr             *split.Manager
+	descriptorMgr        *descriptor.Manager
+	chanOpenMgr          *chanopen.Manager
 	assetClient          *assets.TapdClient
 	swaps                map[lntypes.Hash]loop.SwapInfo
 	subscribers          map[int]chan<- interface{}
@@ -127,6 +160,16 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 			s.config.TotalPaymentTimeout)
 	}
 
+	// Split loop outs are dispatched as a loop out per part, which each
+	// come back through this method without split options.
+	if in.Split != nil {
+		if in.ChannelOpen != nil {
+			return nil, errChannelOpenSplit
+		}
+
+		return s.splitLoopOut(ctx, in)
+	}
+
 	var sweepAddr btcutil.Address
 	var isExternalAddr bool
 	var err error
@@ -136,6 +179,14 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 		return nil, fmt.Errorf("destination address and external " +
 			"account address cannot be set at the same time")
 
+	case in.DestDescriptor != "" && (in.Dest != "" || in.Account != ""):
+		return nil, errDescriptorAndDest
+
+	case in.ChannelOpen != nil && (in.Dest != "" || in.Account != "" ||
+		in.DestDescriptor != ""):
+
+		return nil, errChannelOpenAndDest
+
 	case in.Dest != "":
 		// Decode the client provided destination address for the loop
 		// out sweep.
@@ -148,6 +199,17 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 
 		isExternalAddr = true
 
+	case in.DestDescriptor != "":
+		// The address of the descriptor is only derived once the
+		// request is validated, so that requests that fail validation
+		// don't use up addresses.
+		err = s.descriptorMgr.Validate(in.DestDescriptor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid descriptor: %w", err)
+		}
+
+		isExternalAddr = true
+
 	case in.Account != "" && in.AccountAddrType == looprpc.AddressType_ADDRESS_TYPE_UNKNOWN:
 		return nil, liquidity.ErrAccountAndAddrType
 
@@ -193,6 +255,22 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 		return nil, err
 	}
 
+	var (
+		chanPeer     route.Vertex
+		chanCapacity btcutil.Amount
+	)
+	if in.ChannelOpen != nil {
+		chanPeer, chanCapacity, err = s.validateChannelOpen(in)
+		if err != nil {
+			return nil, err
+		}
+
+		// The sweep transaction is frozen once the channel is funded,
+		// so the sweep must not share it with other sweeps. Marking
+		// its wallet address as external gives it a batch of its own.
+		isExternalAddr = true
+	}
+
 	// Infer if the publication deadline is set in milliseconds.
 	publicationDeadline := getPublicationDeadline(in.SwapPublicationDeadline)
 
@@ -257,12 +335,40 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 		req.OutgoingChanSet = in.OutgoingChanSet
 	}
 
+	// Now that the request is validated, derive a fresh address from the
+	// watch-only descriptor.
+	if in.DestDescriptor != "" {
+		sweepAddr, err = s.descriptorMgr.NextAddr(
+			ctx, in.DestDescriptor,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("derive address from "+
+				"descriptor: %w", err)
+		}
+
+		req.DestAddr = sweepAddr
+	}
+
 	info, err := s.impl.LoopOut(ctx, req)
 	if err != nil {
 		errorf("LoopOut: %v", err)
 		return nil, err
 	}
 
+	// Record the channel that the sweep funds. The swap is underway at
+	// this point, so if that fails, its sweep simply pays to our wallet.
+	if in.ChannelOpen != nil {
+		err := s.chanOpenMgr.Register(
+			ctx, info.SwapHash, chanPeer, in.ChannelOpen.Private,
+			chanCapacity,
+		)
+		if err != nil {
+			errorf("Could not register channel open of swap %v, "+
+				"its sweep pays to %v: %v", info.SwapHash,
+				sweepAddr, err)
+		}
+	}
+
 	htlcAddress := info.HtlcAddress.String()
 	resp := &looprpc.SwapResponse{
 		Id:            info.SwapHash.String(),
@@ -280,6 +386,36 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 	return resp, nil
 }
 
+// validateChannelOpen validates the channel that the sweep of a loop out is
+// requested to fund, and returns its peer and capacity.
+func (s *swapClientServer) validateChannelOpen(
+	in *looprpc.LoopOutRequest) (route.Vertex, btcutil.Amount, error) {
+
+	if in.AssetInfo != nil {
+		return route.Vertex{}, 0, errors.New("asset loop outs can't " +
+			"open a channel")
+	}
+
+	if _, err := btcec.ParsePubKey(in.ChannelOpen.NodePubkey); err != nil {
+		return route.Vertex{}, 0, fmt.Errorf("invalid channel peer: "+
+			"%w", err)
+	}
+
+	peer, err := route.NewVertexFromBytes(in.ChannelOpen.NodePubkey)
+	if err != nil {
+		return route.Vertex{}, 0, err
+	}
+
+	capacity, err := chanopen.Capacity(
+		btcutil.Amount(in.Amt), btcutil.Amount(in.MaxMinerFee),
+	)
+	if err != nil {
+		return route.Vertex{}, 0, err
+	}
+
+	return peer, capacity, nil
+}
+
 // accountExists returns true if account under the address type exists in the
 // backing lnd instance and false otherwise.
 func (s *swapClientServer) accountExists(ctx context.Context, account string,
@@ -385,6 +521,8 @@ func (s *swapClientServer) marshallSwap(ctx context.Context,
 		htlcAddressP2WSH string
 	)
 	var outGoingChanSet []uint64
+	var splitGroupID uint64
+	var channelOpenState looprpc.ChannelOpenState
 	var lastHop []byte
 	var assetInfo *looprpc.AssetLoopOutInfo
 
@@ -417,6 +555,26 @@ func (s *swapClientServer) marshallSwap(ctx context.Context,
 
 		outGoingChanSet = loopSwap.OutgoingChanSet
 
+		if s.splitMgr != nil {
+			var err error
+			splitGroupID, _, err = s.splitMgr.GroupID(
+				ctx, loopSwap.SwapHash,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if s.chanOpenMgr != nil {
+			var err error
+			channelOpenState, err = s.channelOpenState(
+				ctx, loopSwap.SwapHash,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		if loopSwap.AssetSwapInfo != nil {
 			var (
 				// Default the asset name to "N/A" in case we
@@ -465,9 +623,41 @@ func (s *swapClientServer) marshallSwap(ctx context.Context,
 		LastHop:          lastHop,
 		OutgoingChanSet:  outGoingChanSet,
 		AssetInfo:        assetInfo,
+		SplitGroupId:     splitGroupID,
+		ChannelOpenState: channelOpenState,
 	}, nil
 }
 
+// channelOpenState returns the state of the channel that the sweep of a loop
+// out funds.
+func (s *swapClientServer) channelOpenState(ctx context.Context,
+	swapHash lntypes.Hash) (looprpc.ChannelOpenState, error) {
+
+	open, err := s.chanOpenMgr.ChannelOpen(ctx, swapHash)
+	switch {
+	case errors.Is(err, chanopen.ErrNotFound):
+		return looprpc.ChannelOpenState_CHANNEL_OPEN_NONE, nil
+
+	case err != nil:
+		return 0, err
+	}
+
+	switch open.State {
+	case chanopen.StatePending:
+		return looprpc.ChannelOpenState_CHANNEL_OPEN_PENDING, nil
+
+	case chanopen.StateFunded:
+		return looprpc.ChannelOpenState_CHANNEL_OPEN_FUNDED, nil
+
+	case chanopen.StateFallback:
+		return looprpc.ChannelOpenState_CHANNEL_OPEN_FALLBACK, nil
+
+	default:
+		return 0, fmt.Errorf("unknown channel open state: %v",
+			open.State)
+	}
+}
+
 // Monitor will return a stream of swap updates for currently active swaps.
 func (s *swapClientServer) Monitor(in *looprpc.MonitorRequest,
 	server looprpc.SwapClient_MonitorServer) error {
@@ -594,6 +784,26 @@ func (s *swapClientServer) ListSwaps(ctx context.Context,
 			continue
 		}
 
+		// Filtering by split group requires a lookup of the swap's
+		// group, so we don't do it in filterSwap.
+		groupID := req.ListSwapFilter.GetSplitGroupId()
+		if groupID != 0 {
+			if s.splitMgr == nil {
+				continue
+			}
+
+			swapGroup, _, err := s.splitMgr.GroupID(
+				ctx, swp.SwapHash,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if swapGroup != groupID {
+				continue
+			}
+		}
+
 		swapInfos = append(swapInfos, &swp)
 	}
 
@@ -626,13 +836,61 @@ func (s *swapClientServer) ListSwaps(ctx context.Context,
 		nextStartTime = rpcSwaps[len(rpcSwaps)-1].InitiationTime + 1
 	}
 
+	splitGroups, err := s.listSplitGroups(ctx, rpcSwaps)
+	if err != nil {
+		return nil, err
+	}
+
 	response := looprpc.ListSwapsResponse{
 		Swaps:         rpcSwaps,
 		NextStartTime: nextStartTime,
+		SplitGroups:   splitGroups,
 	}
 	return &response, nil
 }
 
+// listSplitGroups returns the split groups that any of the swaps provided
+// are a part of.
+//
+// NOTE: s.swapsLock must be held when calling this method.
+func (s *swapClientServer) listSplitGroups(ctx context.Context,
+	swaps []*looprpc.SwapStatus) ([]*looprpc.SplitGroup, error) {
+
+	groupIDs := make(map[uint64]bool)
+	for _, swp := range swaps {
+		if swp.SplitGroupId != 0 {
+			groupIDs[swp.SplitGroupId] = true
+		}
+	}
+
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+
+	groups, err := s.splitMgr.ListGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Our lock is held, so we look up the states of the groups' swaps
+	// directly.
+	swapState := func(hash lntypes.Hash) (loopdb.SwapState, bool) {
+		swp, ok := s.swaps[hash]
+		return swp.State, ok
+	}
+
+	var rpcGroups []*looprpc.SplitGroup
+	for _, group := range groups {
+		if groupIDs[group.ID] {
+			rpcGroups = append(
+				rpcGroups, rpcSplitGroup(group, swapState),
+			)
+		}
+	}
+
+	return rpcGroups, nil
+}
+
 // filterSwap filters the given swap based on the provided filter.
 func filterSwap(swapInfo *loop.SwapInfo, filter *looprpc.ListSwapsFilter) bool {
 	if filter == nil {
@@ -1279,7 +1537,7 @@ func (s *swapClientServer) GetInfo(ctx context.Context,
 }
 
 // GetLiquidityParams gets our current liquidity manager's parameters.
-func (s *swapClientServer) GetLiquidityParams(_ context.Context,
+func (s *swapClientServer) GetLiquidityParams(ctx context.Context,
 	_ *looprpc.GetLiquidityParamsRequest) (*looprpc.LiquidityParameters,
 	error) {
 
@@ -1290,6 +1548,43 @@ func (s *swapClientServer) GetLiquidityParams(_ context.Context,
 		return nil, err
 	}
 
+	// Report the remaining budget for rules that have their own budget.
+	var haveBudgets bool
+	for _, rule := range rpcCfg.Rules {
+		if rule.BudgetRefreshPeriodSec != 0 {
+			haveBudgets = true
+			break
+		}
+	}
+
+	if !haveBudgets {
+		return rpcCfg, nil
+	}
+
+	chanBudgets, peerBudgets, err := s.liquidityMgr.TargetBudgets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rpcCfg.Rules {
+		if rule.BudgetRefreshPeriodSec == 0 {
+			continue
+		}
+
+		if rule.ChannelId != 0 {
+			chanID := lnwire.NewShortChanIDFromInt(rule.ChannelId)
+			rule.BudgetRemainingSat = uint64(chanBudgets[chanID])
+
+			continue
+		}
+
+		peer, err := route.NewVertexFromBytes(rule.Pubkey)
+		if err != nil {
+			return nil, err
+		}
+		rule.BudgetRemainingSat = uint64(peerBudgets[peer])
+	}
+
 	return rpcCfg, nil
 }
 
@@ -1308,11 +1603,30 @@ func (s *swapClientServer) SetLiquidityParams(ctx context.Context,
 }
 
 // SuggestSwaps provides a list of suggested swaps based on lnd's current
-// channel balances and rules set by the liquidity manager.
+// channel balances and rules set by the liquidity manager. If the request
+// provides a set of parameters, suggestions are made for those parameters
+// without saving them.
 func (s *swapClientServer) SuggestSwaps(ctx context.Context,
-	_ *looprpc.SuggestSwapsRequest) (*looprpc.SuggestSwapsResponse, error) {
+	req *looprpc.SuggestSwapsRequest) (*looprpc.SuggestSwapsResponse, error) {
+
+	var (
+		suggestions *liquidity.Suggestions
+		err         error
+	)
+	if req.Parameters != nil {
+		var params *liquidity.Parameters
+		params, err = liquidity.RpcToParameters(req.Parameters)
+		if err != nil {
+			return nil, status.Error(
+				codes.InvalidArgument, err.Error(),
+			)
+		}
+
+		suggestions, err = s.liquidityMgr.PreviewSwaps(ctx, *params)
+	} else {
+		suggestions, err = s.liquidityMgr.SuggestSwaps(ctx)
+	}
 
-	suggestions, err := s.liquidityMgr.SuggestSwaps(ctx)
 	switch err {
 	case liquidity.ErrNoRules:
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
@@ -1360,6 +1674,19 @@ func (s *swapClientServer) SuggestSwaps(ctx context.Context,
 		resp.LoopIn[i] = loopIn
 	}
 
+	for _, swap := range suggestions.StaticInSwaps {
+		staticLoopIn := &looprpc.StaticAddressLoopInRequest{
+			Outpoints:          swap.DepositOutpoints,
+			MaxSwapFeeSatoshis: int64(swap.MaxSwapFee),
+		}
+
+		if swap.LastHop != nil {
+			staticLoopIn.LastHop = swap.LastHop[:]
+		}
+
+		resp.StaticLoopIn = append(resp.StaticLoopIn, staticLoopIn)
+	}
+
 	for id, reason := range suggestions.DisqualifiedChans {
 		autoloopReason, err := rpcAutoloopReason(reason)
 		if err != nil {
@@ -1370,6 +1697,7 @@ func (s *swapClientServer) SuggestSwaps(ctx context.Context,
 			Reason:    autoloopReason,
 			ChannelId: id.ToUint64(),
 		}
+		setRpcFailureBackoff(exclChan, suggestions.BackoffChans[id])
 
 		resp.Disqualified = append(resp.Disqualified, exclChan)
 	}
@@ -1387,138 +1715,1517 @@ func (s *swapClientServer) SuggestSwaps(ctx context.Context,
 			Reason: autoloopReason,
 			Pubkey: clonedPubkey[:],
 		}
+		setRpcFailureBackoff(exclChan, suggestions.BackoffPeers[pubkey])
 
 		resp.Disqualified = append(resp.Disqualified, exclChan)
 	}
 
-	return resp, nil
-}
-
-// ListReservations lists all existing reservations the client has ever made.
-func (s *swapClientServer) ListReservations(ctx context.Context,
-	_ *looprpc.ListReservationsRequest) (
-	*looprpc.ListReservationsResponse, error) {
+	for id, projection := range suggestions.ChanProjections {
+		rpcProjection := rpcLiquidityProjection(projection)
+		rpcProjection.ChannelId = id.ToUint64()
 
-	if s.reservationManager == nil {
-		return nil, status.Error(codes.Unimplemented,
-			"Restart loop with --experimental")
+		resp.Projections = append(resp.Projections, rpcProjection)
 	}
-	reservations, err := s.reservationManager.GetReservations(
-		ctx,
+
+	resp.NodeDisqualified, err = rpcAutoloopReason(
+		suggestions.DisqualifiedNode,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &looprpc.ListReservationsResponse{
-		Reservations: ToClientReservations(
-			reservations,
-		),
-	}, nil
-}
+	for pubkey, projection := range suggestions.PeerProjections {
+		clonedPubkey := route.Vertex{}
+		copy(clonedPubkey[:], pubkey[:])
 
-// InstantOut initiates an instant out swap.
-func (s *swapClientServer) InstantOut(ctx context.Context,
-	req *looprpc.InstantOutRequest) (*looprpc.InstantOutResponse,
-	error) {
+		rpcProjection := rpcLiquidityProjection(projection)
+		rpcProjection.Pubkey = clonedPubkey[:]
 
-	reservationIds := make([]reservation.ID, len(req.ReservationIds))
-	for i, id := range req.ReservationIds {
-		if len(id) != reservation.IdLength {
-			return nil, fmt.Errorf("invalid reservation id: "+
-				"expected %v bytes, got %d",
-				reservation.IdLength, len(id))
-		}
+		resp.Projections = append(resp.Projections, rpcProjection)
+	}
 
-		var resId reservation.ID
-		copy(resId[:], id)
+	for id, balance := range suggestions.ChanBalances {
+		rpcBalance := rpcTargetBalance(balance)
+		rpcBalance.ChannelId = id.ToUint64()
 
-		reservationIds[i] = resId
+		resp.Balances = append(resp.Balances, rpcBalance)
 	}
 
-	instantOutFsm, err := s.instantOutManager.NewInstantOut(
-		ctx, reservationIds, req.DestAddr,
-	)
-	if err != nil {
-		return nil, err
-	}
+	for pubkey, balance := range suggestions.PeerBalances {
+		clonedPubkey := route.Vertex{}
+		copy(clonedPubkey[:], pubkey[:])
 
-	res := &looprpc.InstantOutResponse{
-		InstantOutHash: instantOutFsm.InstantOut.SwapHash[:],
-		State:          string(instantOutFsm.InstantOut.State),
+		rpcBalance := rpcTargetBalance(balance)
+		rpcBalance.Pubkey = clonedPubkey[:]
+
+		resp.Balances = append(resp.Balances, rpcBalance)
 	}
 
-	if instantOutFsm.InstantOut.SweepTxHash != nil {
-		res.SweepTxId = instantOutFsm.InstantOut.SweepTxHash.String()
+	if suggestions.NodeBalance != nil {
+		rpcBalance := rpcTargetBalance(suggestions.NodeBalance)
+		rpcBalance.Node = true
+
+		resp.Balances = append(resp.Balances, rpcBalance)
 	}
 
-	return res, nil
+	return resp, nil
 }
 
-// InstantOutQuote returns a quote for an instant out swap with the provided
-// parameters.
-func (s *swapClientServer) InstantOutQuote(ctx context.Context,
-	req *looprpc.InstantOutQuoteRequest) (
-	*looprpc.InstantOutQuoteResponse, error) {
-
-	quote, err := s.instantOutManager.GetInstantOutQuote(
-		ctx, btcutil.Amount(req.Amt), req.ReservationIds,
-	)
-	if err != nil {
-		return nil, err
+// rpcTargetBalance converts a target balance to its rpc representation.
+func rpcTargetBalance(balance *liquidity.TargetBalance) *looprpc.TargetBalance {
+	return &looprpc.TargetBalance{
+		CapacitySat:        uint64(balance.Capacity),
+		IncomingSat:        uint64(balance.Incoming),
+		OutgoingSat:        uint64(balance.Outgoing),
+		PendingIncomingSat: uint64(balance.PendingIncoming),
+		PendingOutgoingSat: uint64(balance.PendingOutgoing),
 	}
-
-	return &looprpc.InstantOutQuoteResponse{
-		ServiceFeeSat: int64(quote.ServiceFee),
-		SweepFeeSat:   int64(quote.OnChainFee),
-	}, nil
 }
 
-// ListInstantOuts returns a list of all currently known instant out swaps and
-// their current status.
-func (s *swapClientServer) ListInstantOuts(ctx context.Context,
-	_ *looprpc.ListInstantOutsRequest) (
-	*looprpc.ListInstantOutsResponse, error) {
+// rpcLiquidityProjection converts a liquidity projection to its rpc
+// representation.
+func rpcLiquidityProjection(
+	projection *liquidity.Projection) *looprpc.LiquidityProjection {
 
-	instantOuts, err := s.instantOutManager.ListInstantOuts(ctx)
-	if err != nil {
-		return nil, err
+	return &looprpc.LiquidityProjection{
+		NetOutflowSat:        int64(projection.NetOutflow),
+		LookbackSec:          uint64(projection.Lookback.Seconds()),
+		HorizonSec:           uint64(projection.Horizon.Seconds()),
+		ProjectedIncomingSat: uint64(projection.Incoming),
+		ProjectedOutgoingSat: uint64(projection.Outgoing),
+		PredictedSwap:        projection.Predicted,
 	}
+}
 
-	rpcSwaps := make([]*looprpc.InstantOut, 0, len(instantOuts))
-	for _, instantOut := range instantOuts {
-		rpcSwaps = append(rpcSwaps, rpcInstantOut(instantOut))
-	}
+// SimulateAutoloop replays the liquidity manager's suggestion logic over a
+// time series of balances, either provided in the request or recorded by the
+// liquidity manager.
+func (s *swapClientServer) SimulateAutoloop(ctx context.Context,
+	req *looprpc.SimulateAutoloopRequest) (
+	*looprpc.SimulateAutoloopResponse, error) {
 
-	return &looprpc.ListInstantOutsResponse{
-		Swaps: rpcSwaps,
-	}, nil
-}
+	params := s.liquidityMgr.GetParameters()
+	if req.Parameters != nil {
+		rpcParams, err := liquidity.RpcToParameters(req.Parameters)
+		if err != nil {
+			return nil, status.Error(
+				codes.InvalidArgument, err.Error(),
+			)
+		}
 
-func rpcInstantOut(instantOut *instantout.InstantOut) *looprpc.InstantOut {
-	var sweepTxId string
-	if instantOut.SweepTxHash != nil {
-		sweepTxId = instantOut.SweepTxHash.String()
+		params = *rpcParams
 	}
 
-	reservations := make([][]byte, len(instantOut.Reservations))
-	for i, res := range instantOut.Reservations {
-		reservations[i] = res.ID[:]
+	snapshots := s.liquidityMgr.Snapshots()
+	if len(req.Snapshots) != 0 {
+		var err error
+		snapshots, err = rpcToBalanceSnapshots(req.Snapshots)
+		if err != nil {
+			return nil, status.Error(
+				codes.InvalidArgument, err.Error(),
+			)
+		}
 	}
 
-	return &looprpc.InstantOut{
-		SwapHash:       instantOut.SwapHash[:],
-		State:          string(instantOut.State),
-		Amount:         uint64(instantOut.Value),
-		SweepTxId:      sweepTxId,
-		ReservationIds: reservations,
+	simReq := &liquidity.SimulationRequest{
+		Parameters:   params,
+		Snapshots:    snapshots,
+		SwapFeePPM:   req.SwapFeePpm,
+		MinerFee:     btcutil.Amount(req.MinerFeeSat),
+		PrepayAmount: btcutil.Amount(req.PrepayAmtSat),
 	}
-}
 
-// NewStaticAddress is the rpc endpoint for loop clients to request a new static
-// address.
-func (s *swapClientServer) NewStaticAddress(ctx context.Context,
-	_ *looprpc.NewStaticAddressRequest) (
+	if req.MinSwapAmountSat != 0 || req.MaxSwapAmountSat != 0 {
+		simReq.Restrictions = liquidity.NewRestrictions(
+			btcutil.Amount(req.MinSwapAmountSat),
+			btcutil.Amount(req.MaxSwapAmountSat),
+		)
+	}
+
+	result, err := s.liquidityMgr.Simulate(ctx, simReq)
+	switch {
+	case errors.Is(err, liquidity.ErrNoSnapshots):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	resp := &looprpc.SimulateAutoloopResponse{
+		TotalFeesSat: uint64(result.TotalFees),
+	}
+
+	for _, simSwap := range result.Swaps {
+		rpcSwap := &looprpc.SimulatedSwap{
+			Timestamp:  simSwap.Timestamp.Unix(),
+			Type:       looprpc.SwapType_LOOP_OUT,
+			Amt:        uint64(simSwap.Amount),
+			FeesSat:    uint64(simSwap.Fees),
+			MaxFeesSat: uint64(simSwap.MaxFees),
+		}
+
+		if simSwap.Type == swap.TypeIn {
+			rpcSwap.Type = looprpc.SwapType_LOOP_IN
+		}
+
+		for _, channel := range simSwap.Channels {
+			rpcSwap.OutgoingChanSet = append(
+				rpcSwap.OutgoingChanSet, channel.ToUint64(),
+			)
+		}
+
+		if simSwap.LastHop != nil {
+			rpcSwap.LastHop = simSwap.LastHop[:]
+		}
+
+		resp.Swaps = append(resp.Swaps, rpcSwap)
+	}
+
+	for _, step := range result.Steps {
+		resp.Steps = append(resp.Steps, &looprpc.SimulationStep{
+			Timestamp:          step.Timestamp.Unix(),
+			SwapsDispatched:    uint32(step.SwapsDispatched),
+			SpentFeesSat:       uint64(step.SpentFees),
+			PendingFeesSat:     uint64(step.PendingFees),
+			BudgetRemainingSat: uint64(step.BudgetRemaining),
+		})
+	}
+
+	return resp, nil
+}
+
+// rpcToBalanceSnapshots converts a set of rpc balance snapshots to the
+// liquidity manager's snapshot type.
+func rpcToBalanceSnapshots(rpcSnapshots []*looprpc.BalanceSnapshot) (
+	[]liquidity.BalanceSnapshot, error) {
+
+	snapshots := make([]liquidity.BalanceSnapshot, len(rpcSnapshots))
+	for i, rpcSnapshot := range rpcSnapshots {
+		channels := make(
+			[]lndclient.ChannelInfo, len(rpcSnapshot.Channels),
+		)
+		for j, channel := range rpcSnapshot.Channels {
+			pubkey, err := route.NewVertexFromBytes(channel.Pubkey)
+			if err != nil {
+				return nil, fmt.Errorf("channel %v: %w",
+					channel.ChannelId, err)
+			}
+
+			channels[j] = lndclient.ChannelInfo{
+				ChannelID:   channel.ChannelId,
+				PubKeyBytes: pubkey,
+				Capacity:    btcutil.Amount(channel.CapacitySat),
+				LocalBalance: btcutil.Amount(
+					channel.LocalBalanceSat,
+				),
+				RemoteBalance: btcutil.Amount(
+					channel.RemoteBalanceSat,
+				),
+			}
+		}
+
+		feeRate := chainfee.SatPerKVByte(
+			rpcSnapshot.SweepFeeRateSatPerVbyte * 1000,
+		)
+
+		snapshots[i] = liquidity.BalanceSnapshot{
+			Timestamp:    time.Unix(rpcSnapshot.Timestamp, 0),
+			Channels:     channels,
+			SweepFeeRate: feeRate.FeePerKWeight(),
+		}
+	}
+
+	return snapshots, nil
+}
+
+// ListAutoloopDecisions returns the decisions recorded in the autoloop
+// decision journal that match the request's filters.
+func (s *swapClientServer) ListAutoloopDecisions(ctx context.Context,
+	req *looprpc.ListAutoloopDecisionsRequest) (
+	*looprpc.ListAutoloopDecisionsResponse, error) {
+
+	query := &liquidity.DecisionQuery{
+		IndexOffset:  req.IndexOffset,
+		MaxDecisions: req.MaxDecisions,
+	}
+
+	if req.ChannelId != 0 {
+		channel := lnwire.NewShortChanIDFromInt(req.ChannelId)
+		query.Channel = &channel
+	}
+
+	if len(req.Pubkey) != 0 {
+		peer, err := route.NewVertexFromBytes(req.Pubkey)
+		if err != nil {
+			return nil, status.Error(
+				codes.InvalidArgument, err.Error(),
+			)
+		}
+
+		query.Peer = &peer
+	}
+
+	if req.StartTime != 0 {
+		query.Start = time.Unix(req.StartTime, 0)
+	}
+
+	if req.EndTime != 0 {
+		query.End = time.Unix(req.EndTime, 0)
+	}
+
+	if !query.End.IsZero() && !query.End.After(query.Start) {
+		return nil, status.Error(
+			codes.InvalidArgument, "end time must be after start "+
+				"time",
+		)
+	}
+
+	decisions, err := s.liquidityMgr.ListDecisions(ctx, query)
+	switch {
+	case errors.Is(err, liquidity.ErrNoDecisionStore):
+		return nil, status.Error(codes.Unavailable, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	resp := &looprpc.ListAutoloopDecisionsResponse{
+		LastIndexOffset: req.IndexOffset,
+	}
+
+	for _, decision := range decisions {
+		rpcDecision, err := rpcAutoloopDecision(decision)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Decisions = append(resp.Decisions, rpcDecision)
+		resp.LastIndexOffset = decision.ID
+	}
+
+	return resp, nil
+}
+
+// rpcAutoloopDecision converts an autoloop decision to its rpc representation.
+func rpcAutoloopDecision(decision *liquidity.Decision) (
+	*looprpc.AutoloopDecision, error) {
+
+	rpcDecision := &looprpc.AutoloopDecision{
+		Id:                decision.ID,
+		Timestamp:         decision.Timestamp.Unix(),
+		BudgetSat:         uint64(decision.Budget),
+		BudgetLastRefresh: decision.BudgetLastRefresh.Unix(),
+		SpentFeesSat:      uint64(decision.SpentFees),
+		PendingFeesSat:    uint64(decision.PendingFees),
+	}
+
+	for _, decisionSwap := range decision.Swaps {
+		rpcSwap := &looprpc.AutoloopDecisionSwap{
+			Type: looprpc.SwapType_LOOP_OUT,
+			Amt:  uint64(decisionSwap.Amount),
+		}
+
+		if decisionSwap.Type == swap.TypeIn {
+			rpcSwap.Type = looprpc.SwapType_LOOP_IN
+		}
+
+		for _, target := range decisionSwap.Targets {
+			if decisionSwap.Type == swap.TypeIn {
+				rpcSwap.LastHop = target.Peer[:]
+				continue
+			}
+
+			rpcSwap.OutgoingChanSet = append(
+				rpcSwap.OutgoingChanSet,
+				target.Channel.ToUint64(),
+			)
+		}
+
+		if decisionSwap.SwapHash != nil {
+			rpcSwap.SwapHash = decisionSwap.SwapHash[:]
+		}
+
+		rpcDecision.Swaps = append(rpcDecision.Swaps, rpcSwap)
+	}
+
+	for _, target := range decision.Disqualified {
+		reason, err := rpcAutoloopReason(target.Reason)
+		if err != nil {
+			return nil, err
+		}
+
+		disqualified := &looprpc.Disqualified{
+			ChannelId: target.Channel.ToUint64(),
+			Reason:    reason,
+		}
+
+		if target.Peer != (route.Vertex{}) {
+			disqualified.Pubkey = target.Peer[:]
+		}
+
+		rpcDecision.Disqualified = append(
+			rpcDecision.Disqualified, disqualified,
+		)
+	}
+
+	return rpcDecision, nil
+}
+
+// ListLiquidityProfiles returns our named liquidity profiles along with their
+// history and the history of profile activations.
+func (s *swapClientServer) ListLiquidityProfiles(ctx context.Context,
+	req *looprpc.ListLiquidityProfilesRequest) (
+	*looprpc.ListLiquidityProfilesResponse, error) {
+
+	profiles, err := s.liquidityMgr.ListProfiles(ctx)
+	if err != nil {
+		return nil, profileRPCError(err)
+	}
+
+	activations, err := s.liquidityMgr.ListProfileActivations(
+		ctx, req.MaxActivations,
+	)
+	if err != nil {
+		return nil, profileRPCError(err)
+	}
+
+	resp := &looprpc.ListLiquidityProfilesResponse{}
+	for _, profile := range profiles {
+		rpcProfile := &looprpc.LiquidityProfile{
+			Name:      profile.Name,
+			CreatedAt: profile.CreatedAt.Unix(),
+		}
+
+		for _, version := range profile.Versions {
+			schedule := liquidity.ScheduleWindowsToRpc(
+				version.Schedule,
+			)
+
+			rpcVersion := &looprpc.LiquidityProfileVersion{
+				Version:            version.Version,
+				Params:             version.Params,
+				ActivationSchedule: schedule,
+				CreatedAt:          version.CreatedAt.Unix(),
+			}
+
+			rpcProfile.Versions = append(
+				rpcProfile.Versions, rpcVersion,
+			)
+		}
+
+		resp.Profiles = append(resp.Profiles, rpcProfile)
+	}
+
+	for _, activation := range activations {
+		resp.Activations = append(
+			resp.Activations, &looprpc.LiquidityProfileActivation{
+				Name:        activation.Name,
+				Version:     activation.Version,
+				ActivatedAt: activation.Timestamp.Unix(),
+				Scheduled:   activation.Scheduled,
+			},
+		)
+	}
+
+	return resp, nil
+}
+
+// SaveLiquidityProfile saves a new version of a named liquidity profile.
+func (s *swapClientServer) SaveLiquidityProfile(ctx context.Context,
+	req *looprpc.SaveLiquidityProfileRequest) (
+	*looprpc.SaveLiquidityProfileResponse, error) {
+
+	version, err := s.liquidityMgr.SaveProfile(
+		ctx, req.Name, req.Params,
+		liquidity.RpcToScheduleWindows(req.ActivationSchedule),
+	)
+	if err != nil {
+		return nil, profileRPCError(err)
+	}
+
+	return &looprpc.SaveLiquidityProfileResponse{
+		Version: version,
+	}, nil
+}
+
+// ActivateLiquidityProfile sets the liquidity manager's parameters to a
+// version of a named liquidity profile.
+func (s *swapClientServer) ActivateLiquidityProfile(ctx context.Context,
+	req *looprpc.ActivateLiquidityProfileRequest) (
+	*looprpc.ActivateLiquidityProfileResponse, error) {
+
+	err := s.liquidityMgr.ActivateProfile(ctx, req.Name, req.Version)
+	if err != nil {
+		return nil, profileRPCError(err)
+	}
+
+	return &looprpc.ActivateLiquidityProfileResponse{}, nil
+}
+
+// RollbackLiquidityProfile rolls a named liquidity profile back to an earlier
+// version.
+func (s *swapClientServer) RollbackLiquidityProfile(ctx context.Context,
+	req *looprpc.RollbackLiquidityProfileRequest) (
+	*looprpc.RollbackLiquidityProfileResponse, error) {
+
+	version, err := s.liquidityMgr.RollbackProfile(
+		ctx, req.Name, req.Version,
+	)
+	if err != nil {
+		return nil, profileRPCError(err)
+	}
+
+	return &looprpc.RollbackLiquidityProfileResponse{
+		Version: version,
+	}, nil
+}
+
+// DiffLiquidityProfiles returns the parameters that differ between two
+// versions of liquidity profiles.
+func (s *swapClientServer) DiffLiquidityProfiles(ctx context.Context,
+	req *looprpc.DiffLiquidityProfilesRequest) (
+	*looprpc.DiffLiquidityProfilesResponse, error) {
+
+	diff, err := s.liquidityMgr.DiffProfiles(
+		ctx, liquidity.ProfileRef{
+			Name:    req.FromName,
+			Version: req.FromVersion,
+		}, liquidity.ProfileRef{
+			Name:    req.ToName,
+			Version: req.ToVersion,
+		},
+	)
+	if err != nil {
+		return nil, profileRPCError(err)
+	}
+
+	resp := &looprpc.DiffLiquidityProfilesResponse{
+		FromName:    diff.From.Name,
+		FromVersion: diff.From.Version,
+		ToName:      diff.To.Name,
+		ToVersion:   diff.To.Version,
+	}
+
+	for _, change := range diff.Changes {
+		resp.Changes = append(
+			resp.Changes, &looprpc.LiquidityProfileChange{
+				Field: change.Field,
+				From:  change.From,
+				To:    change.To,
+			},
+		)
+	}
+
+	return resp, nil
+}
+
+// profileRPCError converts the errors returned by liquidity profile calls to
+// rpc status errors.
+func profileRPCError(err error) error {
+	switch {
+	case errors.Is(err, liquidity.ErrNoProfileStore):
+		return status.Error(codes.Unavailable, err.Error())
+
+	case errors.Is(err, liquidity.ErrProfileNotFound),
+		errors.Is(err, liquidity.ErrProfileVersionNotFound):
+
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, liquidity.ErrNoProfileName),
+		errors.Is(err, liquidity.ErrNoEarlierVersion):
+
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	default:
+		return err
+	}
+}
+
+// ListQueuedSwaps returns the swaps that autoloop has added to its approval
+// queue.
+func (s *swapClientServer) ListQueuedSwaps(ctx context.Context,
+	req *looprpc.ListQueuedSwapsRequest) (*looprpc.ListQueuedSwapsResponse,
+	error) {
+
+	queued, err := s.liquidityMgr.ListQueuedSwaps(ctx, req.IncludeResolved)
+	if err != nil {
+		return nil, queueRPCError(err)
+	}
+
+	resp := &looprpc.ListQueuedSwapsResponse{
+		Swaps: make([]*looprpc.QueuedSwap, 0, len(queued)),
+	}
+
+	for _, queuedSwap := range queued {
+		rpcSwap, err := rpcQueuedSwap(queuedSwap)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Swaps = append(resp.Swaps, rpcSwap)
+	}
+
+	return resp, nil
+}
+
+// ApproveQueuedSwap re-quotes a pending queued swap, checks it against our
+// current autoloop budget and dispatches it.
+func (s *swapClientServer) ApproveQueuedSwap(ctx context.Context,
+	req *looprpc.ApproveQueuedSwapRequest) (
+	*looprpc.ApproveQueuedSwapResponse, error) {
+
+	hash, fees, err := s.liquidityMgr.ApproveQueuedSwap(ctx, req.Id)
+	if err != nil {
+		return nil, queueRPCError(err)
+	}
+
+	return &looprpc.ApproveQueuedSwapResponse{
+		SwapHash: hash[:],
+		FeesSat:  uint64(fees),
+	}, nil
+}
+
+// RejectQueuedSwap rejects a pending queued swap, so that it is not
+// dispatched.
+func (s *swapClientServer) RejectQueuedSwap(ctx context.Context,
+	req *looprpc.RejectQueuedSwapRequest) (
+	*looprpc.RejectQueuedSwapResponse, error) {
+
+	err := s.liquidityMgr.RejectQueuedSwap(ctx, req.Id)
+	if err != nil {
+		return nil, queueRPCError(err)
+	}
+
+	return &looprpc.RejectQueuedSwapResponse{}, nil
+}
+
+// rpcQueuedSwap converts a queued swap to its rpc representation.
+func rpcQueuedSwap(queued *liquidity.QueuedSwap) (*looprpc.QueuedSwap,
+	error) {
+
+	rpcSwap := &looprpc.QueuedSwap{
+		Id:         queued.ID,
+		Type:       looprpc.SwapType_LOOP_OUT,
+		Amt:        uint64(queued.Amount),
+		FeesSat:    uint64(queued.Fees),
+		CreatedAt:  queued.Created.Unix(),
+		Expiry:     queued.Expiry.Unix(),
+		UpdatedAt:  queued.Updated.Unix(),
+		DecisionId: queued.DecisionID,
+	}
+
+	if queued.Type == swap.TypeIn {
+		rpcSwap.Type = looprpc.SwapType_LOOP_IN
+	}
+
+	switch queued.State {
+	case liquidity.QueueStatePending:
+		rpcSwap.State = looprpc.QueuedSwapState_QUEUED_SWAP_PENDING
+
+	case liquidity.QueueStateApproved:
+		rpcSwap.State = looprpc.QueuedSwapState_QUEUED_SWAP_APPROVED
+
+	case liquidity.QueueStateRejected:
+		rpcSwap.State = looprpc.QueuedSwapState_QUEUED_SWAP_REJECTED
+
+	case liquidity.QueueStateExpired:
+		rpcSwap.State = looprpc.QueuedSwapState_QUEUED_SWAP_EXPIRED
+
+	default:
+		return nil, fmt.Errorf("unknown queued swap state: %v",
+			queued.State)
+	}
+
+	if queued.Peer != (route.Vertex{}) {
+		rpcSwap.Peer = queued.Peer[:]
+	}
+
+	for _, channel := range queued.Channels {
+		rpcSwap.OutgoingChanSet = append(
+			rpcSwap.OutgoingChanSet, channel.ToUint64(),
+		)
+	}
+
+	if queued.SwapHash != nil {
+		rpcSwap.SwapHash = queued.SwapHash[:]
+	}
+
+	return rpcSwap, nil
+}
+
+// queueRPCError converts the errors returned by approval queue calls to rpc
+// status errors.
+func queueRPCError(err error) error {
+	switch {
+	case errors.Is(err, liquidity.ErrNoQueueStore):
+		return status.Error(codes.Unavailable, err.Error())
+
+	case errors.Is(err, liquidity.ErrQueuedSwapNotFound):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, liquidity.ErrQueuedSwapResolved),
+		errors.Is(err, liquidity.ErrQueuedSwapExpired),
+		errors.Is(err, liquidity.ErrQueuedSwapIneligible):
+
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	default:
+		return err
+	}
+}
+
+// ExportLiquidityParams returns the liquidity manager's parameters as a
+// document that can be edited and imported on other nodes.
+func (s *swapClientServer) ExportLiquidityParams(ctx context.Context,
+	_ *looprpc.ExportLiquidityParamsRequest) (
+	*looprpc.ExportLiquidityParamsResponse, error) {
+
+	doc, err := s.liquidityMgr.ExportParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &looprpc.ExportLiquidityParamsResponse{
+		Document: doc,
+	}, nil
+}
+
+// ImportLiquidityParams validates a liquidity parameters document and returns
+// the parameters that it changes, setting them unless a dry run is requested.
+func (s *swapClientServer) ImportLiquidityParams(ctx context.Context,
+	req *looprpc.ImportLiquidityParamsRequest) (
+	*looprpc.ImportLiquidityParamsResponse, error) {
+
+	changes, err := s.liquidityMgr.ImportParams(
+		ctx, req.Document, req.DryRun,
+	)
+	if err != nil {
+		return nil, documentRPCError(err)
+	}
+
+	resp := &looprpc.ImportLiquidityParamsResponse{}
+	for _, change := range changes {
+		resp.Changes = append(
+			resp.Changes, &looprpc.LiquidityProfileChange{
+				Field: change.Field,
+				From:  change.From,
+				To:    change.To,
+			},
+		)
+	}
+
+	return resp, nil
+}
+
+// documentRPCError converts the errors returned when we import a liquidity
+// parameters document to rpc status errors.
+func documentRPCError(err error) error {
+	switch {
+	case errors.Is(err, liquidity.ErrNoDocumentParams),
+		errors.Is(err, liquidity.ErrDocumentParamsRules),
+		errors.Is(err, liquidity.ErrNoDocumentRule),
+		errors.Is(err, liquidity.ErrDocumentRuleTarget),
+		errors.Is(err, liquidity.ErrNoDocumentRuleTarget),
+		errors.Is(err, liquidity.ErrDocumentNodeTarget),
+		errors.Is(err, liquidity.ErrDocumentAllChannels):
+
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	default:
+		return err
+	}
+}
+
+// ScheduleLoopOut stores a loop out request that is held back until all of
+// the conditions set for it hold.
+func (s *swapClientServer) ScheduleLoopOut(ctx context.Context,
+	req *looprpc.ScheduleLoopOutRequest) (*looprpc.ScheduleLoopOutResponse,
+	error) {
+
+	if req.Request == nil || req.Condition == nil {
+		return nil, status.Error(codes.InvalidArgument,
+			"loop out request and condition required")
+	}
+
+	condition := conditional.Condition{
+		Height: req.Condition.DispatchHeight,
+		MaxFeeRate: chainfee.SatPerKVByte(
+			req.Condition.MaxSweepFeeRateSatPerVbyte * 1000,
+		).FeePerKWeight(),
+	}
+	if req.Condition.DispatchTime != 0 {
+		condition.Time = time.Unix(req.Condition.DispatchTime, 0)
+	}
+
+	id, err := s.conditionalMgr.ScheduleLoopOut(
+		ctx, req.Request, condition,
+	)
+	if err != nil {
+		return nil, conditionalRPCError(err)
+	}
+
+	return &looprpc.ScheduleLoopOutResponse{
+		Id: id,
+	}, nil
+}
+
+// validateScheduledLoopOut checks the parts of a loop out request that won't
+// change until it is dispatched, so that invalid requests are rejected when
+// they are scheduled or split. The request is fully validated when it is
+// dispatched.
+func (s *swapClientServer) validateScheduledLoopOut(
+	req *looprpc.LoopOutRequest) error {
+
+	paymentTimeout := time.Duration(req.PaymentTimeout) * time.Second
+	if paymentTimeout > s.config.TotalPaymentTimeout {
+		return fmt.Errorf("payment timeout %v exceeds maximum "+
+			"allowed timeout of %v", paymentTimeout,
+			s.config.TotalPaymentTimeout)
+	}
+
+	switch {
+	case req.Dest != "" && req.Account != "":
+		return fmt.Errorf("destination address and external " +
+			"account address cannot be set at the same time")
+
+	case req.DestDescriptor != "" && (req.Dest != "" || req.Account != ""):
+		return errDescriptorAndDest
+
+	case req.ChannelOpen != nil && (req.Dest != "" || req.Account != "" ||
+		req.DestDescriptor != ""):
+
+		return errChannelOpenAndDest
+
+	case req.ChannelOpen != nil:
+		if _, _, err := s.validateChannelOpen(req); err != nil {
+			return err
+		}
+
+	case req.DestDescriptor != "":
+		err := s.descriptorMgr.Validate(req.DestDescriptor)
+		if err != nil {
+			return fmt.Errorf("invalid destination descriptor: %w",
+				err)
+		}
+
+	case req.Dest != "":
+		sweepAddr, err := btcutil.DecodeAddress(
+			req.Dest, s.lnd.ChainParams,
+		)
+		if err != nil {
+			return fmt.Errorf("decode address: %w", err)
+		}
+
+		err = validateSweepAddr(s.lnd.ChainParams, sweepAddr)
+		if err != nil {
+			return err
+		}
+
+	case req.Account != "" &&
+		req.AccountAddrType == looprpc.AddressType_ADDRESS_TYPE_UNKNOWN:
+
+		return liquidity.ErrAccountAndAddrType
+	}
+
+	//nolint:staticcheck
+	if req.LoopOutChannel != 0 && len(req.OutgoingChanSet) > 0 {
+		return errors.New("loop_out_channel and outgoing_chan_ids " +
+			"are mutually exclusive")
+	}
+
+	if err := labels.Validate(req.Label); err != nil {
+		return err
+	}
+
+	_, err := validateConfTarget(
+		req.SweepConfTarget, loop.DefaultSweepConfTarget,
+	)
+
+	return err
+}
+
+// ListScheduledLoopOuts returns the loop outs that were scheduled to be
+// dispatched once their conditions hold.
+func (s *swapClientServer) ListScheduledLoopOuts(ctx context.Context,
+	req *looprpc.ListScheduledLoopOutsRequest) (
+	*looprpc.ListScheduledLoopOutsResponse, error) {
+
+	loopOuts, err := s.conditionalMgr.ListLoopOuts(
+		ctx, req.IncludeResolved,
+	)
+	if err != nil {
+		return nil, conditionalRPCError(err)
+	}
+
+	resp := &looprpc.ListScheduledLoopOutsResponse{
+		LoopOuts: make([]*looprpc.ScheduledLoopOut, 0, len(loopOuts)),
+	}
+	for _, loopOut := range loopOuts {
+		rpcLoopOut, err := rpcScheduledLoopOut(loopOut)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.LoopOuts = append(resp.LoopOuts, rpcLoopOut)
+	}
+
+	return resp, nil
+}
+
+// CancelScheduledLoopOut cancels a pending scheduled loop out, so that it is
+// not dispatched.
+func (s *swapClientServer) CancelScheduledLoopOut(ctx context.Context,
+	req *looprpc.CancelScheduledLoopOutRequest) (
+	*looprpc.CancelScheduledLoopOutResponse, error) {
+
+	err := s.conditionalMgr.CancelLoopOut(ctx, req.Id)
+	if err != nil {
+		return nil, conditionalRPCError(err)
+	}
+
+	return &looprpc.CancelScheduledLoopOutResponse{}, nil
+}
+
+// rpcScheduledLoopOut converts a scheduled loop out to its rpc
+// representation.
+func rpcScheduledLoopOut(loopOut *conditional.LoopOut) (
+	*looprpc.ScheduledLoopOut, error) {
+
+	condition := &looprpc.LoopOutCondition{
+		DispatchHeight: loopOut.Condition.Height,
+		MaxSweepFeeRateSatPerVbyte: uint64(
+			loopOut.Condition.MaxFeeRate.FeePerVByte(),
+		),
+	}
+	if !loopOut.Condition.Time.IsZero() {
+		condition.DispatchTime = loopOut.Condition.Time.Unix()
+	}
+
+	var state looprpc.ScheduledLoopOutState
+	switch loopOut.State {
+	case conditional.StatePending:
+		state = looprpc.ScheduledLoopOutState_SCHEDULED_LOOP_OUT_PENDING
+
+	case conditional.StateDispatched:
+		state = looprpc.ScheduledLoopOutState_SCHEDULED_LOOP_OUT_DISPATCHED
+
+	case conditional.StateFailed:
+		state = looprpc.ScheduledLoopOutState_SCHEDULED_LOOP_OUT_FAILED
+
+	case conditional.StateCanceled:
+		state = looprpc.ScheduledLoopOutState_SCHEDULED_LOOP_OUT_CANCELED
+
+	default:
+		return nil, fmt.Errorf("unknown scheduled loop out state: %v",
+			loopOut.State)
+	}
+
+	rpcLoopOut := &looprpc.ScheduledLoopOut{
+		Id:            loopOut.ID,
+		Request:       loopOut.Request,
+		Condition:     condition,
+		State:         state,
+		CreatedAt:     loopOut.Created.Unix(),
+		UpdatedAt:     loopOut.Updated.Unix(),
+		FailureReason: loopOut.FailureReason,
+	}
+
+	if loopOut.SwapHash != nil {
+		rpcLoopOut.SwapHash = loopOut.SwapHash[:]
+	}
+
+	return rpcLoopOut, nil
+}
+
+// conditionalRPCError converts the errors returned by our conditional loop
+// out manager to rpc status errors.
+func conditionalRPCError(err error) error {
+	switch {
+	case errors.Is(err, conditional.ErrNoRequest),
+		errors.Is(err, conditional.ErrNoCondition),
+		errors.Is(err, conditional.ErrAssetLoopOut),
+		errors.Is(err, conditional.ErrSplitLoopOut),
+		errors.Is(err, conditional.ErrInvalidRequest):
+
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, conditional.ErrLoopOutNotFound):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, conditional.ErrLoopOutResolved):
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	default:
+		return err
+	}
+}
+
+// AddSwapSchedule creates a recurring swap schedule, which dispatches a loop
+// out or loop in of a fixed amount at a regular interval or whenever a cron
+// expression matches.
+func (s *swapClientServer) AddSwapSchedule(ctx context.Context,
+	req *looprpc.AddSwapScheduleRequest) (*looprpc.AddSwapScheduleResponse,
+	error) {
+
+	if req.Schedule == nil {
+		return nil, status.Error(codes.InvalidArgument,
+			"schedule required")
+	}
+
+	schedule, err := s.unmarshalSwapSchedule(req.Schedule)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	schedule, err = s.recurringMgr.CreateSchedule(ctx, schedule)
+	if err != nil {
+		return nil, recurringRPCError(err)
+	}
+
+	rpcSchedule, err := rpcSwapSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &looprpc.AddSwapScheduleResponse{
+		Schedule: rpcSchedule,
+	}, nil
+}
+
+// ListSwapSchedules returns all recurring swap schedules.
+func (s *swapClientServer) ListSwapSchedules(ctx context.Context,
+	_ *looprpc.ListSwapSchedulesRequest) (
+	*looprpc.ListSwapSchedulesResponse, error) {
+
+	schedules, err := s.recurringMgr.ListSchedules(ctx)
+	if err != nil {
+		return nil, recurringRPCError(err)
+	}
+
+	resp := &looprpc.ListSwapSchedulesResponse{
+		Schedules: make([]*looprpc.SwapSchedule, 0, len(schedules)),
+	}
+	for _, schedule := range schedules {
+		rpcSchedule, err := rpcSwapSchedule(schedule)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Schedules = append(resp.Schedules, rpcSchedule)
+	}
+
+	return resp, nil
+}
+
+// ListSwapScheduleRuns returns the most recent runs of a recurring swap
+// schedule.
+func (s *swapClientServer) ListSwapScheduleRuns(ctx context.Context,
+	req *looprpc.ListSwapScheduleRunsRequest) (
+	*looprpc.ListSwapScheduleRunsResponse, error) {
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultScheduleRunsLimit
+	}
+
+	runs, err := s.recurringMgr.ListRuns(ctx, req.Name, limit)
+	if err != nil {
+		return nil, recurringRPCError(err)
+	}
+
+	resp := &looprpc.ListSwapScheduleRunsResponse{
+		Runs: make([]*looprpc.SwapScheduleRun, 0, len(runs)),
+	}
+	for _, run := range runs {
+		rpcRun := &looprpc.SwapScheduleRun{
+			RunTime: run.Time.Unix(),
+			Error:   run.Error,
+		}
+		if run.SwapHash != nil {
+			rpcRun.SwapHash = run.SwapHash[:]
+		}
+
+		resp.Runs = append(resp.Runs, rpcRun)
+	}
+
+	return resp, nil
+}
+
+// PauseSwapSchedule pauses a recurring swap schedule.
+func (s *swapClientServer) PauseSwapSchedule(ctx context.Context,
+	req *looprpc.PauseSwapScheduleRequest) (
+	*looprpc.PauseSwapScheduleResponse, error) {
+
+	schedule, err := s.recurringMgr.PauseSchedule(ctx, req.Name)
+	if err != nil {
+		return nil, recurringRPCError(err)
+	}
+
+	rpcSchedule, err := rpcSwapSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &looprpc.PauseSwapScheduleResponse{
+		Schedule: rpcSchedule,
+	}, nil
+}
+
+// ResumeSwapSchedule resumes a paused recurring swap schedule.
+func (s *swapClientServer) ResumeSwapSchedule(ctx context.Context,
+	req *looprpc.ResumeSwapScheduleRequest) (
+	*looprpc.ResumeSwapScheduleResponse, error) {
+
+	schedule, err := s.recurringMgr.ResumeSchedule(ctx, req.Name)
+	if err != nil {
+		return nil, recurringRPCError(err)
+	}
+
+	rpcSchedule, err := rpcSwapSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &looprpc.ResumeSwapScheduleResponse{
+		Schedule: rpcSchedule,
+	}, nil
+}
+
+// DeleteSwapSchedule deletes a recurring swap schedule along with the record
+// of its runs.
+func (s *swapClientServer) DeleteSwapSchedule(ctx context.Context,
+	req *looprpc.DeleteSwapScheduleRequest) (
+	*looprpc.DeleteSwapScheduleResponse, error) {
+
+	err := s.recurringMgr.DeleteSchedule(ctx, req.Name)
+	if err != nil {
+		return nil, recurringRPCError(err)
+	}
+
+	return &looprpc.DeleteSwapScheduleResponse{}, nil
+}
+
+// unmarshalSwapSchedule converts a schedule from its rpc representation, and
+// checks the parts of it that the schedule manager does not.
+func (s *swapClientServer) unmarshalSwapSchedule(
+	in *looprpc.SwapSchedule) (*recurring.Schedule, error) {
+
+	schedule := &recurring.Schedule{
+		Name:                in.Name,
+		Amount:              btcutil.Amount(in.Amt),
+		Dest:                in.Dest,
+		Account:             in.Account,
+		MaxSwapFee:          btcutil.Amount(in.MaxSwapFee),
+		MaxMinerFee:         btcutil.Amount(in.MaxMinerFee),
+		MaxPrepayAmount:     btcutil.Amount(in.MaxPrepayAmt),
+		MaxSwapRoutingFee:   btcutil.Amount(in.MaxSwapRoutingFee),
+		MaxPrepayRoutingFee: btcutil.Amount(in.MaxPrepayRoutingFee),
+		ConfTarget:          in.ConfTarget,
+		Cron:                in.Cron,
+		Label:               in.Label,
+		MaxFailures:         in.MaxFailures,
+	}
+
+	var defaultConfTarget int32
+	switch in.Type {
+	case looprpc.SwapType_LOOP_OUT:
+		schedule.Type = swap.TypeOut
+		defaultConfTarget = loop.DefaultSweepConfTarget
+
+	case looprpc.SwapType_LOOP_IN:
+		schedule.Type = swap.TypeIn
+		defaultConfTarget = loop.DefaultHtlcConfTarget
+
+	default:
+		return nil, fmt.Errorf("unknown swap type: %v", in.Type)
+	}
+
+	_, err := validateConfTarget(in.ConfTarget, defaultConfTarget)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.IntervalSec > uint64(math.MaxInt64/time.Second) {
+		return nil, fmt.Errorf("interval too long: %v seconds",
+			in.IntervalSec)
+	}
+	schedule.Interval = time.Duration(in.IntervalSec) * time.Second
+
+	if in.StartTime != 0 {
+		schedule.Start = time.Unix(in.StartTime, 0)
+	}
+
+	if err := labels.Validate(in.Label); err != nil {
+		return nil, err
+	}
+
+	if in.Dest != "" {
+		sweepAddr, err := btcutil.DecodeAddress(
+			in.Dest, s.lnd.ChainParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("decode address: %w", err)
+		}
+
+		err = validateSweepAddr(s.lnd.ChainParams, sweepAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if in.Account != "" {
+		unknown := looprpc.AddressType_ADDRESS_TYPE_UNKNOWN
+		if in.AccountAddrType == unknown {
+			return nil, liquidity.ErrAccountAndAddrType
+		}
+
+		schedule.AccountAddrType, err = toWalletAddrType(
+			in.AccountAddrType,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return schedule, nil
+}
+
+// rpcSwapSchedule converts a recurring swap schedule to its rpc
+// representation.
+func rpcSwapSchedule(schedule *recurring.Schedule) (*looprpc.SwapSchedule,
+	error) {
+
+	rpcSchedule := &looprpc.SwapSchedule{
+		Name:                schedule.Name,
+		Amt:                 int64(schedule.Amount),
+		Dest:                schedule.Dest,
+		Account:             schedule.Account,
+		MaxSwapFee:          int64(schedule.MaxSwapFee),
+		MaxMinerFee:         int64(schedule.MaxMinerFee),
+		MaxPrepayAmt:        int64(schedule.MaxPrepayAmount),
+		MaxSwapRoutingFee:   int64(schedule.MaxSwapRoutingFee),
+		MaxPrepayRoutingFee: int64(schedule.MaxPrepayRoutingFee),
+		ConfTarget:          schedule.ConfTarget,
+		IntervalSec:         uint64(schedule.Interval.Seconds()),
+		Cron:                schedule.Cron,
+		StartTime:           schedule.Start.Unix(),
+		Label:               schedule.Label,
+		MaxFailures:         schedule.MaxFailures,
+		ConsecutiveFailures: schedule.ConsecutiveFailures,
+		NextRun:             schedule.NextRun.Unix(),
+		CreatedAt:           schedule.Created.Unix(),
+	}
+
+	switch schedule.Type {
+	case swap.TypeOut:
+		rpcSchedule.Type = looprpc.SwapType_LOOP_OUT
+
+	case swap.TypeIn:
+		rpcSchedule.Type = looprpc.SwapType_LOOP_IN
+
+	default:
+		return nil, fmt.Errorf("unknown swap type: %v", schedule.Type)
+	}
+
+	if schedule.AccountAddrType == walletrpc.AddressType_TAPROOT_PUBKEY {
+		rpcSchedule.AccountAddrType = looprpc.AddressType_TAPROOT_PUBKEY
+	}
+
+	var state looprpc.SwapScheduleState
+	switch schedule.State {
+	case recurring.StateActive:
+		state = looprpc.SwapScheduleState_SWAP_SCHEDULE_ACTIVE
+
+	case recurring.StatePaused:
+		state = looprpc.SwapScheduleState_SWAP_SCHEDULE_PAUSED
+
+	default:
+		return nil, fmt.Errorf("unknown swap schedule state: %v",
+			schedule.State)
+	}
+	rpcSchedule.State = state
+
+	return rpcSchedule, nil
+}
+
+// recurringRPCError converts the errors returned by our recurring swap
+// manager to rpc status errors.
+func recurringRPCError(err error) error {
+	switch {
+	case errors.Is(err, recurring.ErrNoName),
+		errors.Is(err, recurring.ErrNoAmount),
+		errors.Is(err, recurring.ErrNoRecurrence),
+		errors.Is(err, recurring.ErrInvalidCron),
+		errors.Is(err, recurring.ErrIntervalTooShort),
+		errors.Is(err, recurring.ErrDestAndAccount),
+		errors.Is(err, recurring.ErrLoopInDest),
+		errors.Is(err, recurring.ErrLoopInLimits),
+		errors.Is(err, recurring.ErrMissingLimits),
+		errors.Is(err, recurring.ErrAccountAddrType):
+
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, recurring.ErrScheduleNotFound),
+		errors.Is(err, recurring.ErrAccountNotFound):
+
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, recurring.ErrScheduleExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+
+	case errors.Is(err, recurring.ErrAlreadyActive),
+		errors.Is(err, recurring.ErrAlreadyPaused):
+
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	default:
+		return err
+	}
+}
+
+// splitLoopOut splits a loop out request into several loop outs that are
+// tracked under a group, and returns the response of the first part that was
+// dispatched.
+func (s *swapClientServer) splitLoopOut(ctx context.Context,
+	in *looprpc.LoopOutRequest) (*looprpc.SwapResponse, error) {
+
+	if err := s.validateScheduledLoopOut(in); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	group, resp, err := s.splitMgr.LoopOut(ctx, in)
+	if err != nil {
+		errorf("Split LoopOut: %v", err)
+		return nil, splitRPCError(err)
+	}
+
+	resp.SplitGroupId = group.ID
+	resp.SplitParts = uint32(len(group.Parts))
+
+	return resp, nil
+}
+
+// rpcSplitGroup converts a split loop out group to its rpc representation.
+func rpcSplitGroup(group *split.Group,
+	swapState func(lntypes.Hash) (loopdb.SwapState,
+		bool)) *looprpc.SplitGroup {
+
+	groupState, swapped := group.Status(swapState)
+
+	var state looprpc.SplitGroupState
+	switch groupState {
+	case split.GroupInProgress:
+		state = looprpc.SplitGroupState_SPLIT_GROUP_IN_PROGRESS
+
+	case split.GroupSucceeded:
+		state = looprpc.SplitGroupState_SPLIT_GROUP_SUCCEEDED
+
+	case split.GroupFailed:
+		state = looprpc.SplitGroupState_SPLIT_GROUP_FAILED
+
+	case split.GroupPartiallySucceeded:
+		state = looprpc.SplitGroupState_SPLIT_GROUP_PARTIALLY_SUCCEEDED
+	}
+
+	rpcGroup := &looprpc.SplitGroup{
+		Id:         group.ID,
+		Amt:        int64(group.Amount),
+		Sequential: group.Sequential,
+		State:      state,
+		AmtSwapped: int64(swapped),
+		CreatedAt:  group.Created.Unix(),
+		Parts:      make([]*looprpc.SplitPart, 0, len(group.Parts)),
+	}
+
+	for _, part := range group.Parts {
+		var partState looprpc.SplitPartState
+		switch part.State {
+		case split.PartPending:
+			partState = looprpc.SplitPartState_SPLIT_PART_PENDING
+
+		case split.PartDispatched:
+			partState = looprpc.SplitPartState_SPLIT_PART_DISPATCHED
+
+		case split.PartFailed:
+			partState = looprpc.SplitPartState_SPLIT_PART_FAILED
+
+		case split.PartSkipped:
+			partState = looprpc.SplitPartState_SPLIT_PART_SKIPPED
+		}
+
+		rpcPart := &looprpc.SplitPart{
+			Index: part.Index,
+			Amt:   int64(part.Amount),
+			State: partState,
+			Error: part.Error,
+		}
+		if part.SwapHash != nil {
+			rpcPart.SwapHash = part.SwapHash[:]
+		}
+
+		rpcGroup.Parts = append(rpcGroup.Parts, rpcPart)
+	}
+
+	return rpcGroup
+}
+
+// splitRPCError converts the errors returned by our split loop out manager
+// to rpc errors with a status code.
+func splitRPCError(err error) error {
+	switch {
+	case errors.Is(err, split.ErrAssetSplit),
+		errors.Is(err, split.ErrReservationSplit),
+		errors.Is(err, split.ErrAmountTooLow),
+		errors.Is(err, split.ErrPartAmountTooLow):
+
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, split.ErrInsufficientBalance):
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	default:
+		return err
+	}
+}
+
+// setRpcFailureBackoff adds the failure backoff information of a disqualified
+// channel or peer to its rpc representation, if present.
+func setRpcFailureBackoff(disqualified *looprpc.Disqualified,
+	backoff *liquidity.FailureBackoff) {
+
+	if backoff == nil {
+		return
+	}
+
+	disqualified.FailureCount = uint32(backoff.Failures)
+	disqualified.FailureBackoffSec = uint64(backoff.Backoff.Seconds())
+}
+
+// ListReservations lists all existing reservations the client has ever made.
+func (s *swapClientServer) ListReservations(ctx context.Context,
+	_ *looprpc.ListReservationsRequest) (
+	*looprpc.ListReservationsResponse, error) {
+
+	if s.reservationManager == nil {
+		return nil, status.Error(codes.Unimplemented,
+			"Restart loop with --experimental")
+	}
+	reservations, err := s.reservationManager.GetReservations(
+		ctx,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &looprpc.ListReservationsResponse{
+		Reservations: ToClientReservations(
+			reservations,
+		),
+	}, nil
+}
+
+// InstantOut initiates an instant out swap.
+func (s *swapClientServer) InstantOut(ctx context.Context,
+	req *looprpc.InstantOutRequest) (*looprpc.InstantOutResponse,
+	error) {
+
+	reservationIds := make([]reservation.ID, len(req.ReservationIds))
+	for i, id := range req.ReservationIds {
+		if len(id) != reservation.IdLength {
+			return nil, fmt.Errorf("invalid reservation id: "+
+				"expected %v bytes, got %d",
+				reservation.IdLength, len(id))
+		}
+
+		var resId reservation.ID
+		copy(resId[:], id)
+
+		reservationIds[i] = resId
+	}
+
+	instantOutFsm, err := s.instantOutManager.NewInstantOut(
+		ctx, reservationIds, req.DestAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &looprpc.InstantOutResponse{
+		InstantOutHash: instantOutFsm.InstantOut.SwapHash[:],
+		State:          string(instantOutFsm.InstantOut.State),
+	}
+
+	if instantOutFsm.InstantOut.SweepTxHash != nil {
+		res.SweepTxId = instantOutFsm.InstantOut.SweepTxHash.String()
+	}
+
+	return res, nil
+}
+
+// InstantOutQuote returns a quote for an instant out swap with the provided
+// parameters.
+func (s *swapClientServer) InstantOutQuote(ctx context.Context,
+	req *looprpc.InstantOutQuoteRequest) (
+	*looprpc.InstantOutQuoteResponse, error) {
+
+	quote, err := s.instantOutManager.GetInstantOutQuote(
+		ctx, btcutil.Amount(req.Amt), req.ReservationIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &looprpc.InstantOutQuoteResponse{
+		ServiceFeeSat: int64(quote.ServiceFee),
+		SweepFeeSat:   int64(quote.OnChainFee),
+	}, nil
+}
+
+// ListInstantOuts returns a list of all currently known instant out swaps and
+// their current status.
+func (s *swapClientServer) ListInstantOuts(ctx context.Context,
+	_ *looprpc.ListInstantOutsRequest) (
+	*looprpc.ListInstantOutsResponse, error) {
+
+	instantOuts, err := s.instantOutManager.ListInstantOuts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSwaps := make([]*looprpc.InstantOut, 0, len(instantOuts))
+	for _, instantOut := range instantOuts {
+		rpcSwaps = append(rpcSwaps, rpcInstantOut(instantOut))
+	}
+
+	return &looprpc.ListInstantOutsResponse{
+		Swaps: rpcSwaps,
+	}, nil
+}
+
+func rpcInstantOut(instantOut *instantout.InstantOut) *looprpc.InstantOut {
+	var sweepTxId string
+	if instantOut.SweepTxHash != nil {
+		sweepTxId = instantOut.SweepTxHash.String()
+	}
+
+	reservations := make([][]byte, len(instantOut.Reservations))
+	for i, res := range instantOut.Reservations {
+		reservations[i] = res.ID[:]
+	}
+
+	return &looprpc.InstantOut{
+		SwapHash:       instantOut.SwapHash[:],
+		State:          string(instantOut.State),
+		Amount:         uint64(instantOut.Value),
+		SweepTxId:      sweepTxId,
+		ReservationIds: reservations,
+	}
+}
+
+// NewStaticAddress is the rpc endpoint for loop clients to request a new static
+// address.
+func (s *swapClientServer) NewStaticAddress(ctx context.Context,
+	_ *looprpc.NewStaticAddressRequest) (
 	*looprpc.NewStaticAddressResponse, error) {
 
 	staticAddress, expiry, err := s.staticAddressManager.NewAddress(ctx)
@@ -1862,6 +3569,13 @@ func (s *swapClientServer) StaticAddressLoopIn(ctx context.Context,
 
 	infof("Static loop-in request received")
 
+	// Check that the label is valid. We validate labels here rather than
+	// in the loop-in manager so that autoloop can dispatch swaps with
+	// reserved labels.
+	if err := labels.Validate(in.Label); err != nil {
+		return nil, fmt.Errorf("invalid label: %w", err)
+	}
+
 	routeHints, err := unmarshallRouteHints(in.RouteHints)
 	if err != nil {
 		return nil, err
@@ -2109,6 +3823,27 @@ func rpcAutoloopReason(reason liquidity.Reason) (looprpc.AutoReason, error) {
 	case liquidity.ReasonFeePPMInsufficient:
 		return looprpc.AutoReason_AUTO_REASON_SWAP_FEE, nil
 
+	case liquidity.ReasonOutsideSchedule:
+		return looprpc.AutoReason_AUTO_REASON_OUTSIDE_SCHEDULE, nil
+
+	case liquidity.ReasonFeeRateTooHigh:
+		return looprpc.AutoReason_AUTO_REASON_FEE_RATE_TOO_HIGH, nil
+
+	case liquidity.ReasonTargetBudget:
+		return looprpc.AutoReason_AUTO_REASON_TARGET_BUDGET, nil
+
+	case liquidity.ReasonNoStaticDeposits:
+		return looprpc.AutoReason_AUTO_REASON_NO_STATIC_DEPOSITS, nil
+
+	case liquidity.ReasonChannelTooYoung:
+		return looprpc.AutoReason_AUTO_REASON_CHANNEL_TOO_YOUNG, nil
+
+	case liquidity.ReasonPeerUnreliable:
+		return looprpc.AutoReason_AUTO_REASON_PEER_UNRELIABLE, nil
+
+	case liquidity.ReasonInsufficientRevenue:
+		return looprpc.AutoReason_AUTO_REASON_INSUFFICIENT_REVENUE, nil
+
 	default:
 		return 0, fmt.Errorf("unknown autoloop reason: %v", reason)
 	}
@@ -2145,6 +3880,19 @@ func (s *swapClientServer) processStatusUpdates(mainCtx context.Context) {
 	}
 }
 
+// swapState returns the current state of the swap with the hash provided, and
+// false if the swap is not known.
+func (s *swapClientServer) swapState(hash lntypes.Hash) (loopdb.SwapState,
+	bool) {
+
+	s.swapsLock.Lock()
+	defer s.swapsLock.Unlock()
+
+	swp, ok := s.swaps[hash]
+
+	return swp.State, ok
+}
+
 // validateConfTarget ensures the given confirmation target is valid. If one
 // isn't specified (0 value), then the default target is used.
 func validateConfTarget(target, defaultTarget int32) (int32, error) {
@@ -2194,17 +3942,15 @@ func validateLoopInRequest(htlcConfTarget int32, external bool,
 	return validateConfTarget(htlcConfTarget, loop.DefaultHtlcConfTarget)
 }
 
-// validateLoopOutRequest validates the confirmation target, destination
-// address and label of the loop out request. It also checks that the requested
-// loop amount is valid given the available balance.
-func validateLoopOutRequest(ctx context.Context, lnd lndclient.LightningClient,
-	chainParams *chaincfg.Params, req *looprpc.LoopOutRequest,
-	sweepAddr btcutil.Address, maxParts uint32) (int32, error) {
+// validateSweepAddr checks that a loop out sweep address is for the active
+// network and has a supported address format.
+func validateSweepAddr(chainParams *chaincfg.Params,
+	sweepAddr btcutil.Address) error {
 
 	// Check that the provided destination address has the correct format
 	// for the active network.
 	if !sweepAddr.IsForNet(chainParams) {
-		return 0, fmt.Errorf("%w: Current active network is %s",
+		return fmt.Errorf("%w: Current active network is %s",
 			errIncorrectChain, chainParams.Name)
 	}
 
@@ -2217,8 +3963,28 @@ func validateLoopOutRequest(ctx context.Context, lnd lndclient.LightningClient,
 		*btcutil.AddressScriptHash,
 		*btcutil.AddressPubKeyHash:
 
+		return nil
+
 	default:
-		return 0, errInvalidAddress
+		return errInvalidAddress
+	}
+}
+
+// validateLoopOutRequest validates the confirmation target, destination
+// address and label of the loop out request. It also checks that the requested
+// loop amount is valid given the available balance.
+func validateLoopOutRequest(ctx context.Context, lnd lndclient.LightningClient,
+	chainParams *chaincfg.Params, req *looprpc.LoopOutRequest,
+	sweepAddr btcutil.Address, maxParts uint32) (int32, error) {
+
+	// The sweep address is not set yet for loop outs to a destination
+	// descriptor, whose addresses are derived for our network once the
+	// request is validated.
+	if sweepAddr != nil {
+		err := validateSweepAddr(chainParams, sweepAddr)
+		if err != nil {
+			return 0, err
+		}
 	}
 
 	// If this is an asset payment, we'll check that we have the necessary
@@ -3,19 +3,25 @@ package loopd
 import (
 	"context"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btclog/v2"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/chanopen"
+	"github.com/lightninglabs/loop/descriptor"
 	"github.com/lightninglabs/loop/labels"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/looprpc"
 	"github.com/lightninglabs/loop/swap"
 	mock_lnd "github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -891,3 +897,131 @@ func TestListSwapsFilterAndPagination(t *testing.T) {
 		})
 	}
 }
+
+// TestLoopOutDescriptorValidation tests that loop outs to a destination
+// descriptor only derive an address once the request is validated.
+func TestLoopOutDescriptorValidation(t *testing.T) {
+	ctx := context.Background()
+	lnd := mock_lnd.NewMockLnd()
+
+	logger := btclog.NewSLogger(btclog.NewDefaultHandler(os.Stdout))
+	setLogger(logger.SubSystem(Subsystem))
+
+	testDb := loopdb.NewTestDB(t)
+	defer testDb.Close()
+
+	descriptorMgr := descriptor.NewManager(&descriptor.Config{
+		Store: descriptor.NewSQLStore(
+			loopdb.NewTypedStore[descriptor.Querier](testDb),
+		),
+		ChainParams: lnd.ChainParams,
+		Clock:       clock.NewDefaultClock(),
+	})
+
+	master, err := hdkeychain.NewMaster(
+		make([]byte, hdkeychain.RecommendedSeedLen), lnd.ChainParams,
+	)
+	require.NoError(t, err)
+
+	xpub, err := master.Neuter()
+	require.NoError(t, err)
+
+	desc := "wpkh(" + xpub.String() + "/0/*)"
+
+	server := &swapClientServer{
+		config: &Config{
+			TotalPaymentTimeout: time.Minute,
+		},
+		impl:          &loop.Client{},
+		lnd:           &lnd.LndServices,
+		descriptorMgr: descriptorMgr,
+	}
+
+	_, err = server.LoopOut(ctx, &looprpc.LoopOutRequest{
+		Amt:            100_000,
+		DestDescriptor: desc,
+		Label:          strings.Repeat("x", labels.MaxLength+1),
+	})
+	require.ErrorIs(t, err, labels.ErrLabelTooLong)
+
+	// The failed request did not use up the first address of the
+	// descriptor.
+	parsed, err := descriptor.Parse(desc, lnd.ChainParams)
+	require.NoError(t, err)
+
+	first, err := parsed.Derive(0)
+	require.NoError(t, err)
+
+	addr, err := descriptorMgr.NextAddr(ctx, desc)
+	require.NoError(t, err)
+	require.Equal(t, first, addr)
+}
+
+// TestValidateScheduledLoopOut tests that the channels that scheduled loop
+// outs open are validated when they are scheduled.
+func TestValidateScheduledLoopOut(t *testing.T) {
+	lnd := mock_lnd.NewMockLnd()
+
+	_, pubKey := btcec.PrivKeyFromBytes([]byte{1})
+	peer := pubKey.SerializeCompressed()
+
+	server := &swapClientServer{
+		config: &Config{
+			TotalPaymentTimeout: time.Minute,
+		},
+		lnd: &lnd.LndServices,
+	}
+
+	tests := []struct {
+		name string
+		req  *looprpc.LoopOutRequest
+		err  error
+	}{
+		{
+			name: "valid channel open",
+			req: &looprpc.LoopOutRequest{
+				Amt: 1_000_000,
+				ChannelOpen: &looprpc.LoopOutChannelOpen{
+					NodePubkey: peer,
+				},
+			},
+		},
+		{
+			name: "channel open and destination",
+			req: &looprpc.LoopOutRequest{
+				Amt:  1_000_000,
+				Dest: "bcrt1qdest",
+				ChannelOpen: &looprpc.LoopOutChannelOpen{
+					NodePubkey: peer,
+				},
+			},
+			err: errChannelOpenAndDest,
+		},
+		{
+			name: "channel too small",
+			req: &looprpc.LoopOutRequest{
+				Amt: 10_000,
+				ChannelOpen: &looprpc.LoopOutChannelOpen{
+					NodePubkey: peer,
+				},
+			},
+			err: chanopen.ErrCapacityTooLow,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := server.validateScheduledLoopOut(testCase.req)
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+
+	// A channel peer that is not a valid public key is rejected.
+	err := server.validateScheduledLoopOut(&looprpc.LoopOutRequest{
+		Amt: 1_000_000,
+		ChannelOpen: &looprpc.LoopOutChannelOpen{
+			NodePubkey: []byte{1, 2, 3},
+		},
+	})
+	require.ErrorContains(t, err, "invalid channel peer")
+}
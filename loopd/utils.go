@@ -3,6 +3,7 @@ package loopd
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -10,18 +11,26 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/assets"
+	"github.com/lightninglabs/loop/descriptor"
 	"github.com/lightninglabs/loop/liquidity"
 	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/staticaddr/address"
+	"github.com/lightninglabs/loop/staticaddr/deposit"
+	"github.com/lightninglabs/loop/staticaddr/loopin"
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/sweepbatcher"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/ticker"
 )
 
 // getClient returns an instance of the swap client.
 func getClient(cfg *Config, swapDb loopdb.SwapStore,
 	sweeperDb sweepbatcher.BatcherStore, lnd *lndclient.LndServices,
-	assets *assets.TapdClient) (*loop.Client, func(), error) {
+	assets *assets.TapdClient,
+	channelFunder sweepbatcher.ChannelFunder) (*loop.Client, func(),
+	error) {
 
 	// Default is not set for MaxLSATCost and MaxLSATFee to distinguish
 	// it from user explicitly setting the option to default value.
@@ -55,6 +64,7 @@ func getClient(cfg *Config, swapDb loopdb.SwapStore,
 		MaxPaymentRetries:                    cfg.MaxPaymentRetries,
 		MaxStaticAddrHtlcFeePercentage:       cfg.MaxStaticAddrHtlcFeePercentage,
 		MaxStaticAddrHtlcBackupFeePercentage: cfg.MaxStaticAddrHtlcBackupFeePercentage,
+		ChannelFunder:                        channelFunder,
 	}
 
 	if cfg.MaxL402Cost == defaultCost && cfg.MaxLSATCost != 0 {
@@ -115,7 +125,13 @@ func openDatabase(cfg *Config, chainParams *chaincfg.Params) (loopdb.SwapStore,
 	return db, &baseDb, nil
 }
 
-func getLiquidityManager(client *loop.Client) *liquidity.Manager {
+func getLiquidityManager(client *loop.Client,
+	decisionStore liquidity.DecisionStore, decisionRetention time.Duration,
+	profileStore liquidity.ProfileStore, queueStore liquidity.QueueStore,
+	staticAddressManager *address.Manager, depositManager *deposit.Manager,
+	staticLoopInManager *loopin.Manager,
+	descriptorMgr *descriptor.Manager) *liquidity.Manager {
+
 	mngrCfg := &liquidity.Config{
 		AutoloopTicker: ticker.NewForce(liquidity.DefaultAutoloopTicker),
 		LoopOut:        client.LoopOut,
@@ -153,10 +169,138 @@ func getLiquidityManager(client *loop.Client) *liquidity.Manager {
 		LoopInTerms:          client.LoopInTerms,
 		LoopOutTerms:         client.LoopOutTerms,
 		GetAssetPrice:        client.AssetClient.GetAssetPrice,
+		NextDescriptorAddr:   descriptorMgr.NextAddr,
 		MinimumConfirmations: minConfTarget,
 		PutLiquidityParams:   client.Store.PutLiquidityParams,
 		FetchLiquidityParams: client.Store.FetchLiquidityParams,
+		DecisionStore:        decisionStore,
+		DecisionRetention:    decisionRetention,
+		ListStaticDeposits: func(ctx context.Context) (
+			[]*liquidity.StaticDeposit, error) {
+
+			return listStaticDeposits(
+				ctx, client.LndServices, staticAddressManager,
+				depositManager,
+			)
+		},
+		ListStaticLoopIns: func(ctx context.Context) (
+			[]*liquidity.StaticLoopInSwap, error) {
+
+			return listStaticLoopIns(ctx, staticLoopInManager)
+		},
+		StaticLoopIn: func(ctx context.Context,
+			req *loop.StaticAddressLoopInRequest) (lntypes.Hash,
+			error) {
+
+			loopIn, err := staticLoopInManager.DeliverLoopInRequest(
+				ctx, req,
+			)
+			if err != nil {
+				return lntypes.Hash{}, err
+			}
+
+			return loopIn.SwapHash, nil
+		},
+		ProfileStore: profileStore,
+		QueueStore:   queueStore,
 	}
 
 	return liquidity.NewManager(mngrCfg)
 }
+
+// listStaticDeposits returns the static address deposits that are in the
+// deposited state, along with the number of blocks until each expires.
+func listStaticDeposits(ctx context.Context, lnd *lndclient.LndServices,
+	staticAddressManager *address.Manager,
+	depositManager *deposit.Manager) ([]*liquidity.StaticDeposit, error) {
+
+	deposits, err := depositManager.GetActiveDepositsInState(
+		deposit.Deposited,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(deposits) == 0 {
+		return nil, nil
+	}
+
+	info, err := lnd.Client.GetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := staticAddressManager.GetStaticAddressParameters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	staticDeposits := make([]*liquidity.StaticDeposit, len(deposits))
+	for i, d := range deposits {
+		staticDeposits[i] = &liquidity.StaticDeposit{
+			OutPoint: d.OutPoint,
+			Value:    d.Value,
+			BlocksUntilExpiry: d.ConfirmationHeight +
+				int64(params.Expiry) - int64(info.BlockHeight),
+		}
+	}
+
+	return staticDeposits, nil
+}
+
+// listStaticLoopIns returns our static address loop in swaps, with their
+// states mapped to the loop in states that the liquidity manager uses to
+// account for swaps.
+func listStaticLoopIns(ctx context.Context,
+	staticLoopInManager *loopin.Manager) ([]*liquidity.StaticLoopInSwap,
+	error) {
+
+	swaps, err := staticLoopInManager.GetAllSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	staticLoopIns := make([]*liquidity.StaticLoopInSwap, len(swaps))
+	for i, s := range swaps {
+		staticLoopIn := &liquidity.StaticLoopInSwap{
+			Hash:           s.SwapHash,
+			Label:          s.Label,
+			Initiator:      s.Initiator,
+			MaxSwapFee:     s.MaxSwapFee,
+			InitiationTime: s.InitiationTime,
+		}
+
+		if len(s.LastHop) != 0 {
+			lastHop, err := route.NewVertexFromBytes(s.LastHop)
+			if err != nil {
+				return nil, err
+			}
+
+			staticLoopIn.LastHop = &lastHop
+		}
+
+		switch s.GetState() {
+		// Once the payment is received the swap is complete from our
+		// perspective, but it is still being finalized.
+		case loopin.PaymentReceived:
+			staticLoopIn.State = loopdb.StateInvoiceSettled
+
+		case loopin.Succeeded, loopin.SucceededTransitioningFailed:
+			staticLoopIn.State = loopdb.StateSuccess
+			staticLoopIn.SwapFee = s.QuotedSwapFee
+
+		case loopin.HtlcTimeoutSwept:
+			staticLoopIn.State = loopdb.StateFailTimeout
+
+		case loopin.Failed:
+			staticLoopIn.State = loopdb.StateFailAbandoned
+
+		default:
+			staticLoopIn.State = loopdb.StateInitiated
+		}
+
+		staticLoopIns[i] = staticLoopIn
+	}
+
+	return staticLoopIns, nil
+}
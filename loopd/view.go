@@ -47,7 +47,7 @@ func view(config *Config, lisCfg *ListenerCfg) error {
 	}
 
 	swapClient, cleanup, err := getClient(
-		config, swapDb, sweeperDb, &lnd.LndServices, assetClient,
+		config, swapDb, sweeperDb, &lnd.LndServices, assetClient, nil,
 	)
 	if err != nil {
 		return err
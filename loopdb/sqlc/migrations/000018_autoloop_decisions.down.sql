@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS autoloop_decision_targets_peer_idx;
+DROP INDEX IF EXISTS autoloop_decision_targets_channel_id_idx;
+DROP INDEX IF EXISTS autoloop_decision_targets_decision_id_idx;
+DROP TABLE IF EXISTS autoloop_decision_targets;
+DROP INDEX IF EXISTS autoloop_decisions_time_idx;
+DROP TABLE IF EXISTS autoloop_decisions;
@@ -0,0 +1,67 @@
+-- autoloop_decisions stores the outcome of each autoloop run, along with a
+-- snapshot of the autoloop budget at the time of the run.
+CREATE TABLE IF NOT EXISTS autoloop_decisions (
+    -- id is the auto-incrementing primary key for a decision.
+    id INTEGER PRIMARY KEY,
+
+    -- decision_time is the time at which autoloop ran.
+    decision_time TIMESTAMP NOT NULL,
+
+    -- budget is the total autoloop fee budget in satoshis.
+    budget BIGINT NOT NULL,
+
+    -- budget_last_refresh is the start of the budget period that the
+    -- decision was made in.
+    budget_last_refresh TIMESTAMP NOT NULL,
+
+    -- spent_fees is the amount of fees in satoshis that completed autoloop
+    -- swaps had spent in the current budget period.
+    spent_fees BIGINT NOT NULL,
+
+    -- pending_fees is the worst-case amount of fees in satoshis that in
+    -- flight autoloop swaps may spend.
+    pending_fees BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS autoloop_decisions_time_idx ON autoloop_decisions(decision_time);
+
+-- autoloop_decision_targets stores the channels and peers that an autoloop
+-- decision considered, along with the reason that no swap was suggested, or
+-- the swap that was suggested for them.
+CREATE TABLE IF NOT EXISTS autoloop_decision_targets (
+    -- id is the auto-incrementing primary key.
+    id INTEGER PRIMARY KEY,
+
+    -- decision_id references the decision that the target belongs to.
+    decision_id INTEGER NOT NULL REFERENCES autoloop_decisions(id),
+
+    -- channel_id is the short channel id of the target, or zero if the
+    -- target is a peer.
+    channel_id BIGINT NOT NULL,
+
+    -- peer is the public key of the target's peer, if known.
+    peer BLOB,
+
+    -- reason is the reason that no swap was suggested for the target, or
+    -- zero if a swap was suggested.
+    reason INTEGER NOT NULL,
+
+    -- swap_index is the index of the suggested swap within the decision
+    -- that the target is part of, or -1 if no swap was suggested.
+    swap_index INTEGER NOT NULL,
+
+    -- swap_type is the type of swap that was suggested for the target.
+    swap_type INTEGER NOT NULL,
+
+    -- amount is the amount in satoshis of the swap that was suggested for
+    -- the target.
+    amount BIGINT NOT NULL,
+
+    -- swap_hash is the hash of the swap that was dispatched for the
+    -- target's suggestion, if any.
+    swap_hash BLOB
+);
+
+CREATE INDEX IF NOT EXISTS autoloop_decision_targets_decision_id_idx ON autoloop_decision_targets(decision_id);
+CREATE INDEX IF NOT EXISTS autoloop_decision_targets_channel_id_idx ON autoloop_decision_targets(channel_id);
+CREATE INDEX IF NOT EXISTS autoloop_decision_targets_peer_idx ON autoloop_decision_targets(peer);
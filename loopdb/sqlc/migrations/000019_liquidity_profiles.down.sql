@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS liquidity_profile_activations;
+DROP INDEX IF EXISTS liquidity_profile_schedules_version_idx;
+DROP TABLE IF EXISTS liquidity_profile_schedules;
+DROP TABLE IF EXISTS liquidity_profile_versions;
+DROP TABLE IF EXISTS liquidity_profiles;
@@ -0,0 +1,76 @@
+-- liquidity_profiles stores the named sets of liquidity parameters that can
+-- be activated on the liquidity manager.
+CREATE TABLE IF NOT EXISTS liquidity_profiles (
+    -- id is the auto-incrementing primary key for a profile.
+    id INTEGER PRIMARY KEY,
+
+    -- name is the unique name of the profile.
+    name TEXT NOT NULL UNIQUE,
+
+    -- created_at is the time at which the profile was first saved.
+    created_at TIMESTAMP NOT NULL
+);
+
+-- liquidity_profile_versions stores every version of a profile, so that we
+-- keep a history of the changes made to it.
+CREATE TABLE IF NOT EXISTS liquidity_profile_versions (
+    -- id is the auto-incrementing primary key.
+    id INTEGER PRIMARY KEY,
+
+    -- profile_id references the profile that the version belongs to.
+    profile_id INTEGER NOT NULL REFERENCES liquidity_profiles(id),
+
+    -- version is the version number of the profile, starting at one.
+    version INTEGER NOT NULL,
+
+    -- params is the serialized liquidity parameters rpc message of the
+    -- version.
+    params BLOB NOT NULL,
+
+    -- created_at is the time at which the version was saved.
+    created_at TIMESTAMP NOT NULL,
+
+    UNIQUE(profile_id, version)
+);
+
+-- liquidity_profile_schedules stores the windows in which a profile version
+-- is automatically activated.
+CREATE TABLE IF NOT EXISTS liquidity_profile_schedules (
+    -- id is the auto-incrementing primary key.
+    id INTEGER PRIMARY KEY,
+
+    -- profile_version_id references the profile version that the window
+    -- belongs to.
+    profile_version_id INTEGER NOT NULL REFERENCES liquidity_profile_versions(id),
+
+    -- days is a bitmask of the days of the week that the window applies
+    -- to, where bit zero is Sunday.
+    days INTEGER NOT NULL,
+
+    -- start_hour is the UTC hour at which the window starts.
+    start_hour INTEGER NOT NULL,
+
+    -- end_hour is the UTC hour at which the window ends.
+    end_hour INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS liquidity_profile_schedules_version_idx ON liquidity_profile_schedules(profile_version_id);
+
+-- liquidity_profile_activations stores the history of profile activations.
+CREATE TABLE IF NOT EXISTS liquidity_profile_activations (
+    -- id is the auto-incrementing primary key.
+    id INTEGER PRIMARY KEY,
+
+    -- profile_id references the profile that was activated.
+    profile_id INTEGER NOT NULL REFERENCES liquidity_profiles(id),
+
+    -- version is the version of the profile that was activated.
+    version INTEGER NOT NULL,
+
+    -- activated_at is the time at which the profile was activated.
+    activated_at TIMESTAMP NOT NULL,
+
+    -- scheduled indicates whether the profile was activated by its
+    -- schedule, rather than by the user.
+    scheduled BOOLEAN NOT NULL
+);
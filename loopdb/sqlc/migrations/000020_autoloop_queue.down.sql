@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS autoloop_queue_channels_queue_id_idx;
+DROP TABLE IF EXISTS autoloop_queue_channels;
+DROP INDEX IF EXISTS autoloop_queue_state_idx;
+DROP TABLE IF EXISTS autoloop_queue;
@@ -0,0 +1,59 @@
+-- autoloop_queue stores the swaps that autoloop suggested while manual
+-- approval of its swaps is required, along with the outcome of each
+-- suggestion.
+CREATE TABLE IF NOT EXISTS autoloop_queue (
+    -- id is the auto-incrementing primary key for a queued swap.
+    id INTEGER PRIMARY KEY,
+
+    -- created_at is the time at which the swap was queued.
+    created_at TIMESTAMP NOT NULL,
+
+    -- expiry is the time after which the swap can no longer be approved.
+    expiry TIMESTAMP NOT NULL,
+
+    -- swap_type is the type of the swap.
+    swap_type INTEGER NOT NULL,
+
+    -- amount is the amount of the swap in satoshis.
+    amount BIGINT NOT NULL,
+
+    -- peer is the public key of the peer that the swap targets, if known.
+    peer BLOB,
+
+    -- fees is the worst-case amount of fees in satoshis that the swap was
+    -- quoted for when it was suggested.
+    fees BIGINT NOT NULL,
+
+    -- decision_id is the id of the autoloop decision that suggested the
+    -- swap, or zero if the decision was not journaled.
+    decision_id BIGINT NOT NULL,
+
+    -- swap_index is the index of the swap within its decision.
+    swap_index INTEGER NOT NULL,
+
+    -- state is the current state of the queued swap.
+    state INTEGER NOT NULL,
+
+    -- updated_at is the time at which the state of the swap last changed.
+    updated_at TIMESTAMP NOT NULL,
+
+    -- swap_hash is the hash of the swap that was dispatched when the
+    -- queued swap was approved.
+    swap_hash BLOB
+);
+
+CREATE INDEX IF NOT EXISTS autoloop_queue_state_idx ON autoloop_queue(state);
+
+-- autoloop_queue_channels stores the channels that a queued swap targets.
+CREATE TABLE IF NOT EXISTS autoloop_queue_channels (
+    -- id is the auto-incrementing primary key.
+    id INTEGER PRIMARY KEY,
+
+    -- queue_id references the queued swap that the channel belongs to.
+    queue_id INTEGER NOT NULL REFERENCES autoloop_queue(id),
+
+    -- channel_id is the short channel id of the channel.
+    channel_id BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS autoloop_queue_channels_queue_id_idx ON autoloop_queue_channels(queue_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS conditional_loop_outs_state_idx;
+DROP TABLE IF EXISTS conditional_loop_outs;
@@ -0,0 +1,40 @@
+-- conditional_loop_outs stores loop out requests that are held back until
+-- the conditions set for them hold.
+CREATE TABLE IF NOT EXISTS conditional_loop_outs (
+    -- id is the auto-incrementing primary key for a conditional loop out.
+    id INTEGER PRIMARY KEY,
+
+    -- created_at is the time at which the loop out was scheduled.
+    created_at TIMESTAMP NOT NULL,
+
+    -- updated_at is the time at which the state of the loop out last
+    -- changed.
+    updated_at TIMESTAMP NOT NULL,
+
+    -- request is the serialized rpc loop out request that is dispatched
+    -- once the conditions hold.
+    request BLOB NOT NULL,
+
+    -- dispatch_time is the time that must be reached before the loop out is
+    -- dispatched, if set.
+    dispatch_time TIMESTAMP,
+
+    -- dispatch_height is the block height that must be reached before the
+    -- loop out is dispatched, or zero if not set.
+    dispatch_height INTEGER NOT NULL,
+
+    -- max_fee_rate is the sweep fee rate estimate in sat/kw that must be
+    -- reached before the loop out is dispatched, or zero if not set.
+    max_fee_rate BIGINT NOT NULL,
+
+    -- state is the current state of the loop out.
+    state INTEGER NOT NULL,
+
+    -- swap_hash is the hash of the swap that was dispatched.
+    swap_hash BLOB,
+
+    -- failure_reason describes why the loop out could not be dispatched.
+    failure_reason TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS conditional_loop_outs_state_idx ON conditional_loop_outs(state);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS swap_schedule_runs_schedule_id_idx;
+DROP TABLE IF EXISTS swap_schedule_runs;
+DROP TABLE IF EXISTS swap_schedules;
@@ -0,0 +1,101 @@
+-- swap_schedules stores the definitions of swaps that are dispatched on a
+-- recurring schedule.
+CREATE TABLE IF NOT EXISTS swap_schedules (
+    -- id is the auto-incrementing primary key for a schedule.
+    id INTEGER PRIMARY KEY,
+
+    -- name is the unique name of the schedule.
+    name TEXT NOT NULL UNIQUE,
+
+    -- swap_type is the type of the swaps that the schedule dispatches.
+    swap_type INTEGER NOT NULL,
+
+    -- amount is the amount of each swap in satoshis.
+    amount BIGINT NOT NULL,
+
+    -- dest is the address that loop outs are swept to, if set.
+    dest TEXT NOT NULL,
+
+    -- account is the name of the lnd account that loop out addresses are
+    -- derived from, if set.
+    account TEXT NOT NULL,
+
+    -- account_addr_type is the address type of the addresses that are
+    -- derived from the account.
+    account_addr_type INTEGER NOT NULL,
+
+    -- max_swap_fee is the maximum swap fee in satoshis.
+    max_swap_fee BIGINT NOT NULL,
+
+    -- max_miner_fee is the maximum on-chain fee in satoshis.
+    max_miner_fee BIGINT NOT NULL,
+
+    -- max_prepay_amount is the maximum prepay amount of loop outs in
+    -- satoshis.
+    max_prepay_amount BIGINT NOT NULL,
+
+    -- max_swap_routing_fee is the maximum routing fee of the swap payment
+    -- of loop outs in satoshis.
+    max_swap_routing_fee BIGINT NOT NULL,
+
+    -- max_prepay_routing_fee is the maximum routing fee of the prepay
+    -- payment of loop outs in satoshis.
+    max_prepay_routing_fee BIGINT NOT NULL,
+
+    -- conf_target is the sweep confirmation target of loop outs or the
+    -- htlc confirmation target of loop ins.
+    conf_target INTEGER NOT NULL,
+
+    -- interval_seconds is the interval between runs of the schedule, or
+    -- zero if the schedule uses a cron expression.
+    interval_seconds BIGINT NOT NULL,
+
+    -- cron is the cron expression of the schedule, if set.
+    cron TEXT NOT NULL,
+
+    -- start_time is the time from which the schedule runs.
+    start_time TIMESTAMP NOT NULL,
+
+    -- label is the label of the swaps that the schedule dispatches.
+    label TEXT NOT NULL,
+
+    -- max_failures is the number of consecutive failed runs after which
+    -- the schedule is paused.
+    max_failures INTEGER NOT NULL,
+
+    -- state is the current state of the schedule.
+    state INTEGER NOT NULL,
+
+    -- consecutive_failures is the number of runs that failed since the
+    -- last successful run.
+    consecutive_failures INTEGER NOT NULL,
+
+    -- next_run is the time at which the schedule runs next.
+    next_run TIMESTAMP NOT NULL,
+
+    -- created_at is the time at which the schedule was created.
+    created_at TIMESTAMP NOT NULL,
+
+    -- updated_at is the time at which the schedule was last updated.
+    updated_at TIMESTAMP NOT NULL
+);
+
+-- swap_schedule_runs records each run of a schedule.
+CREATE TABLE IF NOT EXISTS swap_schedule_runs (
+    -- id is the auto-incrementing primary key for a run.
+    id INTEGER PRIMARY KEY,
+
+    -- schedule_id references the schedule that the run belongs to.
+    schedule_id INTEGER NOT NULL REFERENCES swap_schedules(id),
+
+    -- run_time is the time of the run.
+    run_time TIMESTAMP NOT NULL,
+
+    -- swap_hash is the hash of the swap that the run dispatched, if any.
+    swap_hash BLOB,
+
+    -- error describes why the run failed, if it did.
+    error TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS swap_schedule_runs_schedule_id_idx ON swap_schedule_runs(schedule_id);
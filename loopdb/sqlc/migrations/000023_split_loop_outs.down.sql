@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS split_loop_out_parts_swap_hash_idx;
+DROP TABLE IF EXISTS split_loop_out_parts;
+DROP TABLE IF EXISTS split_loop_out_groups;
@@ -0,0 +1,49 @@
+-- split_loop_out_groups stores loop outs that were split into several
+-- parts, each of which is dispatched as a loop out of its own.
+CREATE TABLE IF NOT EXISTS split_loop_out_groups (
+    -- id is the auto-incrementing primary key for a group.
+    id INTEGER PRIMARY KEY,
+
+    -- created_at is the time at which the loop out was split.
+    created_at TIMESTAMP NOT NULL,
+
+    -- amount is the total amount of the loop out in satoshis.
+    amount BIGINT NOT NULL,
+
+    -- sequential is true if each part is only dispatched once the swap of
+    -- the previous part succeeded.
+    sequential BOOLEAN NOT NULL,
+
+    -- request is the serialized rpc loop out request that the parts are
+    -- derived from.
+    request BLOB NOT NULL
+);
+
+-- split_loop_out_parts stores the parts of split loop outs.
+CREATE TABLE IF NOT EXISTS split_loop_out_parts (
+    -- id is the auto-incrementing primary key for a part.
+    id INTEGER PRIMARY KEY,
+
+    -- group_id is the id of the group that the part belongs to.
+    group_id INTEGER NOT NULL REFERENCES split_loop_out_groups(id),
+
+    -- part_index is the position of the part within its group.
+    part_index INTEGER NOT NULL,
+
+    -- amount is the amount of the part in satoshis.
+    amount BIGINT NOT NULL,
+
+    -- state is the current state of the part.
+    state INTEGER NOT NULL,
+
+    -- swap_hash is the hash of the swap that was dispatched for the part.
+    swap_hash BLOB,
+
+    -- error describes why the part could not be dispatched, if it could
+    -- not.
+    error TEXT NOT NULL DEFAULT '',
+
+    UNIQUE (group_id, part_index)
+);
+
+CREATE INDEX IF NOT EXISTS split_loop_out_parts_swap_hash_idx ON split_loop_out_parts(swap_hash);
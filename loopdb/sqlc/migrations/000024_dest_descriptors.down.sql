@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS dest_descriptor_addresses;
+DROP TABLE IF EXISTS dest_descriptors;
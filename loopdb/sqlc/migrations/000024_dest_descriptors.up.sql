@@ -0,0 +1,35 @@
+-- dest_descriptors stores the watch-only output descriptors and extended
+-- public keys that loop outs derive their destination addresses from.
+CREATE TABLE IF NOT EXISTS dest_descriptors (
+    -- id is the auto-incrementing primary key for a descriptor.
+    id INTEGER PRIMARY KEY,
+
+    -- descriptor is the normalized descriptor, without its checksum.
+    descriptor TEXT NOT NULL UNIQUE,
+
+    -- next_index is the derivation index that the next address of the
+    -- descriptor is derived at.
+    next_index BIGINT NOT NULL
+);
+
+-- dest_descriptor_addresses stores the addresses that were derived from our
+-- descriptors and handed out as loop out destinations.
+CREATE TABLE IF NOT EXISTS dest_descriptor_addresses (
+    -- id is the auto-incrementing primary key for an address.
+    id INTEGER PRIMARY KEY,
+
+    -- descriptor_id is the id of the descriptor that the address was
+    -- derived from.
+    descriptor_id INTEGER NOT NULL REFERENCES dest_descriptors(id),
+
+    -- derivation_index is the index that the address was derived at.
+    derivation_index BIGINT NOT NULL,
+
+    -- address is the encoded address.
+    address TEXT NOT NULL UNIQUE,
+
+    -- created_at is the time at which the address was handed out.
+    created_at TIMESTAMP NOT NULL,
+
+    UNIQUE (descriptor_id, derivation_index)
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS loop_out_channel_opens;
@@ -0,0 +1,32 @@
+-- loop_out_channel_opens stores the channels that the sweeps of loop outs
+-- fund directly, rather than paying to a wallet address.
+CREATE TABLE IF NOT EXISTS loop_out_channel_opens (
+    -- swap_hash is the hash of the loop out that opens the channel.
+    swap_hash BLOB PRIMARY KEY,
+
+    -- peer is the public key of the node that the channel is opened with.
+    peer BLOB NOT NULL,
+
+    -- private is set if the channel is not announced to the network.
+    private BOOLEAN NOT NULL,
+
+    -- capacity is the capacity of the channel in satoshis.
+    capacity BIGINT NOT NULL,
+
+    -- state is the state of the channel funding.
+    state INTEGER NOT NULL,
+
+    -- sweep_tx is the serialized sweep transaction that funds the channel,
+    -- which is set once the funding was finalized.
+    sweep_tx BLOB,
+
+    -- failure_reason is the reason why the sweep fell back to paying its
+    -- destination address.
+    failure_reason TEXT NOT NULL DEFAULT '',
+
+    -- created_at is the time at which the channel open was requested.
+    created_at TIMESTAMP NOT NULL,
+
+    -- updated_at is the time at which the state last changed.
+    updated_at TIMESTAMP NOT NULL
+);
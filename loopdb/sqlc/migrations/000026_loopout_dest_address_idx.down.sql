@@ -0,0 +1 @@
+DROP INDEX IF EXISTS loopout_swaps_dest_address_idx;
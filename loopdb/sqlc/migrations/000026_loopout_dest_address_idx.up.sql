@@ -0,0 +1,4 @@
+-- loopout_swaps_dest_address_idx allows us to look up whether a loop out paid
+-- to an address, which we check before handing out addresses of our
+-- destination descriptors.
+CREATE INDEX IF NOT EXISTS loopout_swaps_dest_address_idx ON loopout_swaps(dest_address);
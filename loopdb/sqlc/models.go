@@ -9,6 +9,61 @@ import (
 	"time"
 )
 
+type AutoloopDecision struct {
+	ID                int32
+	DecisionTime      time.Time
+	Budget            int64
+	BudgetLastRefresh time.Time
+	SpentFees         int64
+	PendingFees       int64
+}
+
+type AutoloopDecisionTarget struct {
+	ID         int32
+	DecisionID int32
+	ChannelID  int64
+	Peer       []byte
+	Reason     int32
+	SwapIndex  int32
+	SwapType   int32
+	Amount     int64
+	SwapHash   []byte
+}
+
+type AutoloopQueue struct {
+	ID         int32
+	CreatedAt  time.Time
+	Expiry     time.Time
+	SwapType   int32
+	Amount     int64
+	Peer       []byte
+	Fees       int64
+	DecisionID int64
+	SwapIndex  int32
+	State      int32
+	UpdatedAt  time.Time
+	SwapHash   []byte
+}
+
+type AutoloopQueueChannel struct {
+	ID        int32
+	QueueID   int32
+	ChannelID int64
+}
+
+type ConditionalLoopOut struct {
+	ID             int32
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Request        []byte
+	DispatchTime   sql.NullTime
+	DispatchHeight int32
+	MaxFeeRate     int64
+	State          int32
+	SwapHash       []byte
+	FailureReason  string
+}
+
 type Deposit struct {
 	ID                    int32
 	DepositID             []byte
@@ -29,6 +84,20 @@ type DepositUpdate struct {
 	UpdateTimestamp time.Time
 }
 
+type DestDescriptor struct {
+	ID         int32
+	Descriptor string
+	NextIndex  int64
+}
+
+type DestDescriptorAddress struct {
+	ID              int32
+	DescriptorID    int32
+	DerivationIndex int64
+	Address         string
+	CreatedAt       time.Time
+}
+
 type HtlcKey struct {
 	SwapHash               []byte
 	SenderScriptPubkey     []byte
@@ -65,6 +134,48 @@ type LiquidityParam struct {
 	Params []byte
 }
 
+type LiquidityProfile struct {
+	ID        int32
+	Name      string
+	CreatedAt time.Time
+}
+
+type LiquidityProfileActivation struct {
+	ID          int32
+	ProfileID   int32
+	Version     int32
+	ActivatedAt time.Time
+	Scheduled   bool
+}
+
+type LiquidityProfileSchedule struct {
+	ID               int32
+	ProfileVersionID int32
+	Days             int32
+	StartHour        int32
+	EndHour          int32
+}
+
+type LiquidityProfileVersion struct {
+	ID        int32
+	ProfileID int32
+	Version   int32
+	Params    []byte
+	CreatedAt time.Time
+}
+
+type LoopOutChannelOpen struct {
+	SwapHash      []byte
+	Peer          []byte
+	Private       bool
+	Capacity      int64
+	State         int32
+	SweepTx       []byte
+	FailureReason string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
 type LoopinSwap struct {
 	SwapHash       []byte
 	HtlcConfTarget int32
@@ -124,6 +235,24 @@ type ReservationUpdate struct {
 	UpdateTimestamp time.Time
 }
 
+type SplitLoopOutGroup struct {
+	ID         int32
+	CreatedAt  time.Time
+	Amount     int64
+	Sequential bool
+	Request    []byte
+}
+
+type SplitLoopOutPart struct {
+	ID        int32
+	GroupID   int32
+	PartIndex int32
+	Amount    int64
+	State     int32
+	SwapHash  []byte
+	Error     string
+}
+
 type StaticAddress struct {
 	ID               int32
 	ClientPubkey     []byte
@@ -170,6 +299,40 @@ type Swap struct {
 	Label            string
 }
 
+type SwapSchedule struct {
+	ID                  int32
+	Name                string
+	SwapType            int32
+	Amount              int64
+	Dest                string
+	Account             string
+	AccountAddrType     int32
+	MaxSwapFee          int64
+	MaxMinerFee         int64
+	MaxPrepayAmount     int64
+	MaxSwapRoutingFee   int64
+	MaxPrepayRoutingFee int64
+	ConfTarget          int32
+	IntervalSeconds     int64
+	Cron                string
+	StartTime           time.Time
+	Label               string
+	MaxFailures         int32
+	State               int32
+	ConsecutiveFailures int32
+	NextRun             time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+type SwapScheduleRun struct {
+	ID         int32
+	ScheduleID int32
+	RunTime    time.Time
+	SwapHash   []byte
+	Error      string
+}
+
 type SwapUpdate struct {
 	ID              int32
 	SwapHash        []byte
@@ -7,6 +7,7 @@ package sqlc
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 type Querier interface {
@@ -18,22 +19,37 @@ type Querier interface {
 	CreateStaticAddress(ctx context.Context, arg CreateStaticAddressParams) error
 	CreateWithdrawal(ctx context.Context, arg CreateWithdrawalParams) error
 	CreateWithdrawalDeposit(ctx context.Context, arg CreateWithdrawalDepositParams) error
+	DeleteAutoloopDecisionTargetsBefore(ctx context.Context, decisionTime time.Time) error
+	DeleteAutoloopDecisionsBefore(ctx context.Context, decisionTime time.Time) error
 	DepositForOutpoint(ctx context.Context, arg DepositForOutpointParams) (Deposit, error)
+	DeleteSwapSchedule(ctx context.Context, id int32) error
+	DeleteSwapScheduleRuns(ctx context.Context, scheduleID int32) error
 	DepositIDsForSwapHash(ctx context.Context, swapHash []byte) ([][]byte, error)
 	DepositsForSwapHash(ctx context.Context, swapHash []byte) ([]DepositsForSwapHashRow, error)
+	ExpireAutoloopQueueSwaps(ctx context.Context, arg ExpireAutoloopQueueSwapsParams) error
 	FetchLiquidityParams(ctx context.Context) ([]byte, error)
 	GetAllWithdrawals(ctx context.Context) ([]Withdrawal, error)
+	GetAutoloopDecisionTargets(ctx context.Context, decisionID int32) ([]AutoloopDecisionTarget, error)
+	GetAutoloopQueueChannels(ctx context.Context, queueID int32) ([]AutoloopQueueChannel, error)
+	GetAutoloopQueueSwap(ctx context.Context, id int32) (AutoloopQueue, error)
 	GetBatchSweeps(ctx context.Context, batchID int32) ([]Sweep, error)
 	GetBatchSweptAmount(ctx context.Context, batchID int32) (int64, error)
+	GetConditionalLoopOut(ctx context.Context, id int32) (ConditionalLoopOut, error)
 	GetDeposit(ctx context.Context, depositID []byte) (Deposit, error)
+	GetDestDescriptor(ctx context.Context, descriptor string) (DestDescriptor, error)
 	GetInstantOutSwap(ctx context.Context, swapHash []byte) (GetInstantOutSwapRow, error)
 	GetInstantOutSwapUpdates(ctx context.Context, swapHash []byte) ([]InstantoutUpdate, error)
 	GetInstantOutSwaps(ctx context.Context) ([]GetInstantOutSwapsRow, error)
 	GetLastUpdateID(ctx context.Context, swapHash []byte) (int32, error)
 	GetLatestDepositUpdate(ctx context.Context, depositID []byte) (DepositUpdate, error)
+	GetLatestLiquidityProfileVersion(ctx context.Context, profileID int32) (LiquidityProfileVersion, error)
+	GetLiquidityProfile(ctx context.Context, name string) (LiquidityProfile, error)
+	GetLiquidityProfileSchedules(ctx context.Context, profileVersionID int32) ([]LiquidityProfileSchedule, error)
+	GetLiquidityProfileVersion(ctx context.Context, arg GetLiquidityProfileVersionParams) (LiquidityProfileVersion, error)
 	GetLoopInSwap(ctx context.Context, swapHash []byte) (GetLoopInSwapRow, error)
 	GetLoopInSwapUpdates(ctx context.Context, swapHash []byte) ([]StaticAddressSwapUpdate, error)
 	GetLoopInSwaps(ctx context.Context) ([]GetLoopInSwapsRow, error)
+	GetLoopOutChannelOpen(ctx context.Context, swapHash []byte) (LoopOutChannelOpen, error)
 	GetLoopOutSwap(ctx context.Context, swapHash []byte) (GetLoopOutSwapRow, error)
 	GetLoopOutSwaps(ctx context.Context) ([]GetLoopOutSwapsRow, error)
 	GetMigration(ctx context.Context, migrationID string) (MigrationTracker, error)
@@ -44,35 +60,73 @@ type Querier interface {
 	GetStaticAddress(ctx context.Context, pkscript []byte) (StaticAddress, error)
 	GetStaticAddressLoopInSwap(ctx context.Context, swapHash []byte) (GetStaticAddressLoopInSwapRow, error)
 	GetStaticAddressLoopInSwapsByStates(ctx context.Context, dollar_1 sql.NullString) ([]GetStaticAddressLoopInSwapsByStatesRow, error)
+	GetSwapSchedule(ctx context.Context, name string) (SwapSchedule, error)
 	GetSwapUpdates(ctx context.Context, swapHash []byte) ([]SwapUpdate, error)
 	GetSweepStatus(ctx context.Context, outpoint string) (bool, error)
 	GetUnconfirmedBatches(ctx context.Context) ([]SweepBatch, error)
 	GetWithdrawalDeposits(ctx context.Context, withdrawalID []byte) ([][]byte, error)
 	GetWithdrawalIDByDepositID(ctx context.Context, depositID []byte) ([]byte, error)
+	InsertAutoloopDecision(ctx context.Context, arg InsertAutoloopDecisionParams) (int32, error)
+	InsertAutoloopDecisionTarget(ctx context.Context, arg InsertAutoloopDecisionTargetParams) error
+	InsertAutoloopQueueChannel(ctx context.Context, arg InsertAutoloopQueueChannelParams) error
+	InsertAutoloopQueueSwap(ctx context.Context, arg InsertAutoloopQueueSwapParams) (int32, error)
 	InsertBatch(ctx context.Context, arg InsertBatchParams) (int32, error)
+	InsertConditionalLoopOut(ctx context.Context, arg InsertConditionalLoopOutParams) (int32, error)
 	InsertDepositUpdate(ctx context.Context, arg InsertDepositUpdateParams) error
+	InsertDestDescriptor(ctx context.Context, arg InsertDestDescriptorParams) (int32, error)
+	InsertDestDescriptorAddress(ctx context.Context, arg InsertDestDescriptorAddressParams) error
 	InsertHtlcKeys(ctx context.Context, arg InsertHtlcKeysParams) error
 	InsertInstantOut(ctx context.Context, arg InsertInstantOutParams) error
 	InsertInstantOutUpdate(ctx context.Context, arg InsertInstantOutUpdateParams) error
+	InsertLiquidityProfile(ctx context.Context, arg InsertLiquidityProfileParams) (int32, error)
+	InsertLiquidityProfileActivation(ctx context.Context, arg InsertLiquidityProfileActivationParams) error
+	InsertLiquidityProfileSchedule(ctx context.Context, arg InsertLiquidityProfileScheduleParams) error
+	InsertLiquidityProfileVersion(ctx context.Context, arg InsertLiquidityProfileVersionParams) (int32, error)
 	InsertLoopIn(ctx context.Context, arg InsertLoopInParams) error
 	InsertLoopOut(ctx context.Context, arg InsertLoopOutParams) error
 	InsertLoopOutAsset(ctx context.Context, arg InsertLoopOutAssetParams) error
+	InsertLoopOutChannelOpen(ctx context.Context, arg InsertLoopOutChannelOpenParams) error
 	InsertMigration(ctx context.Context, arg InsertMigrationParams) error
 	InsertReservationUpdate(ctx context.Context, arg InsertReservationUpdateParams) error
+	InsertSplitLoopOutGroup(ctx context.Context, arg InsertSplitLoopOutGroupParams) (int32, error)
+	InsertSplitLoopOutPart(ctx context.Context, arg InsertSplitLoopOutPartParams) error
 	InsertStaticAddressLoopIn(ctx context.Context, arg InsertStaticAddressLoopInParams) error
 	InsertStaticAddressMetaUpdate(ctx context.Context, arg InsertStaticAddressMetaUpdateParams) error
 	InsertSwap(ctx context.Context, arg InsertSwapParams) error
+	InsertSwapSchedule(ctx context.Context, arg InsertSwapScheduleParams) (int32, error)
+	InsertSwapScheduleRun(ctx context.Context, arg InsertSwapScheduleRunParams) error
 	InsertSwapUpdate(ctx context.Context, arg InsertSwapUpdateParams) error
+	IsDestAddressUsed(ctx context.Context, address string) (bool, error)
+	IsLoopOutDestAddress(ctx context.Context, destAddress string) (bool, error)
 	IsStored(ctx context.Context, swapHash []byte) (bool, error)
+	ListAutoloopDecisions(ctx context.Context, arg ListAutoloopDecisionsParams) ([]AutoloopDecision, error)
+	ListAutoloopQueueSwaps(ctx context.Context) ([]AutoloopQueue, error)
+	ListAutoloopQueueSwapsByState(ctx context.Context, state int32) ([]AutoloopQueue, error)
+	ListConditionalLoopOuts(ctx context.Context) ([]ConditionalLoopOut, error)
+	ListConditionalLoopOutsByState(ctx context.Context, state int32) ([]ConditionalLoopOut, error)
+	ListLiquidityProfileActivations(ctx context.Context, limit int32) ([]ListLiquidityProfileActivationsRow, error)
+	ListLiquidityProfileVersions(ctx context.Context, profileID int32) ([]LiquidityProfileVersion, error)
+	ListLiquidityProfiles(ctx context.Context) ([]LiquidityProfile, error)
+	ListSplitLoopOutGroups(ctx context.Context) ([]SplitLoopOutGroup, error)
+	ListSplitLoopOutParts(ctx context.Context) ([]SplitLoopOutPart, error)
+	ListSwapScheduleRuns(ctx context.Context, arg ListSwapScheduleRunsParams) ([]SwapScheduleRun, error)
+	ListSwapSchedules(ctx context.Context) ([]SwapSchedule, error)
 	MapDepositToSwap(ctx context.Context, arg MapDepositToSwapParams) error
 	OverrideSwapCosts(ctx context.Context, arg OverrideSwapCostsParams) error
+	SetAutoloopDecisionSwapHash(ctx context.Context, arg SetAutoloopDecisionSwapHashParams) error
 	SwapHashForDepositID(ctx context.Context, depositID []byte) ([]byte, error)
+	UpdateAutoloopQueueSwap(ctx context.Context, arg UpdateAutoloopQueueSwapParams) error
 	UpdateBatch(ctx context.Context, arg UpdateBatchParams) error
+	UpdateConditionalLoopOut(ctx context.Context, arg UpdateConditionalLoopOutParams) error
 	UpdateDeposit(ctx context.Context, arg UpdateDepositParams) error
+	UpdateDestDescriptorIndex(ctx context.Context, arg UpdateDestDescriptorIndexParams) error
 	UpdateInstantOut(ctx context.Context, arg UpdateInstantOutParams) error
 	UpdateLoopOutAssetOffchainPayments(ctx context.Context, arg UpdateLoopOutAssetOffchainPaymentsParams) error
+	UpdateLoopOutChannelOpen(ctx context.Context, arg UpdateLoopOutChannelOpenParams) error
 	UpdateReservation(ctx context.Context, arg UpdateReservationParams) error
+	UpdateSplitLoopOutPart(ctx context.Context, arg UpdateSplitLoopOutPartParams) error
 	UpdateStaticAddressLoopIn(ctx context.Context, arg UpdateStaticAddressLoopInParams) error
+	UpdateSwapSchedule(ctx context.Context, arg UpdateSwapScheduleParams) error
 	UpdateWithdrawal(ctx context.Context, arg UpdateWithdrawalParams) error
 	UpsertLiquidityParams(ctx context.Context, params []byte) error
 	UpsertSweep(ctx context.Context, arg UpsertSweepParams) error
@@ -0,0 +1,90 @@
+-- name: InsertAutoloopDecision :one
+INSERT INTO autoloop_decisions (
+        decision_time,
+        budget,
+        budget_last_refresh,
+        spent_fees,
+        pending_fees
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5
+) RETURNING id;
+
+-- name: InsertAutoloopDecisionTarget :exec
+INSERT INTO autoloop_decision_targets (
+        decision_id,
+        channel_id,
+        peer,
+        reason,
+        swap_index,
+        swap_type,
+        amount
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7
+);
+
+-- name: SetAutoloopDecisionSwapHash :exec
+UPDATE autoloop_decision_targets SET
+        swap_hash = $3
+WHERE
+        decision_id = $1
+AND
+        swap_index = $2;
+
+-- name: ListAutoloopDecisions :many
+SELECT
+        d.*
+FROM
+        autoloop_decisions d
+WHERE
+        d.id > sqlc.arg(index_offset)
+AND
+        d.decision_time >= sqlc.arg(start_time)
+AND
+        d.decision_time < sqlc.arg(end_time)
+AND (
+        sqlc.narg(channel_id) IS NULL OR EXISTS (
+                SELECT 1 FROM autoloop_decision_targets t
+                WHERE t.decision_id = d.id
+                AND t.channel_id = sqlc.narg(channel_id)
+        )
+)
+AND (
+        sqlc.narg(peer) IS NULL OR EXISTS (
+                SELECT 1 FROM autoloop_decision_targets t
+                WHERE t.decision_id = d.id
+                AND t.peer = sqlc.narg(peer)
+        )
+)
+ORDER BY
+        d.id ASC
+LIMIT sqlc.arg(max_decisions);
+
+-- name: GetAutoloopDecisionTargets :many
+SELECT
+        *
+FROM
+        autoloop_decision_targets
+WHERE
+        decision_id = $1
+ORDER BY
+        id ASC;
+
+-- name: DeleteAutoloopDecisionTargetsBefore :exec
+DELETE FROM autoloop_decision_targets
+WHERE decision_id IN (
+        SELECT id FROM autoloop_decisions WHERE decision_time < $1
+);
+
+-- name: DeleteAutoloopDecisionsBefore :exec
+DELETE FROM autoloop_decisions
+WHERE decision_time < $1;
@@ -0,0 +1,86 @@
+-- name: InsertAutoloopQueueSwap :one
+INSERT INTO autoloop_queue (
+        created_at,
+        expiry,
+        swap_type,
+        amount,
+        peer,
+        fees,
+        decision_id,
+        swap_index,
+        state,
+        updated_at
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10
+) RETURNING id;
+
+-- name: InsertAutoloopQueueChannel :exec
+INSERT INTO autoloop_queue_channels (
+        queue_id,
+        channel_id
+) VALUES (
+        $1,
+        $2
+);
+
+-- name: GetAutoloopQueueSwap :one
+SELECT
+        *
+FROM
+        autoloop_queue
+WHERE
+        id = $1;
+
+-- name: GetAutoloopQueueChannels :many
+SELECT
+        *
+FROM
+        autoloop_queue_channels
+WHERE
+        queue_id = $1
+ORDER BY
+        id ASC;
+
+-- name: ListAutoloopQueueSwaps :many
+SELECT
+        *
+FROM
+        autoloop_queue
+ORDER BY
+        id ASC;
+
+-- name: ListAutoloopQueueSwapsByState :many
+SELECT
+        *
+FROM
+        autoloop_queue
+WHERE
+        state = $1
+ORDER BY
+        id ASC;
+
+-- name: UpdateAutoloopQueueSwap :exec
+UPDATE autoloop_queue SET
+        state = $2,
+        updated_at = $3,
+        swap_hash = $4
+WHERE
+        id = $1;
+
+-- name: ExpireAutoloopQueueSwaps :exec
+UPDATE autoloop_queue SET
+        state = sqlc.arg(expired_state),
+        updated_at = sqlc.arg(now)
+WHERE
+        state = sqlc.arg(pending_state)
+AND
+        expiry <= sqlc.arg(now);
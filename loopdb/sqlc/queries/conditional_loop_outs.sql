@@ -0,0 +1,53 @@
+-- name: InsertConditionalLoopOut :one
+INSERT INTO conditional_loop_outs (
+        created_at,
+        updated_at,
+        request,
+        dispatch_time,
+        dispatch_height,
+        max_fee_rate,
+        state
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7
+) RETURNING id;
+
+-- name: GetConditionalLoopOut :one
+SELECT
+        *
+FROM
+        conditional_loop_outs
+WHERE
+        id = $1;
+
+-- name: ListConditionalLoopOuts :many
+SELECT
+        *
+FROM
+        conditional_loop_outs
+ORDER BY
+        id ASC;
+
+-- name: ListConditionalLoopOutsByState :many
+SELECT
+        *
+FROM
+        conditional_loop_outs
+WHERE
+        state = $1
+ORDER BY
+        id ASC;
+
+-- name: UpdateConditionalLoopOut :exec
+UPDATE conditional_loop_outs SET
+        state = $2,
+        updated_at = $3,
+        swap_hash = $4,
+        failure_reason = $5
+WHERE
+        id = $1;
@@ -0,0 +1,49 @@
+-- name: GetDestDescriptor :one
+SELECT
+        *
+FROM
+        dest_descriptors
+WHERE
+        descriptor = $1;
+
+-- name: InsertDestDescriptor :one
+INSERT INTO dest_descriptors (
+        descriptor,
+        next_index
+) VALUES (
+        $1,
+        $2
+) RETURNING id;
+
+-- name: UpdateDestDescriptorIndex :exec
+UPDATE dest_descriptors SET
+        next_index = $2
+WHERE
+        id = $1;
+
+-- name: InsertDestDescriptorAddress :exec
+INSERT INTO dest_descriptor_addresses (
+        descriptor_id,
+        derivation_index,
+        address,
+        created_at
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4
+);
+
+-- name: IsDestAddressUsed :one
+SELECT EXISTS (
+        SELECT 1
+        FROM dest_descriptor_addresses
+        WHERE address = $1
+);
+
+-- name: IsLoopOutDestAddress :one
+SELECT EXISTS (
+        SELECT 1
+        FROM loopout_swaps
+        WHERE dest_address = $1
+);
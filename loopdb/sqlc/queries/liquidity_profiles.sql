@@ -0,0 +1,118 @@
+-- name: InsertLiquidityProfile :one
+INSERT INTO liquidity_profiles (
+        name,
+        created_at
+) VALUES (
+        $1,
+        $2
+) RETURNING id;
+
+-- name: GetLiquidityProfile :one
+SELECT
+        *
+FROM
+        liquidity_profiles
+WHERE
+        name = $1;
+
+-- name: ListLiquidityProfiles :many
+SELECT
+        *
+FROM
+        liquidity_profiles
+ORDER BY
+        name ASC;
+
+-- name: InsertLiquidityProfileVersion :one
+INSERT INTO liquidity_profile_versions (
+        profile_id,
+        version,
+        params,
+        created_at
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4
+) RETURNING id;
+
+-- name: GetLiquidityProfileVersion :one
+SELECT
+        *
+FROM
+        liquidity_profile_versions
+WHERE
+        profile_id = $1
+AND
+        version = $2;
+
+-- name: GetLatestLiquidityProfileVersion :one
+SELECT
+        *
+FROM
+        liquidity_profile_versions
+WHERE
+        profile_id = $1
+ORDER BY
+        version DESC
+LIMIT 1;
+
+-- name: ListLiquidityProfileVersions :many
+SELECT
+        *
+FROM
+        liquidity_profile_versions
+WHERE
+        profile_id = $1
+ORDER BY
+        version ASC;
+
+-- name: InsertLiquidityProfileSchedule :exec
+INSERT INTO liquidity_profile_schedules (
+        profile_version_id,
+        days,
+        start_hour,
+        end_hour
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4
+);
+
+-- name: GetLiquidityProfileSchedules :many
+SELECT
+        *
+FROM
+        liquidity_profile_schedules
+WHERE
+        profile_version_id = $1
+ORDER BY
+        id ASC;
+
+-- name: InsertLiquidityProfileActivation :exec
+INSERT INTO liquidity_profile_activations (
+        profile_id,
+        version,
+        activated_at,
+        scheduled
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4
+);
+
+-- name: ListLiquidityProfileActivations :many
+SELECT
+        p.name,
+        a.version,
+        a.activated_at,
+        a.scheduled
+FROM
+        liquidity_profile_activations a
+JOIN
+        liquidity_profiles p ON p.id = a.profile_id
+ORDER BY
+        a.id DESC
+LIMIT $1;
@@ -0,0 +1,35 @@
+-- name: InsertLoopOutChannelOpen :exec
+INSERT INTO loop_out_channel_opens (
+        swap_hash,
+        peer,
+        private,
+        capacity,
+        state,
+        created_at,
+        updated_at
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $6
+);
+
+-- name: GetLoopOutChannelOpen :one
+SELECT
+        *
+FROM
+        loop_out_channel_opens
+WHERE
+        swap_hash = $1;
+
+-- name: UpdateLoopOutChannelOpen :exec
+UPDATE loop_out_channel_opens SET
+        state = $2,
+        sweep_tx = $3,
+        failure_reason = $4,
+        updated_at = $5
+WHERE
+        swap_hash = $1;
@@ -0,0 +1,53 @@
+-- name: InsertSplitLoopOutGroup :one
+INSERT INTO split_loop_out_groups (
+        created_at,
+        amount,
+        sequential,
+        request
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4
+) RETURNING id;
+
+-- name: InsertSplitLoopOutPart :exec
+INSERT INTO split_loop_out_parts (
+        group_id,
+        part_index,
+        amount,
+        state,
+        swap_hash,
+        error
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6
+);
+
+-- name: ListSplitLoopOutGroups :many
+SELECT
+        *
+FROM
+        split_loop_out_groups
+ORDER BY
+        id ASC;
+
+-- name: ListSplitLoopOutParts :many
+SELECT
+        *
+FROM
+        split_loop_out_parts
+ORDER BY
+        group_id ASC, part_index ASC;
+
+-- name: UpdateSplitLoopOutPart :exec
+UPDATE split_loop_out_parts SET
+        state = $3,
+        swap_hash = $4,
+        error = $5
+WHERE
+        group_id = $1 AND part_index = $2;
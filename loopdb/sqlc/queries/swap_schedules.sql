@@ -0,0 +1,107 @@
+-- name: InsertSwapSchedule :one
+INSERT INTO swap_schedules (
+        name,
+        swap_type,
+        amount,
+        dest,
+        account,
+        account_addr_type,
+        max_swap_fee,
+        max_miner_fee,
+        max_prepay_amount,
+        max_swap_routing_fee,
+        max_prepay_routing_fee,
+        conf_target,
+        interval_seconds,
+        cron,
+        start_time,
+        label,
+        max_failures,
+        state,
+        consecutive_failures,
+        next_run,
+        created_at,
+        updated_at
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15,
+        $16,
+        $17,
+        $18,
+        $19,
+        $20,
+        $21,
+        $22
+) RETURNING id;
+
+-- name: GetSwapSchedule :one
+SELECT
+        *
+FROM
+        swap_schedules
+WHERE
+        name = $1;
+
+-- name: ListSwapSchedules :many
+SELECT
+        *
+FROM
+        swap_schedules
+ORDER BY
+        id ASC;
+
+-- name: UpdateSwapSchedule :exec
+UPDATE swap_schedules SET
+        state = $2,
+        consecutive_failures = $3,
+        next_run = $4,
+        updated_at = $5
+WHERE
+        id = $1;
+
+-- name: DeleteSwapSchedule :exec
+DELETE FROM swap_schedules
+WHERE
+        id = $1;
+
+-- name: InsertSwapScheduleRun :exec
+INSERT INTO swap_schedule_runs (
+        schedule_id,
+        run_time,
+        swap_hash,
+        error
+) VALUES (
+        $1,
+        $2,
+        $3,
+        $4
+);
+
+-- name: ListSwapScheduleRuns :many
+SELECT
+        *
+FROM
+        swap_schedule_runs
+WHERE
+        schedule_id = $1
+ORDER BY
+        id DESC
+LIMIT $2;
+
+-- name: DeleteSwapScheduleRuns :exec
+DELETE FROM swap_schedule_runs
+WHERE
+        schedule_id = $1;
@@ -92,6 +92,96 @@ type SwapClientClient interface {
 	// Note that only loop out suggestions are currently supported.
 	// [EXPERIMENTAL]: endpoint is subject to change.
 	SuggestSwaps(ctx context.Context, in *SuggestSwapsRequest, opts ...grpc.CallOption) (*SuggestSwapsResponse, error)
+	// loop: `liquidity simulate`
+	// SimulateAutoloop replays the liquidity manager's suggestion logic over a
+	// time series of channel balances and fee rates, and returns the swaps that
+	// would have been dispatched along with their fees and budget use.
+	// [EXPERIMENTAL]: endpoint is subject to change.
+	SimulateAutoloop(ctx context.Context, in *SimulateAutoloopRequest, opts ...grpc.CallOption) (*SimulateAutoloopResponse, error)
+	// loop: `liquidity decisions`
+	// ListAutoloopDecisions returns the decisions recorded in the autoloop
+	// decision journal, optionally filtered by channel, peer and time range.
+	ListAutoloopDecisions(ctx context.Context, in *ListAutoloopDecisionsRequest, opts ...grpc.CallOption) (*ListAutoloopDecisionsResponse, error)
+	// loop: `liquidity profiles list`
+	// ListLiquidityProfiles returns our named liquidity profiles, along with the
+	// history of changes made to them and the history of profile activations.
+	ListLiquidityProfiles(ctx context.Context, in *ListLiquidityProfilesRequest, opts ...grpc.CallOption) (*ListLiquidityProfilesResponse, error)
+	// loop: `liquidity profiles save`
+	// SaveLiquidityProfile saves a new version of a named liquidity profile. If
+	// the profile is currently active, the new version is activated.
+	SaveLiquidityProfile(ctx context.Context, in *SaveLiquidityProfileRequest, opts ...grpc.CallOption) (*SaveLiquidityProfileResponse, error)
+	// loop: `liquidity profiles activate`
+	// ActivateLiquidityProfile sets the liquidity manager's parameters to a
+	// version of a named liquidity profile.
+	ActivateLiquidityProfile(ctx context.Context, in *ActivateLiquidityProfileRequest, opts ...grpc.CallOption) (*ActivateLiquidityProfileResponse, error)
+	// loop: `liquidity profiles rollback`
+	// RollbackLiquidityProfile rolls a named liquidity profile back to an
+	// earlier version by saving a copy of that version as its latest version.
+	RollbackLiquidityProfile(ctx context.Context, in *RollbackLiquidityProfileRequest, opts ...grpc.CallOption) (*RollbackLiquidityProfileResponse, error)
+	// loop: `liquidity profiles diff`
+	// DiffLiquidityProfiles returns the parameters that differ between two
+	// versions of liquidity profiles.
+	DiffLiquidityProfiles(ctx context.Context, in *DiffLiquidityProfilesRequest, opts ...grpc.CallOption) (*DiffLiquidityProfilesResponse, error)
+	// loop: `liquidity queue list`
+	// ListQueuedSwaps returns the swaps that autoloop has added to its approval
+	// queue.
+	ListQueuedSwaps(ctx context.Context, in *ListQueuedSwapsRequest, opts ...grpc.CallOption) (*ListQueuedSwapsResponse, error)
+	// loop: `liquidity queue approve`
+	// ApproveQueuedSwap re-quotes a pending queued swap, checks it against our
+	// current autoloop budget and dispatches it.
+	ApproveQueuedSwap(ctx context.Context, in *ApproveQueuedSwapRequest, opts ...grpc.CallOption) (*ApproveQueuedSwapResponse, error)
+	// loop: `liquidity queue reject`
+	// RejectQueuedSwap rejects a pending queued swap, so that it is not
+	// dispatched.
+	RejectQueuedSwap(ctx context.Context, in *RejectQueuedSwapRequest, opts ...grpc.CallOption) (*RejectQueuedSwapResponse, error)
+	// loop: `liquidity export`
+	// ExportLiquidityParams returns the liquidity manager's parameters as a
+	// document that can be edited and imported on other nodes.
+	ExportLiquidityParams(ctx context.Context, in *ExportLiquidityParamsRequest, opts ...grpc.CallOption) (*ExportLiquidityParamsResponse, error)
+	// loop: `liquidity import`
+	// ImportLiquidityParams validates a liquidity parameters document and
+	// returns the parameters that it changes. Unless a dry run is requested,
+	// the document's parameters are then set.
+	ImportLiquidityParams(ctx context.Context, in *ImportLiquidityParamsRequest, opts ...grpc.CallOption) (*ImportLiquidityParamsResponse, error)
+	// loop: `out --at/--height/--max_sweep_feerate`
+	// ScheduleLoopOut stores a loop out request that is held back until all of
+	// the conditions set for it hold. The swap is then re-quoted, checked
+	// against the request's fee limits and dispatched.
+	ScheduleLoopOut(ctx context.Context, in *ScheduleLoopOutRequest, opts ...grpc.CallOption) (*ScheduleLoopOutResponse, error)
+	// loop: `scheduled list`
+	// ListScheduledLoopOuts returns the loop outs that were scheduled to be
+	// dispatched once their conditions hold.
+	ListScheduledLoopOuts(ctx context.Context, in *ListScheduledLoopOutsRequest, opts ...grpc.CallOption) (*ListScheduledLoopOutsResponse, error)
+	// loop: `scheduled cancel`
+	// CancelScheduledLoopOut cancels a pending scheduled loop out, so that it
+	// is not dispatched.
+	CancelScheduledLoopOut(ctx context.Context, in *CancelScheduledLoopOutRequest, opts ...grpc.CallOption) (*CancelScheduledLoopOutResponse, error)
+	// loop: `schedule add`
+	// AddSwapSchedule creates a recurring swap schedule, which dispatches a loop
+	// out or loop in of a fixed amount at a regular interval or whenever a cron
+	// expression matches. Each run is quoted and checked against the schedule's
+	// fee limits before its swap is dispatched.
+	AddSwapSchedule(ctx context.Context, in *AddSwapScheduleRequest, opts ...grpc.CallOption) (*AddSwapScheduleResponse, error)
+	// loop: `schedule list`
+	// ListSwapSchedules returns all recurring swap schedules.
+	ListSwapSchedules(ctx context.Context, in *ListSwapSchedulesRequest, opts ...grpc.CallOption) (*ListSwapSchedulesResponse, error)
+	// loop: `schedule runs`
+	// ListSwapScheduleRuns returns the most recent runs of a recurring swap
+	// schedule, along with the swap that each run dispatched or the reason that
+	// it failed.
+	ListSwapScheduleRuns(ctx context.Context, in *ListSwapScheduleRunsRequest, opts ...grpc.CallOption) (*ListSwapScheduleRunsResponse, error)
+	// loop: `schedule pause`
+	// PauseSwapSchedule pauses a recurring swap schedule, so that it no longer
+	// dispatches swaps.
+	PauseSwapSchedule(ctx context.Context, in *PauseSwapScheduleRequest, opts ...grpc.CallOption) (*PauseSwapScheduleResponse, error)
+	// loop: `schedule resume`
+	// ResumeSwapSchedule resumes a paused recurring swap schedule. Its failure
+	// count is reset and it next runs at its first regular run from now.
+	ResumeSwapSchedule(ctx context.Context, in *ResumeSwapScheduleRequest, opts ...grpc.CallOption) (*ResumeSwapScheduleResponse, error)
+	// loop: `schedule delete`
+	// DeleteSwapSchedule deletes a recurring swap schedule along with the record
+	// of its runs.
+	DeleteSwapSchedule(ctx context.Context, in *DeleteSwapScheduleRequest, opts ...grpc.CallOption) (*DeleteSwapScheduleResponse, error)
 	// loop: `listreservations`
 	// ListReservations returns a list of all reservations the server opened to us.
 	ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error)
@@ -328,6 +418,195 @@ func (c *swapClientClient) SuggestSwaps(ctx context.Context, in *SuggestSwapsReq
 	return out, nil
 }
 
+func (c *swapClientClient) SimulateAutoloop(ctx context.Context, in *SimulateAutoloopRequest, opts ...grpc.CallOption) (*SimulateAutoloopResponse, error) {
+	out := new(SimulateAutoloopResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/SimulateAutoloop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ListAutoloopDecisions(ctx context.Context, in *ListAutoloopDecisionsRequest, opts ...grpc.CallOption) (*ListAutoloopDecisionsResponse, error) {
+	out := new(ListAutoloopDecisionsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListAutoloopDecisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ListLiquidityProfiles(ctx context.Context, in *ListLiquidityProfilesRequest, opts ...grpc.CallOption) (*ListLiquidityProfilesResponse, error) {
+	out := new(ListLiquidityProfilesResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListLiquidityProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) SaveLiquidityProfile(ctx context.Context, in *SaveLiquidityProfileRequest, opts ...grpc.CallOption) (*SaveLiquidityProfileResponse, error) {
+	out := new(SaveLiquidityProfileResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/SaveLiquidityProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ActivateLiquidityProfile(ctx context.Context, in *ActivateLiquidityProfileRequest, opts ...grpc.CallOption) (*ActivateLiquidityProfileResponse, error) {
+	out := new(ActivateLiquidityProfileResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ActivateLiquidityProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) RollbackLiquidityProfile(ctx context.Context, in *RollbackLiquidityProfileRequest, opts ...grpc.CallOption) (*RollbackLiquidityProfileResponse, error) {
+	out := new(RollbackLiquidityProfileResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/RollbackLiquidityProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) DiffLiquidityProfiles(ctx context.Context, in *DiffLiquidityProfilesRequest, opts ...grpc.CallOption) (*DiffLiquidityProfilesResponse, error) {
+	out := new(DiffLiquidityProfilesResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/DiffLiquidityProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ListQueuedSwaps(ctx context.Context, in *ListQueuedSwapsRequest, opts ...grpc.CallOption) (*ListQueuedSwapsResponse, error) {
+	out := new(ListQueuedSwapsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListQueuedSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ApproveQueuedSwap(ctx context.Context, in *ApproveQueuedSwapRequest, opts ...grpc.CallOption) (*ApproveQueuedSwapResponse, error) {
+	out := new(ApproveQueuedSwapResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ApproveQueuedSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) RejectQueuedSwap(ctx context.Context, in *RejectQueuedSwapRequest, opts ...grpc.CallOption) (*RejectQueuedSwapResponse, error) {
+	out := new(RejectQueuedSwapResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/RejectQueuedSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ExportLiquidityParams(ctx context.Context, in *ExportLiquidityParamsRequest, opts ...grpc.CallOption) (*ExportLiquidityParamsResponse, error) {
+	out := new(ExportLiquidityParamsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ExportLiquidityParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ImportLiquidityParams(ctx context.Context, in *ImportLiquidityParamsRequest, opts ...grpc.CallOption) (*ImportLiquidityParamsResponse, error) {
+	out := new(ImportLiquidityParamsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ImportLiquidityParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ScheduleLoopOut(ctx context.Context, in *ScheduleLoopOutRequest, opts ...grpc.CallOption) (*ScheduleLoopOutResponse, error) {
+	out := new(ScheduleLoopOutResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ScheduleLoopOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ListScheduledLoopOuts(ctx context.Context, in *ListScheduledLoopOutsRequest, opts ...grpc.CallOption) (*ListScheduledLoopOutsResponse, error) {
+	out := new(ListScheduledLoopOutsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListScheduledLoopOuts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) CancelScheduledLoopOut(ctx context.Context, in *CancelScheduledLoopOutRequest, opts ...grpc.CallOption) (*CancelScheduledLoopOutResponse, error) {
+	out := new(CancelScheduledLoopOutResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/CancelScheduledLoopOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) AddSwapSchedule(ctx context.Context, in *AddSwapScheduleRequest, opts ...grpc.CallOption) (*AddSwapScheduleResponse, error) {
+	out := new(AddSwapScheduleResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/AddSwapSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ListSwapSchedules(ctx context.Context, in *ListSwapSchedulesRequest, opts ...grpc.CallOption) (*ListSwapSchedulesResponse, error) {
+	out := new(ListSwapSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListSwapSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ListSwapScheduleRuns(ctx context.Context, in *ListSwapScheduleRunsRequest, opts ...grpc.CallOption) (*ListSwapScheduleRunsResponse, error) {
+	out := new(ListSwapScheduleRunsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListSwapScheduleRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) PauseSwapSchedule(ctx context.Context, in *PauseSwapScheduleRequest, opts ...grpc.CallOption) (*PauseSwapScheduleResponse, error) {
+	out := new(PauseSwapScheduleResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/PauseSwapSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ResumeSwapSchedule(ctx context.Context, in *ResumeSwapScheduleRequest, opts ...grpc.CallOption) (*ResumeSwapScheduleResponse, error) {
+	out := new(ResumeSwapScheduleResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ResumeSwapSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) DeleteSwapSchedule(ctx context.Context, in *DeleteSwapScheduleRequest, opts ...grpc.CallOption) (*DeleteSwapScheduleResponse, error) {
+	out := new(DeleteSwapScheduleResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/DeleteSwapSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *swapClientClient) ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error) {
 	out := new(ListReservationsResponse)
 	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListReservations", in, out, opts...)
@@ -514,6 +793,96 @@ type SwapClientServer interface {
 	// Note that only loop out suggestions are currently supported.
 	// [EXPERIMENTAL]: endpoint is subject to change.
 	SuggestSwaps(context.Context, *SuggestSwapsRequest) (*SuggestSwapsResponse, error)
+	// loop: `liquidity simulate`
+	// SimulateAutoloop replays the liquidity manager's suggestion logic over a
+	// time series of channel balances and fee rates, and returns the swaps that
+	// would have been dispatched along with their fees and budget use.
+	// [EXPERIMENTAL]: endpoint is subject to change.
+	SimulateAutoloop(context.Context, *SimulateAutoloopRequest) (*SimulateAutoloopResponse, error)
+	// loop: `liquidity decisions`
+	// ListAutoloopDecisions returns the decisions recorded in the autoloop
+	// decision journal, optionally filtered by channel, peer and time range.
+	ListAutoloopDecisions(context.Context, *ListAutoloopDecisionsRequest) (*ListAutoloopDecisionsResponse, error)
+	// loop: `liquidity profiles list`
+	// ListLiquidityProfiles returns our named liquidity profiles, along with the
+	// history of changes made to them and the history of profile activations.
+	ListLiquidityProfiles(context.Context, *ListLiquidityProfilesRequest) (*ListLiquidityProfilesResponse, error)
+	// loop: `liquidity profiles save`
+	// SaveLiquidityProfile saves a new version of a named liquidity profile. If
+	// the profile is currently active, the new version is activated.
+	SaveLiquidityProfile(context.Context, *SaveLiquidityProfileRequest) (*SaveLiquidityProfileResponse, error)
+	// loop: `liquidity profiles activate`
+	// ActivateLiquidityProfile sets the liquidity manager's parameters to a
+	// version of a named liquidity profile.
+	ActivateLiquidityProfile(context.Context, *ActivateLiquidityProfileRequest) (*ActivateLiquidityProfileResponse, error)
+	// loop: `liquidity profiles rollback`
+	// RollbackLiquidityProfile rolls a named liquidity profile back to an
+	// earlier version by saving a copy of that version as its latest version.
+	RollbackLiquidityProfile(context.Context, *RollbackLiquidityProfileRequest) (*RollbackLiquidityProfileResponse, error)
+	// loop: `liquidity profiles diff`
+	// DiffLiquidityProfiles returns the parameters that differ between two
+	// versions of liquidity profiles.
+	DiffLiquidityProfiles(context.Context, *DiffLiquidityProfilesRequest) (*DiffLiquidityProfilesResponse, error)
+	// loop: `liquidity queue list`
+	// ListQueuedSwaps returns the swaps that autoloop has added to its approval
+	// queue.
+	ListQueuedSwaps(context.Context, *ListQueuedSwapsRequest) (*ListQueuedSwapsResponse, error)
+	// loop: `liquidity queue approve`
+	// ApproveQueuedSwap re-quotes a pending queued swap, checks it against our
+	// current autoloop budget and dispatches it.
+	ApproveQueuedSwap(context.Context, *ApproveQueuedSwapRequest) (*ApproveQueuedSwapResponse, error)
+	// loop: `liquidity queue reject`
+	// RejectQueuedSwap rejects a pending queued swap, so that it is not
+	// dispatched.
+	RejectQueuedSwap(context.Context, *RejectQueuedSwapRequest) (*RejectQueuedSwapResponse, error)
+	// loop: `liquidity export`
+	// ExportLiquidityParams returns the liquidity manager's parameters as a
+	// document that can be edited and imported on other nodes.
+	ExportLiquidityParams(context.Context, *ExportLiquidityParamsRequest) (*ExportLiquidityParamsResponse, error)
+	// loop: `liquidity import`
+	// ImportLiquidityParams validates a liquidity parameters document and
+	// returns the parameters that it changes. Unless a dry run is requested,
+	// the document's parameters are then set.
+	ImportLiquidityParams(context.Context, *ImportLiquidityParamsRequest) (*ImportLiquidityParamsResponse, error)
+	// loop: `out --at/--height/--max_sweep_feerate`
+	// ScheduleLoopOut stores a loop out request that is held back until all of
+	// the conditions set for it hold. The swap is then re-quoted, checked
+	// against the request's fee limits and dispatched.
+	ScheduleLoopOut(context.Context, *ScheduleLoopOutRequest) (*ScheduleLoopOutResponse, error)
+	// loop: `scheduled list`
+	// ListScheduledLoopOuts returns the loop outs that were scheduled to be
+	// dispatched once their conditions hold.
+	ListScheduledLoopOuts(context.Context, *ListScheduledLoopOutsRequest) (*ListScheduledLoopOutsResponse, error)
+	// loop: `scheduled cancel`
+	// CancelScheduledLoopOut cancels a pending scheduled loop out, so that it
+	// is not dispatched.
+	CancelScheduledLoopOut(context.Context, *CancelScheduledLoopOutRequest) (*CancelScheduledLoopOutResponse, error)
+	// loop: `schedule add`
+	// AddSwapSchedule creates a recurring swap schedule, which dispatches a loop
+	// out or loop in of a fixed amount at a regular interval or whenever a cron
+	// expression matches. Each run is quoted and checked against the schedule's
+	// fee limits before its swap is dispatched.
+	AddSwapSchedule(context.Context, *AddSwapScheduleRequest) (*AddSwapScheduleResponse, error)
+	// loop: `schedule list`
+	// ListSwapSchedules returns all recurring swap schedules.
+	ListSwapSchedules(context.Context, *ListSwapSchedulesRequest) (*ListSwapSchedulesResponse, error)
+	// loop: `schedule runs`
+	// ListSwapScheduleRuns returns the most recent runs of a recurring swap
+	// schedule, along with the swap that each run dispatched or the reason that
+	// it failed.
+	ListSwapScheduleRuns(context.Context, *ListSwapScheduleRunsRequest) (*ListSwapScheduleRunsResponse, error)
+	// loop: `schedule pause`
+	// PauseSwapSchedule pauses a recurring swap schedule, so that it no longer
+	// dispatches swaps.
+	PauseSwapSchedule(context.Context, *PauseSwapScheduleRequest) (*PauseSwapScheduleResponse, error)
+	// loop: `schedule resume`
+	// ResumeSwapSchedule resumes a paused recurring swap schedule. Its failure
+	// count is reset and it next runs at its first regular run from now.
+	ResumeSwapSchedule(context.Context, *ResumeSwapScheduleRequest) (*ResumeSwapScheduleResponse, error)
+	// loop: `schedule delete`
+	// DeleteSwapSchedule deletes a recurring swap schedule along with the record
+	// of its runs.
+	DeleteSwapSchedule(context.Context, *DeleteSwapScheduleRequest) (*DeleteSwapScheduleResponse, error)
 	// loop: `listreservations`
 	// ListReservations returns a list of all reservations the server opened to us.
 	ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error)
@@ -616,6 +985,69 @@ func (UnimplementedSwapClientServer) SetLiquidityParams(context.Context, *SetLiq
 func (UnimplementedSwapClientServer) SuggestSwaps(context.Context, *SuggestSwapsRequest) (*SuggestSwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuggestSwaps not implemented")
 }
+func (UnimplementedSwapClientServer) SimulateAutoloop(context.Context, *SimulateAutoloopRequest) (*SimulateAutoloopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAutoloop not implemented")
+}
+func (UnimplementedSwapClientServer) ListAutoloopDecisions(context.Context, *ListAutoloopDecisionsRequest) (*ListAutoloopDecisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAutoloopDecisions not implemented")
+}
+func (UnimplementedSwapClientServer) ListLiquidityProfiles(context.Context, *ListLiquidityProfilesRequest) (*ListLiquidityProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLiquidityProfiles not implemented")
+}
+func (UnimplementedSwapClientServer) SaveLiquidityProfile(context.Context, *SaveLiquidityProfileRequest) (*SaveLiquidityProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveLiquidityProfile not implemented")
+}
+func (UnimplementedSwapClientServer) ActivateLiquidityProfile(context.Context, *ActivateLiquidityProfileRequest) (*ActivateLiquidityProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateLiquidityProfile not implemented")
+}
+func (UnimplementedSwapClientServer) RollbackLiquidityProfile(context.Context, *RollbackLiquidityProfileRequest) (*RollbackLiquidityProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackLiquidityProfile not implemented")
+}
+func (UnimplementedSwapClientServer) DiffLiquidityProfiles(context.Context, *DiffLiquidityProfilesRequest) (*DiffLiquidityProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffLiquidityProfiles not implemented")
+}
+func (UnimplementedSwapClientServer) ListQueuedSwaps(context.Context, *ListQueuedSwapsRequest) (*ListQueuedSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueuedSwaps not implemented")
+}
+func (UnimplementedSwapClientServer) ApproveQueuedSwap(context.Context, *ApproveQueuedSwapRequest) (*ApproveQueuedSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveQueuedSwap not implemented")
+}
+func (UnimplementedSwapClientServer) RejectQueuedSwap(context.Context, *RejectQueuedSwapRequest) (*RejectQueuedSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectQueuedSwap not implemented")
+}
+func (UnimplementedSwapClientServer) ExportLiquidityParams(context.Context, *ExportLiquidityParamsRequest) (*ExportLiquidityParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportLiquidityParams not implemented")
+}
+func (UnimplementedSwapClientServer) ImportLiquidityParams(context.Context, *ImportLiquidityParamsRequest) (*ImportLiquidityParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportLiquidityParams not implemented")
+}
+func (UnimplementedSwapClientServer) ScheduleLoopOut(context.Context, *ScheduleLoopOutRequest) (*ScheduleLoopOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleLoopOut not implemented")
+}
+func (UnimplementedSwapClientServer) ListScheduledLoopOuts(context.Context, *ListScheduledLoopOutsRequest) (*ListScheduledLoopOutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledLoopOuts not implemented")
+}
+func (UnimplementedSwapClientServer) CancelScheduledLoopOut(context.Context, *CancelScheduledLoopOutRequest) (*CancelScheduledLoopOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledLoopOut not implemented")
+}
+func (UnimplementedSwapClientServer) AddSwapSchedule(context.Context, *AddSwapScheduleRequest) (*AddSwapScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSwapSchedule not implemented")
+}
+func (UnimplementedSwapClientServer) ListSwapSchedules(context.Context, *ListSwapSchedulesRequest) (*ListSwapSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwapSchedules not implemented")
+}
+func (UnimplementedSwapClientServer) ListSwapScheduleRuns(context.Context, *ListSwapScheduleRunsRequest) (*ListSwapScheduleRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwapScheduleRuns not implemented")
+}
+func (UnimplementedSwapClientServer) PauseSwapSchedule(context.Context, *PauseSwapScheduleRequest) (*PauseSwapScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSwapSchedule not implemented")
+}
+func (UnimplementedSwapClientServer) ResumeSwapSchedule(context.Context, *ResumeSwapScheduleRequest) (*ResumeSwapScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSwapSchedule not implemented")
+}
+func (UnimplementedSwapClientServer) DeleteSwapSchedule(context.Context, *DeleteSwapScheduleRequest) (*DeleteSwapScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSwapSchedule not implemented")
+}
 func (UnimplementedSwapClientServer) ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReservations not implemented")
 }
@@ -992,6 +1424,384 @@ func _SwapClient_SuggestSwaps_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SwapClient_SimulateAutoloop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateAutoloopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).SimulateAutoloop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/SimulateAutoloop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).SimulateAutoloop(ctx, req.(*SimulateAutoloopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListAutoloopDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAutoloopDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListAutoloopDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListAutoloopDecisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListAutoloopDecisions(ctx, req.(*ListAutoloopDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListLiquidityProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLiquidityProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListLiquidityProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListLiquidityProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListLiquidityProfiles(ctx, req.(*ListLiquidityProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_SaveLiquidityProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveLiquidityProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).SaveLiquidityProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/SaveLiquidityProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).SaveLiquidityProfile(ctx, req.(*SaveLiquidityProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ActivateLiquidityProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateLiquidityProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ActivateLiquidityProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ActivateLiquidityProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ActivateLiquidityProfile(ctx, req.(*ActivateLiquidityProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_RollbackLiquidityProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackLiquidityProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).RollbackLiquidityProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/RollbackLiquidityProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).RollbackLiquidityProfile(ctx, req.(*RollbackLiquidityProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_DiffLiquidityProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffLiquidityProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).DiffLiquidityProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/DiffLiquidityProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).DiffLiquidityProfiles(ctx, req.(*DiffLiquidityProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListQueuedSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueuedSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListQueuedSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListQueuedSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListQueuedSwaps(ctx, req.(*ListQueuedSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ApproveQueuedSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveQueuedSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ApproveQueuedSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ApproveQueuedSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ApproveQueuedSwap(ctx, req.(*ApproveQueuedSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_RejectQueuedSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectQueuedSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).RejectQueuedSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/RejectQueuedSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).RejectQueuedSwap(ctx, req.(*RejectQueuedSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ExportLiquidityParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportLiquidityParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ExportLiquidityParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ExportLiquidityParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ExportLiquidityParams(ctx, req.(*ExportLiquidityParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ImportLiquidityParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportLiquidityParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ImportLiquidityParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ImportLiquidityParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ImportLiquidityParams(ctx, req.(*ImportLiquidityParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ScheduleLoopOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleLoopOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ScheduleLoopOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ScheduleLoopOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ScheduleLoopOut(ctx, req.(*ScheduleLoopOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListScheduledLoopOuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledLoopOutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListScheduledLoopOuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListScheduledLoopOuts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListScheduledLoopOuts(ctx, req.(*ListScheduledLoopOutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_CancelScheduledLoopOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledLoopOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).CancelScheduledLoopOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/CancelScheduledLoopOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).CancelScheduledLoopOut(ctx, req.(*CancelScheduledLoopOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_AddSwapSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSwapScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).AddSwapSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/AddSwapSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).AddSwapSchedule(ctx, req.(*AddSwapScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListSwapSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwapSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListSwapSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListSwapSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListSwapSchedules(ctx, req.(*ListSwapSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListSwapScheduleRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwapScheduleRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListSwapScheduleRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListSwapScheduleRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListSwapScheduleRuns(ctx, req.(*ListSwapScheduleRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_PauseSwapSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSwapScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).PauseSwapSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/PauseSwapSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).PauseSwapSchedule(ctx, req.(*PauseSwapScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ResumeSwapSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSwapScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ResumeSwapSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ResumeSwapSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ResumeSwapSchedule(ctx, req.(*ResumeSwapScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_DeleteSwapSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSwapScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).DeleteSwapSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/DeleteSwapSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).DeleteSwapSchedule(ctx, req.(*DeleteSwapScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SwapClient_ListReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListReservationsRequest)
 	if err := dec(in); err != nil {
@@ -1283,6 +2093,90 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SuggestSwaps",
 			Handler:    _SwapClient_SuggestSwaps_Handler,
 		},
+		{
+			MethodName: "SimulateAutoloop",
+			Handler:    _SwapClient_SimulateAutoloop_Handler,
+		},
+		{
+			MethodName: "ListAutoloopDecisions",
+			Handler:    _SwapClient_ListAutoloopDecisions_Handler,
+		},
+		{
+			MethodName: "ListLiquidityProfiles",
+			Handler:    _SwapClient_ListLiquidityProfiles_Handler,
+		},
+		{
+			MethodName: "SaveLiquidityProfile",
+			Handler:    _SwapClient_SaveLiquidityProfile_Handler,
+		},
+		{
+			MethodName: "ActivateLiquidityProfile",
+			Handler:    _SwapClient_ActivateLiquidityProfile_Handler,
+		},
+		{
+			MethodName: "RollbackLiquidityProfile",
+			Handler:    _SwapClient_RollbackLiquidityProfile_Handler,
+		},
+		{
+			MethodName: "DiffLiquidityProfiles",
+			Handler:    _SwapClient_DiffLiquidityProfiles_Handler,
+		},
+		{
+			MethodName: "ListQueuedSwaps",
+			Handler:    _SwapClient_ListQueuedSwaps_Handler,
+		},
+		{
+			MethodName: "ApproveQueuedSwap",
+			Handler:    _SwapClient_ApproveQueuedSwap_Handler,
+		},
+		{
+			MethodName: "RejectQueuedSwap",
+			Handler:    _SwapClient_RejectQueuedSwap_Handler,
+		},
+		{
+			MethodName: "ExportLiquidityParams",
+			Handler:    _SwapClient_ExportLiquidityParams_Handler,
+		},
+		{
+			MethodName: "ImportLiquidityParams",
+			Handler:    _SwapClient_ImportLiquidityParams_Handler,
+		},
+		{
+			MethodName: "ScheduleLoopOut",
+			Handler:    _SwapClient_ScheduleLoopOut_Handler,
+		},
+		{
+			MethodName: "ListScheduledLoopOuts",
+			Handler:    _SwapClient_ListScheduledLoopOuts_Handler,
+		},
+		{
+			MethodName: "CancelScheduledLoopOut",
+			Handler:    _SwapClient_CancelScheduledLoopOut_Handler,
+		},
+		{
+			MethodName: "AddSwapSchedule",
+			Handler:    _SwapClient_AddSwapSchedule_Handler,
+		},
+		{
+			MethodName: "ListSwapSchedules",
+			Handler:    _SwapClient_ListSwapSchedules_Handler,
+		},
+		{
+			MethodName: "ListSwapScheduleRuns",
+			Handler:    _SwapClient_ListSwapScheduleRuns_Handler,
+		},
+		{
+			MethodName: "PauseSwapSchedule",
+			Handler:    _SwapClient_PauseSwapSchedule_Handler,
+		},
+		{
+			MethodName: "ResumeSwapSchedule",
+			Handler:    _SwapClient_ResumeSwapSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSwapSchedule",
+			Handler:    _SwapClient_DeleteSwapSchedule_Handler,
+		},
 		{
 			MethodName: "ListReservations",
 			Handler:    _SwapClient_ListReservations_Handler,
@@ -141,6 +141,114 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "suggestions",
 		Action: "read",
 	}},
+	"/looprpc.SwapClient/SimulateAutoloop": {{
+		Entity: "suggestions",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/ListAutoloopDecisions": {{
+		Entity: "suggestions",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/ListLiquidityProfiles": {{
+		Entity: "suggestions",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/SaveLiquidityProfile": {{
+		Entity: "suggestions",
+		Action: "write",
+	}},
+	"/looprpc.SwapClient/ActivateLiquidityProfile": {{
+		Entity: "suggestions",
+		Action: "write",
+	}},
+	"/looprpc.SwapClient/RollbackLiquidityProfile": {{
+		Entity: "suggestions",
+		Action: "write",
+	}},
+	"/looprpc.SwapClient/DiffLiquidityProfiles": {{
+		Entity: "suggestions",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/ListQueuedSwaps": {{
+		Entity: "suggestions",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/ApproveQueuedSwap": {{
+		Entity: "swap",
+		Action: "execute",
+	}, {
+		Entity: "loop",
+		Action: "out",
+	}, {
+		Entity: "loop",
+		Action: "in",
+	}},
+	"/looprpc.SwapClient/RejectQueuedSwap": {{
+		Entity: "suggestions",
+		Action: "write",
+	}},
+	"/looprpc.SwapClient/ExportLiquidityParams": {{
+		Entity: "suggestions",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/ImportLiquidityParams": {{
+		Entity: "suggestions",
+		Action: "write",
+	}},
+	"/looprpc.SwapClient/ScheduleLoopOut": {{
+		Entity: "swap",
+		Action: "execute",
+	}, {
+		Entity: "loop",
+		Action: "out",
+	}},
+	"/looprpc.SwapClient/ListScheduledLoopOuts": {{
+		Entity: "swap",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/CancelScheduledLoopOut": {{
+		Entity: "swap",
+		Action: "execute",
+	}, {
+		Entity: "loop",
+		Action: "out",
+	}},
+	"/looprpc.SwapClient/AddSwapSchedule": {{
+		Entity: "swap",
+		Action: "execute",
+	}, {
+		Entity: "loop",
+		Action: "out",
+	}, {
+		Entity: "loop",
+		Action: "in",
+	}},
+	"/looprpc.SwapClient/ListSwapSchedules": {{
+		Entity: "swap",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/ListSwapScheduleRuns": {{
+		Entity: "swap",
+		Action: "read",
+	}},
+	"/looprpc.SwapClient/PauseSwapSchedule": {{
+		Entity: "swap",
+		Action: "execute",
+	}},
+	"/looprpc.SwapClient/ResumeSwapSchedule": {{
+		Entity: "swap",
+		Action: "execute",
+	}, {
+		Entity: "loop",
+		Action: "out",
+	}, {
+		Entity: "loop",
+		Action: "in",
+	}},
+	"/looprpc.SwapClient/DeleteSwapSchedule": {{
+		Entity: "swap",
+		Action: "execute",
+	}},
 	"/looprpc.SwapClient/GetInfo": {{
 		Entity: "suggestions",
 		Action: "read",
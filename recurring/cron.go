@@ -0,0 +1,251 @@
+package recurring
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchYears is the number of years that we search for the next time
+// that matches a cron expression. Expressions that can't be matched, such as
+// the 30th of February, would otherwise be searched forever.
+const cronSearchYears = 5
+
+// errCronNoMatch is returned when a cron expression does not match any time
+// within our search range.
+var errCronNoMatch = errors.New("cron expression does not match any time")
+
+// cronField describes the range of values of a field of a cron expression.
+type cronField struct {
+	name string
+	min  int
+	max  int
+}
+
+var (
+	minuteField  = cronField{name: "minute", min: 0, max: 59}
+	hourField    = cronField{name: "hour", min: 0, max: 23}
+	dayField     = cronField{name: "day of month", min: 1, max: 31}
+	monthField   = cronField{name: "month", min: 1, max: 12}
+	weekdayField = cronField{name: "day of week", min: 0, max: 7}
+)
+
+// cronSchedule is a parsed cron expression. Each field holds a bitset of the
+// values that it matches. All times are matched in UTC.
+type cronSchedule struct {
+	minutes  uint64
+	hours    uint64
+	days     uint64
+	months   uint64
+	weekdays uint64
+
+	// anyDay and anyWeekday indicate that the day of month and day of
+	// week fields start with a wildcard. If both fields are restricted, a
+	// day matches if either of them matches.
+	anyDay     bool
+	anyWeekday bool
+}
+
+// parseCron parses a standard five field cron expression of the form
+// "minute hour day-of-month month day-of-week". Each field may be a wildcard,
+// a value, a range or a comma separated list of those, each of which may
+// have a step. Sunday may be given as 0 or 7.
+func parseCron(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression must have 5 fields, "+
+			"got: %v", len(fields))
+	}
+
+	var (
+		schedule cronSchedule
+		err      error
+	)
+
+	schedule.minutes, err = parseCronField(fields[0], minuteField)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.hours, err = parseCronField(fields[1], hourField)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.days, err = parseCronField(fields[2], dayField)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.months, err = parseCronField(fields[3], monthField)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.weekdays, err = parseCronField(fields[4], weekdayField)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sunday may be expressed as 7, so we fold it onto 0.
+	if schedule.weekdays&(1<<7) != 0 {
+		schedule.weekdays |= 1
+		schedule.weekdays &^= 1 << 7
+	}
+
+	schedule.anyDay = strings.HasPrefix(fields[2], "*")
+	schedule.anyWeekday = strings.HasPrefix(fields[4], "*")
+
+	return &schedule, nil
+}
+
+// parseCronField parses a single field of a cron expression into a bitset
+// of the values that it matches.
+func parseCronField(expr string, field cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(expr, ",") {
+		partBits, err := parseCronPart(part, field)
+		if err != nil {
+			return 0, fmt.Errorf("%v field: %w", field.name, err)
+		}
+
+		bits |= partBits
+	}
+
+	return bits, nil
+}
+
+// parseCronPart parses a wildcard, value or range with an optional step.
+func parseCronPart(part string, field cronField) (uint64, error) {
+	var (
+		rangeExpr = part
+		step      = 1
+		err       error
+	)
+
+	if i := strings.Index(part, "/"); i != -1 {
+		rangeExpr = part[:i]
+
+		step, err = strconv.Atoi(part[i+1:])
+		if err != nil {
+			return 0, fmt.Errorf("invalid step: %v", part)
+		}
+
+		if step < 1 {
+			return 0, fmt.Errorf("step must be positive: %v", part)
+		}
+	}
+
+	var start, end int
+	switch {
+	case rangeExpr == "*":
+		start, end = field.min, field.max
+
+	case strings.Contains(rangeExpr, "-"):
+		bounds := strings.SplitN(rangeExpr, "-", 2)
+
+		start, err = parseCronValue(bounds[0], field)
+		if err != nil {
+			return 0, err
+		}
+
+		end, err = parseCronValue(bounds[1], field)
+		if err != nil {
+			return 0, err
+		}
+
+		if start > end {
+			return 0, fmt.Errorf("invalid range: %v", rangeExpr)
+		}
+
+	default:
+		start, err = parseCronValue(rangeExpr, field)
+		if err != nil {
+			return 0, err
+		}
+
+		// A single value with a step, such as 5/15, runs from the
+		// value to the end of the range.
+		end = start
+		if strings.Contains(part, "/") {
+			end = field.max
+		}
+	}
+
+	var bits uint64
+	for value := start; value <= end; value += step {
+		bits |= 1 << uint(value)
+	}
+
+	return bits, nil
+}
+
+// parseCronValue parses a single value of a cron field and checks that it is
+// within the field's range.
+func parseCronValue(value string, field cronField) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value: %v", value)
+	}
+
+	if v < field.min || v > field.max {
+		return 0, fmt.Errorf("value %v out of range [%v, %v]", v,
+			field.min, field.max)
+	}
+
+	return v, nil
+}
+
+// next returns the first time after the time provided that matches the cron
+// expression.
+func (c *cronSchedule) next(after time.Time) (time.Time, error) {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(cronSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if c.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(
+				t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC,
+			)
+			continue
+		}
+
+		if !c.dayMatches(t) {
+			t = time.Date(
+				t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0,
+				time.UTC,
+			)
+			continue
+		}
+
+		if c.hours&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+
+		if c.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t, nil
+	}
+
+	return time.Time{}, errCronNoMatch
+}
+
+// dayMatches returns a boolean that indicates whether the day of the time
+// provided matches our day of month and day of week fields. Following cron
+// convention, a day matches either field if both of them are restricted.
+func (c *cronSchedule) dayMatches(t time.Time) bool {
+	day := c.days&(1<<uint(t.Day())) != 0
+	weekday := c.weekdays&(1<<uint(t.Weekday())) != 0
+
+	if c.anyDay || c.anyWeekday {
+		return day && weekday
+	}
+
+	return day || weekday
+}
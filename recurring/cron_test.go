@@ -0,0 +1,169 @@
+package recurring
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseCron tests parsing of valid and invalid cron expressions.
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		err  bool
+	}{
+		{
+			name: "wildcards",
+			expr: "* * * * *",
+		},
+		{
+			name: "lists, ranges and steps",
+			expr: "0,30 9-17/2 1-15 */3 1-5",
+		},
+		{
+			name: "sunday as seven",
+			expr: "0 0 * * 7",
+		},
+		{
+			name: "too few fields",
+			expr: "0 0 * *",
+			err:  true,
+		},
+		{
+			name: "minute out of range",
+			expr: "60 0 * * *",
+			err:  true,
+		},
+		{
+			name: "day out of range",
+			expr: "0 0 0 * *",
+			err:  true,
+		},
+		{
+			name: "inverted range",
+			expr: "0 10-9 * * *",
+			err:  true,
+		},
+		{
+			name: "zero step",
+			expr: "*/0 * * * *",
+			err:  true,
+		},
+		{
+			name: "not a number",
+			expr: "a * * * *",
+			err:  true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := parseCron(testCase.expr)
+			if testCase.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestCronNext tests finding the next time that matches a cron expression.
+func TestCronNext(t *testing.T) {
+	// after is a Wednesday.
+	after := time.Date(2024, 1, 3, 10, 30, 15, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		expr     string
+		expected time.Time
+	}{
+		{
+			name: "every minute",
+			expr: "* * * * *",
+			expected: time.Date(
+				2024, 1, 3, 10, 31, 0, 0, time.UTC,
+			),
+		},
+		{
+			name: "later today",
+			expr: "0 12 * * *",
+			expected: time.Date(
+				2024, 1, 3, 12, 0, 0, 0, time.UTC,
+			),
+		},
+		{
+			name: "tomorrow",
+			expr: "0 9 * * *",
+			expected: time.Date(
+				2024, 1, 4, 9, 0, 0, 0, time.UTC,
+			),
+		},
+		{
+			name: "weekly on sunday",
+			expr: "0 0 * * 0",
+			expected: time.Date(
+				2024, 1, 7, 0, 0, 0, 0, time.UTC,
+			),
+		},
+		{
+			name: "sunday as seven",
+			expr: "0 0 * * 7",
+			expected: time.Date(
+				2024, 1, 7, 0, 0, 0, 0, time.UTC,
+			),
+		},
+		{
+			name: "monthly",
+			expr: "15 6 1 * *",
+			expected: time.Date(
+				2024, 2, 1, 6, 15, 0, 0, time.UTC,
+			),
+		},
+		{
+			name: "leap day",
+			expr: "0 0 29 2 *",
+			expected: time.Date(
+				2024, 2, 29, 0, 0, 0, 0, time.UTC,
+			),
+		},
+		{
+			name: "step",
+			expr: "*/20 * * * *",
+			expected: time.Date(
+				2024, 1, 3, 10, 40, 0, 0, time.UTC,
+			),
+		},
+		{
+			// If both day fields are restricted, either matches,
+			// so the 4th matches before the next Friday.
+			name: "day of month or week",
+			expr: "0 0 4 * 5",
+			expected: time.Date(
+				2024, 1, 4, 0, 0, 0, 0, time.UTC,
+			),
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cron, err := parseCron(testCase.expr)
+			require.NoError(t, err)
+
+			next, err := cron.next(after)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, next)
+		})
+	}
+
+	// An expression that never matches returns an error rather than
+	// searching forever.
+	cron, err := parseCron("0 0 30 2 *")
+	require.NoError(t, err)
+
+	_, err = cron.next(after)
+	require.ErrorIs(t, err, errCronNoMatch)
+}
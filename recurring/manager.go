@@ -0,0 +1,600 @@
+package recurring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// pollInterval is the interval at which we check whether any of our
+	// schedules are due.
+	pollInterval = time.Minute
+
+	// retryInterval is the longest that we wait before we retry a failed
+	// run. If the schedule's next regular run is sooner, we retry then.
+	retryInterval = time.Hour
+
+	// swapPublicationWait is the time that we allow the server to wait
+	// before it publishes the htlc of a loop out. Scheduled swaps are not
+	// urgent, so we opt for the lower fees of a slower swap.
+	swapPublicationWait = 30 * time.Minute
+
+	// swapInitiator is the initiator of the swaps that our schedules
+	// dispatch.
+	swapInitiator = "recurring"
+)
+
+var (
+	// ErrMinerFeeTooHigh is returned when the quoted on-chain fee of a
+	// swap exceeds the schedule's maximum miner fee.
+	ErrMinerFeeTooHigh = errors.New("miner fee too high")
+
+	// ErrAccountAddrType is returned when a schedule is created with an
+	// account but without the address type of the account.
+	ErrAccountAddrType = errors.New("account address type required " +
+		"when an account is set")
+
+	// ErrAccountNotFound is returned when a schedule is created with an
+	// account that our wallet does not know.
+	ErrAccountNotFound = errors.New("account not found")
+)
+
+// Config contains the external functionality required by the manager.
+type Config struct {
+	// Store persists our schedules and their runs.
+	Store Store
+
+	// WalletKit is used to derive the addresses that loop outs are swept
+	// to.
+	WalletKit lndclient.WalletKitClient
+
+	// ChainParams are the parameters of the chain that we operate on.
+	ChainParams *chaincfg.Params
+
+	// Clock allows easy mocking of time in unit tests.
+	Clock clock.Clock
+
+	// LoopOutQuote gets swap fee, estimated miner fee and prepay amount for
+	// a loop out swap.
+	LoopOutQuote func(ctx context.Context,
+		request *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote, error)
+
+	// LoopInQuote provides a quote for a loop in swap.
+	LoopInQuote func(ctx context.Context,
+		request *loop.LoopInQuoteRequest) (*loop.LoopInQuote, error)
+
+	// LoopOut dispatches a loop out.
+	LoopOut func(ctx context.Context, request *loop.OutRequest) (
+		*loop.LoopOutSwapInfo, error)
+
+	// LoopIn dispatches a loop in swap.
+	LoopIn func(ctx context.Context,
+		request *loop.LoopInRequest) (*loop.LoopInSwapInfo, error)
+}
+
+// Manager dispatches swaps on behalf of our recurring swap schedules.
+type Manager struct {
+	cfg *Config
+
+	// mu ensures that a schedule can't be changed while it runs.
+	mu sync.Mutex
+
+	// update is signaled when a schedule is created or resumed, so that
+	// we pick up its next run right away.
+	update chan struct{}
+}
+
+// NewManager creates a new recurring swap manager.
+func NewManager(cfg *Config) *Manager {
+	return &Manager{
+		cfg:    cfg,
+		update: make(chan struct{}, 1),
+	}
+}
+
+// Run dispatches the swaps of our active schedules when they are due. Since
+// schedules are loaded from our store, a schedule that became due while we
+// were offline runs once when we start, and then resumes its regular
+// schedule.
+func (m *Manager) Run(ctx context.Context) error {
+	for {
+		if err := m.runSchedules(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			log.Errorf("could not run swap schedules: %v", err)
+		}
+
+		select {
+		case <-m.update:
+
+		case <-m.cfg.Clock.TickAfter(pollInterval):
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// CreateSchedule validates and persists a new schedule. The schedule's
+// state, next run and timestamps are set by the manager. If no start time is
+// set, the schedule starts now.
+func (m *Manager) CreateSchedule(ctx context.Context,
+	schedule *Schedule) (*Schedule, error) {
+
+	if err := schedule.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := m.validateDest(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	now := m.cfg.Clock.Now()
+	if schedule.Start.IsZero() {
+		schedule.Start = now
+	}
+
+	if schedule.MaxFailures == 0 {
+		schedule.MaxFailures = DefaultMaxFailures
+	}
+
+	nextRun, err := schedule.nextRun(now)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCron, err)
+	}
+
+	schedule.State = StateActive
+	schedule.ConsecutiveFailures = 0
+	schedule.NextRun = nextRun
+	schedule.Created = now
+	schedule.Updated = now
+
+	schedule.ID, err = m.cfg.Store.AddSchedule(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Created %v schedule %v for %v, next run: %v",
+		schedule.Type, schedule.Name, schedule.Amount, schedule.NextRun)
+
+	m.signalUpdate()
+
+	return schedule, nil
+}
+
+// ListSchedules returns all of our schedules.
+func (m *Manager) ListSchedules(ctx context.Context) ([]*Schedule, error) {
+	return m.cfg.Store.ListSchedules(ctx)
+}
+
+// GetSchedule returns the schedule with the name provided.
+func (m *Manager) GetSchedule(ctx context.Context,
+	name string) (*Schedule, error) {
+
+	return m.cfg.Store.GetSchedule(ctx, name)
+}
+
+// PauseSchedule pauses a schedule so that it no longer dispatches swaps.
+func (m *Manager) PauseSchedule(ctx context.Context,
+	name string) (*Schedule, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	schedule, err := m.cfg.Store.GetSchedule(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.State == StatePaused {
+		return nil, ErrAlreadyPaused
+	}
+
+	schedule.State = StatePaused
+	schedule.Updated = m.cfg.Clock.Now()
+
+	if err := m.cfg.Store.UpdateSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Paused schedule %v", name)
+
+	return schedule, nil
+}
+
+// ResumeSchedule resumes a paused schedule. Its failure count is reset and it
+// next runs at its first regular run from now.
+func (m *Manager) ResumeSchedule(ctx context.Context,
+	name string) (*Schedule, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	schedule, err := m.cfg.Store.GetSchedule(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.State == StateActive {
+		return nil, ErrAlreadyActive
+	}
+
+	now := m.cfg.Clock.Now()
+	nextRun, err := schedule.nextRun(now)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.State = StateActive
+	schedule.ConsecutiveFailures = 0
+	schedule.NextRun = nextRun
+	schedule.Updated = now
+
+	if err := m.cfg.Store.UpdateSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Resumed schedule %v, next run: %v", name, nextRun)
+
+	m.signalUpdate()
+
+	return schedule, nil
+}
+
+// DeleteSchedule deletes a schedule along with the record of its runs.
+func (m *Manager) DeleteSchedule(ctx context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	schedule, err := m.cfg.Store.GetSchedule(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Deleting schedule %v", name)
+
+	return m.cfg.Store.DeleteSchedule(ctx, schedule.ID)
+}
+
+// ListRuns returns the most recent runs of the schedule with the name
+// provided, newest first.
+func (m *Manager) ListRuns(ctx context.Context, name string,
+	limit uint32) ([]*Run, error) {
+
+	schedule, err := m.cfg.Store.GetSchedule(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.cfg.Store.ListRuns(ctx, schedule.ID, limit)
+}
+
+// signalUpdate signals our main loop to check our schedules. If a check is
+// already signaled, we don't need to signal again.
+func (m *Manager) signalUpdate() {
+	select {
+	case m.update <- struct{}{}:
+	default:
+	}
+}
+
+// validateDest checks that the destination of a loop out schedule is valid
+// for our chain, and that its account exists.
+func (m *Manager) validateDest(ctx context.Context, schedule *Schedule) error {
+	switch {
+	case schedule.Dest != "":
+		addr, err := btcutil.DecodeAddress(
+			schedule.Dest, m.cfg.ChainParams,
+		)
+		if err != nil {
+			return fmt.Errorf("decode address: %w", err)
+		}
+
+		if !addr.IsForNet(m.cfg.ChainParams) {
+			return fmt.Errorf("address %v is not for network %v",
+				schedule.Dest, m.cfg.ChainParams.Name)
+		}
+
+	case schedule.Account != "":
+		if schedule.AccountAddrType == walletrpc.AddressType_UNKNOWN {
+			return ErrAccountAddrType
+		}
+
+		accounts, err := m.cfg.WalletKit.ListAccounts(
+			ctx, schedule.Account, schedule.AccountAddrType,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			if account.Name == schedule.Account {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%w: %v", ErrAccountNotFound,
+			schedule.Account)
+	}
+
+	return nil
+}
+
+// runSchedules runs each of our active schedules that is due.
+func (m *Manager) runSchedules(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	schedules, err := m.cfg.Store.ListSchedules(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, schedule := range schedules {
+		if schedule.State != StateActive {
+			continue
+		}
+
+		now := m.cfg.Clock.Now()
+		if now.Before(schedule.NextRun) {
+			continue
+		}
+
+		if err := m.runSchedule(ctx, schedule, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runSchedule dispatches the swap of a schedule that is due, records the run
+// and sets the schedule's next run. If the swap could not be dispatched, the
+// run is retried sooner than the schedule's next regular run. Once too many
+// runs failed in a row, the schedule is paused.
+func (m *Manager) runSchedule(ctx context.Context, schedule *Schedule,
+	now time.Time) error {
+
+	log.Infof("Running %v schedule %v for %v", schedule.Type,
+		schedule.Name, schedule.Amount)
+
+	run := &Run{
+		ScheduleID: schedule.ID,
+		Time:       now,
+	}
+
+	hash, err := m.dispatch(ctx, schedule, now)
+	switch {
+	case err != nil && ctx.Err() != nil:
+		return ctx.Err()
+
+	case err != nil:
+		run.Error = err.Error()
+		schedule.ConsecutiveFailures++
+
+		log.Warnf("Schedule %v failed (%v/%v): %v", schedule.Name,
+			schedule.ConsecutiveFailures, schedule.MaxFailures, err)
+
+	default:
+		run.SwapHash = &hash
+		schedule.ConsecutiveFailures = 0
+
+		log.Infof("Schedule %v dispatched swap %v", schedule.Name,
+			hash)
+	}
+
+	if schedule.ConsecutiveFailures >= schedule.MaxFailures {
+		log.Warnf("Pausing schedule %v after %v consecutive failures",
+			schedule.Name, schedule.ConsecutiveFailures)
+
+		schedule.State = StatePaused
+	}
+
+	nextRun, err := schedule.nextRun(now)
+	if err != nil {
+		log.Warnf("Pausing schedule %v, could not get its next run: "+
+			"%v", schedule.Name, err)
+
+		schedule.State = StatePaused
+	}
+
+	// A failed run is retried before the schedule's next regular run.
+	retry := now.Add(retryInterval)
+	if run.Error != "" && retry.Before(nextRun) {
+		nextRun = retry
+	}
+
+	schedule.NextRun = nextRun
+	schedule.Updated = now
+
+	return m.cfg.Store.RecordRun(ctx, run, schedule)
+}
+
+// dispatch quotes the swap of a schedule, checks the quote against the
+// schedule's limits and dispatches the swap.
+func (m *Manager) dispatch(ctx context.Context, schedule *Schedule,
+	now time.Time) (lntypes.Hash, error) {
+
+	label := schedule.Label
+	if label == "" {
+		label = labels.RecurringLabel(schedule.Type)
+	}
+
+	switch schedule.Type {
+	case swap.TypeOut:
+		return m.dispatchLoopOut(ctx, schedule, label, now)
+
+	case swap.TypeIn:
+		return m.dispatchLoopIn(ctx, schedule, label)
+
+	default:
+		return lntypes.Hash{}, fmt.Errorf("unsupported swap type: %v",
+			schedule.Type)
+	}
+}
+
+// dispatchLoopOut dispatches the loop out of a schedule.
+func (m *Manager) dispatchLoopOut(ctx context.Context, schedule *Schedule,
+	label string, now time.Time) (lntypes.Hash, error) {
+
+	confTarget := schedule.ConfTarget
+	if confTarget == 0 {
+		confTarget = loop.DefaultSweepConfTarget
+	}
+
+	deadline := now.Add(swapPublicationWait)
+
+	quote, err := m.cfg.LoopOutQuote(ctx, &loop.LoopOutQuoteRequest{
+		Amount:                  schedule.Amount,
+		SweepConfTarget:         confTarget,
+		SwapPublicationDeadline: deadline,
+		Initiator:               swapInitiator,
+	})
+	if err != nil {
+		return lntypes.Hash{}, fmt.Errorf("quote: %w", err)
+	}
+
+	if quote.SwapFee > schedule.MaxSwapFee {
+		return lntypes.Hash{}, fmt.Errorf("%w: quoted %v, maximum %v",
+			loop.ErrSwapFeeTooHigh, quote.SwapFee,
+			schedule.MaxSwapFee)
+	}
+
+	if quote.PrepayAmount > schedule.MaxPrepayAmount {
+		return lntypes.Hash{}, fmt.Errorf("%w: quoted %v, maximum %v",
+			loop.ErrPrepayAmountTooHigh, quote.PrepayAmount,
+			schedule.MaxPrepayAmount)
+	}
+
+	if quote.MinerFee > schedule.MaxMinerFee {
+		return lntypes.Hash{}, fmt.Errorf("%w: quoted %v, maximum %v",
+			ErrMinerFeeTooHigh, quote.MinerFee,
+			schedule.MaxMinerFee)
+	}
+
+	dest, external, err := m.destAddr(ctx, schedule)
+	if err != nil {
+		return lntypes.Hash{}, err
+	}
+
+	info, err := m.cfg.LoopOut(ctx, &loop.OutRequest{
+		Amount:                  schedule.Amount,
+		DestAddr:                dest,
+		IsExternalAddr:          external,
+		MaxSwapRoutingFee:       schedule.MaxSwapRoutingFee,
+		MaxPrepayRoutingFee:     schedule.MaxPrepayRoutingFee,
+		MaxSwapFee:              schedule.MaxSwapFee,
+		MaxPrepayAmount:         schedule.MaxPrepayAmount,
+		MaxMinerFee:             schedule.MaxMinerFee,
+		SweepConfTarget:         confTarget,
+		SwapPublicationDeadline: deadline,
+		Label:                   label,
+		Initiator:               swapInitiator,
+	})
+	if err != nil {
+		return lntypes.Hash{}, err
+	}
+
+	return info.SwapHash, nil
+}
+
+// dispatchLoopIn dispatches the loop in of a schedule.
+func (m *Manager) dispatchLoopIn(ctx context.Context, schedule *Schedule,
+	label string) (lntypes.Hash, error) {
+
+	confTarget := schedule.ConfTarget
+	if confTarget == 0 {
+		confTarget = loop.DefaultHtlcConfTarget
+	}
+
+	quote, err := m.cfg.LoopInQuote(ctx, &loop.LoopInQuoteRequest{
+		Amount:         schedule.Amount,
+		HtlcConfTarget: confTarget,
+		Initiator:      swapInitiator,
+	})
+	if err != nil {
+		return lntypes.Hash{}, fmt.Errorf("quote: %w", err)
+	}
+
+	if quote.SwapFee > schedule.MaxSwapFee {
+		return lntypes.Hash{}, fmt.Errorf("%w: quoted %v, maximum %v",
+			loop.ErrSwapFeeTooHigh, quote.SwapFee,
+			schedule.MaxSwapFee)
+	}
+
+	if quote.MinerFee > schedule.MaxMinerFee {
+		return lntypes.Hash{}, fmt.Errorf("%w: quoted %v, maximum %v",
+			ErrMinerFeeTooHigh, quote.MinerFee,
+			schedule.MaxMinerFee)
+	}
+
+	info, err := m.cfg.LoopIn(ctx, &loop.LoopInRequest{
+		Amount:         schedule.Amount,
+		MaxSwapFee:     schedule.MaxSwapFee,
+		MaxMinerFee:    schedule.MaxMinerFee,
+		HtlcConfTarget: confTarget,
+		Label:          label,
+		Initiator:      swapInitiator,
+	})
+	if err != nil {
+		return lntypes.Hash{}, err
+	}
+
+	return info.SwapHash, nil
+}
+
+// destAddr returns the address that the loop out of a schedule is swept to,
+// and whether the address is external to our wallet. A new address is
+// derived for each run if the schedule sweeps to an account or our wallet.
+func (m *Manager) destAddr(ctx context.Context,
+	schedule *Schedule) (btcutil.Address, bool, error) {
+
+	switch {
+	case schedule.Dest != "":
+		addr, err := btcutil.DecodeAddress(
+			schedule.Dest, m.cfg.ChainParams,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return addr, true, nil
+
+	case schedule.Account != "":
+		addr, err := m.cfg.WalletKit.NextAddr(
+			ctx, schedule.Account, schedule.AccountAddrType, false,
+		)
+		if err != nil {
+			return nil, false, fmt.Errorf("NextAddr from account "+
+				"error: %w", err)
+		}
+
+		return addr, true, nil
+
+	default:
+		addr, err := m.cfg.WalletKit.NextAddr(
+			ctx, "", walletrpc.AddressType_WITNESS_PUBKEY_HASH,
+			false,
+		)
+		if err != nil {
+			return nil, false, fmt.Errorf("NextAddr error: %w", err)
+		}
+
+		return addr, false, nil
+	}
+}
@@ -0,0 +1,567 @@
+package recurring
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testTime = time.Unix(1700000000, 0).UTC()
+
+	testHash = lntypes.Hash{1}
+
+	// testOutQuote and testInQuote are the quotes that our swaps are
+	// dispatched with unless a test sets its own.
+	testOutQuote = &loop.LoopOutQuote{
+		SwapFee:      100,
+		PrepayAmount: 50,
+		MinerFee:     200,
+	}
+
+	testInQuote = &loop.LoopInQuote{
+		SwapFee:  100,
+		MinerFee: 200,
+	}
+)
+
+// testDest returns an address on the test network.
+func testDest(t *testing.T) string {
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(
+		make([]byte, 20), &chaincfg.TestNet3Params,
+	)
+	require.NoError(t, err)
+
+	return addr.EncodeAddress()
+}
+
+// testLoopOut returns a weekly loop out schedule with limits that allow our
+// test quote.
+func testLoopOut() *Schedule {
+	return &Schedule{
+		Name:                "weekly",
+		Type:                swap.TypeOut,
+		Amount:              500000,
+		MaxSwapFee:          100,
+		MaxMinerFee:         200,
+		MaxPrepayAmount:     50,
+		MaxSwapRoutingFee:   1000,
+		MaxPrepayRoutingFee: 1000,
+		Interval:            7 * 24 * time.Hour,
+	}
+}
+
+// testLoopIn returns a daily loop in schedule with limits that allow our
+// test quote.
+func testLoopIn() *Schedule {
+	return &Schedule{
+		Name:        "daily",
+		Type:        swap.TypeIn,
+		Amount:      100000,
+		MaxSwapFee:  100,
+		MaxMinerFee: 200,
+		Cron:        "0 12 * * *",
+	}
+}
+
+// scheduleHarness runs schedules against a test clock and keeps the swaps
+// that they dispatch, so that tests can check which runs fired.
+type scheduleHarness struct {
+	manager *Manager
+	lnd     *test.LndMockServices
+	clock   *clock.TestClock
+
+	// outQuote and inQuote are the quotes that are returned to the
+	// manager.
+	outQuote *loop.LoopOutQuote
+	inQuote  *loop.LoopInQuote
+
+	// loopOuts and loopIns hold the swaps that were dispatched.
+	loopOuts []*loop.OutRequest
+	loopIns  []*loop.LoopInRequest
+}
+
+// newScheduleHarness returns a harness that quotes both swap types within the
+// limits of our test schedules. Its schedules are kept in a test database that
+// is closed along with the test.
+func newScheduleHarness(t *testing.T) *scheduleHarness {
+	testDb := loopdb.NewTestDB(t)
+	t.Cleanup(func() {
+		testDb.Close()
+	})
+
+	c := &scheduleHarness{
+		lnd:      test.NewMockLnd(),
+		clock:    clock.NewTestClock(testTime),
+		outQuote: testOutQuote,
+		inQuote:  testInQuote,
+	}
+
+	c.manager = NewManager(&Config{
+		Store:       NewSQLStore(loopdb.NewTypedStore[Querier](testDb)),
+		WalletKit:   c.lnd.WalletKit,
+		ChainParams: c.lnd.ChainParams,
+		Clock:       c.clock,
+		LoopOutQuote: func(_ context.Context,
+			_ *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote,
+			error) {
+
+			return c.outQuote, nil
+		},
+		LoopInQuote: func(_ context.Context,
+			_ *loop.LoopInQuoteRequest) (*loop.LoopInQuote,
+			error) {
+
+			return c.inQuote, nil
+		},
+		LoopOut: func(_ context.Context,
+			req *loop.OutRequest) (*loop.LoopOutSwapInfo, error) {
+
+			c.loopOuts = append(c.loopOuts, req)
+
+			return &loop.LoopOutSwapInfo{
+				SwapHash: testHash,
+			}, nil
+		},
+		LoopIn: func(_ context.Context,
+			req *loop.LoopInRequest) (*loop.LoopInSwapInfo, error) {
+
+			c.loopIns = append(c.loopIns, req)
+
+			return &loop.LoopInSwapInfo{
+				SwapHash: testHash,
+			}, nil
+		},
+	})
+
+	return c
+}
+
+// getSchedule returns the persisted schedule with the name provided.
+func (c *scheduleHarness) getSchedule(t *testing.T, name string) *Schedule {
+	schedule, err := c.manager.GetSchedule(context.Background(), name)
+	require.NoError(t, err)
+
+	return schedule
+}
+
+// TestCreateSchedule tests validation of the schedules that we create.
+func TestCreateSchedule(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Schedule)
+		err    error
+	}{
+		{
+			name: "no name",
+			modify: func(s *Schedule) {
+				s.Name = ""
+			},
+			err: ErrNoName,
+		},
+		{
+			name: "no amount",
+			modify: func(s *Schedule) {
+				s.Amount = 0
+			},
+			err: ErrNoAmount,
+		},
+		{
+			name: "interval and cron",
+			modify: func(s *Schedule) {
+				s.Cron = "0 0 * * 0"
+			},
+			err: ErrNoRecurrence,
+		},
+		{
+			name: "no recurrence",
+			modify: func(s *Schedule) {
+				s.Interval = 0
+			},
+			err: ErrNoRecurrence,
+		},
+		{
+			name: "short interval",
+			modify: func(s *Schedule) {
+				s.Interval = time.Minute
+			},
+			err: ErrIntervalTooShort,
+		},
+		{
+			name: "unmatched cron",
+			modify: func(s *Schedule) {
+				s.Interval = 0
+				s.Cron = "0 0 30 2 *"
+			},
+			err: errCronNoMatch,
+		},
+		{
+			name: "invalid cron",
+			modify: func(s *Schedule) {
+				s.Interval = 0
+				s.Cron = "0 0 * *"
+			},
+			err: ErrInvalidCron,
+		},
+		{
+			name: "missing limit",
+			modify: func(s *Schedule) {
+				s.MaxPrepayRoutingFee = 0
+			},
+			err: ErrMissingLimits,
+		},
+		{
+			name: "dest and account",
+			modify: func(s *Schedule) {
+				s.Dest = "dest"
+				s.Account = "cold"
+			},
+			err: ErrDestAndAccount,
+		},
+		{
+			name: "account without address type",
+			modify: func(s *Schedule) {
+				s.Account = "cold"
+			},
+			err: ErrAccountAddrType,
+		},
+		{
+			name: "unknown account",
+			modify: func(s *Schedule) {
+				s.Account = "cold"
+				s.AccountAddrType =
+					walletrpc.AddressType_TAPROOT_PUBKEY
+			},
+			err: ErrAccountNotFound,
+		},
+		{
+			name: "loop in with dest",
+			modify: func(s *Schedule) {
+				*s = *testLoopIn()
+				s.Dest = "dest"
+			},
+			err: ErrLoopInDest,
+		},
+		{
+			name: "loop in with prepay",
+			modify: func(s *Schedule) {
+				*s = *testLoopIn()
+				s.MaxPrepayAmount = 100
+			},
+			err: ErrLoopInLimits,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := newScheduleHarness(t)
+
+			schedule := testLoopOut()
+			testCase.modify(schedule)
+
+			_, err := c.manager.CreateSchedule(
+				context.Background(), schedule,
+			)
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+
+	// A destination on another network is rejected.
+	c := newScheduleHarness(t)
+	ctx := context.Background()
+
+	mainnetAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		make([]byte, 20), &chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	schedule := testLoopOut()
+	schedule.Dest = mainnetAddr.EncodeAddress()
+	_, err = c.manager.CreateSchedule(ctx, schedule)
+	require.Error(t, err)
+
+	// A valid schedule starts now, and first runs an interval later.
+	schedule = testLoopOut()
+	schedule.Dest = testDest(t)
+	created, err := c.manager.CreateSchedule(ctx, schedule)
+	require.NoError(t, err)
+	require.Equal(t, StateActive, created.State)
+	require.Equal(t, uint32(DefaultMaxFailures), created.MaxFailures)
+	require.Equal(t, testTime, created.Start)
+	require.Equal(t, testTime.Add(schedule.Interval), created.NextRun)
+	require.Equal(t, created, c.getSchedule(t, schedule.Name))
+
+	_, err = c.manager.CreateSchedule(ctx, testLoopOut())
+	require.ErrorIs(t, err, ErrScheduleExists)
+}
+
+// TestNextRun tests the calculation of the next run of a schedule.
+func TestNextRun(t *testing.T) {
+	start := time.Date(2024, 1, 3, 10, 30, 0, 0, time.UTC)
+
+	interval := &Schedule{
+		Interval: 24 * time.Hour,
+		Start:    start,
+	}
+
+	// Before the start time, the schedule runs at its start time.
+	next, err := interval.nextRun(start.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, start, next)
+
+	// Afterwards, runs are anchored on the start time.
+	next, err = interval.nextRun(start)
+	require.NoError(t, err)
+	require.Equal(t, start.Add(24*time.Hour), next)
+
+	next, err = interval.nextRun(start.Add(50 * time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, start.Add(72*time.Hour), next)
+
+	cron := &Schedule{
+		Cron:  "0 12 * * *",
+		Start: start.Add(24 * time.Hour),
+	}
+
+	// Cron schedules don't run before their start time.
+	next, err = cron.nextRun(start)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 4, 12, 0, 0, 0, time.UTC), next)
+
+	next, err = cron.nextRun(start.Add(48 * time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 5, 12, 0, 0, 0, time.UTC), next)
+}
+
+// TestRunLoopOut tests that a due loop out schedule dispatches a swap with
+// its limits and records the run.
+func TestRunLoopOut(t *testing.T) {
+	ctx := context.Background()
+	c := newScheduleHarness(t)
+
+	schedule := testLoopOut()
+	schedule.Dest = testDest(t)
+	_, err := c.manager.CreateSchedule(ctx, schedule)
+	require.NoError(t, err)
+
+	// Nothing happens before the schedule is due.
+	require.NoError(t, c.manager.runSchedules(ctx))
+	require.Empty(t, c.loopOuts)
+
+	// Once due, the loop out is dispatched with the schedule's limits.
+	now := testTime.Add(schedule.Interval)
+	c.clock.SetTime(now)
+	require.NoError(t, c.manager.runSchedules(ctx))
+	require.Len(t, c.loopOuts, 1)
+
+	req := c.loopOuts[0]
+	require.Equal(t, schedule.Amount, req.Amount)
+	require.Equal(t, schedule.Dest, req.DestAddr.EncodeAddress())
+	require.True(t, req.IsExternalAddr)
+	require.Equal(t, schedule.MaxSwapFee, req.MaxSwapFee)
+	require.Equal(t, schedule.MaxMinerFee, req.MaxMinerFee)
+	require.Equal(t, schedule.MaxPrepayAmount, req.MaxPrepayAmount)
+	require.Equal(t, schedule.MaxSwapRoutingFee, req.MaxSwapRoutingFee)
+	require.Equal(
+		t, schedule.MaxPrepayRoutingFee, req.MaxPrepayRoutingFee,
+	)
+	require.EqualValues(t, loop.DefaultSweepConfTarget, req.SweepConfTarget)
+	require.Equal(t, now.Add(swapPublicationWait),
+		req.SwapPublicationDeadline)
+	require.Equal(t, labels.RecurringLabel(swap.TypeOut), req.Label)
+	require.Equal(t, swapInitiator, req.Initiator)
+
+	// The run is recorded and the schedule moves on to its next run.
+	schedule = c.getSchedule(t, schedule.Name)
+	require.Equal(t, now.Add(schedule.Interval), schedule.NextRun)
+
+	runs, err := c.manager.ListRuns(ctx, schedule.Name, 10)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	require.Equal(t, &testHash, runs[0].SwapHash)
+	require.Empty(t, runs[0].Error)
+
+	// The schedule doesn't run again until it is due.
+	require.NoError(t, c.manager.runSchedules(ctx))
+	require.Len(t, c.loopOuts, 1)
+}
+
+// TestRunLoopIn tests that a due loop in schedule dispatches a swap with its
+// own label.
+func TestRunLoopIn(t *testing.T) {
+	ctx := context.Background()
+	c := newScheduleHarness(t)
+
+	schedule := testLoopIn()
+	schedule.Label = "topup"
+	created, err := c.manager.CreateSchedule(ctx, schedule)
+	require.NoError(t, err)
+
+	c.clock.SetTime(created.NextRun)
+	require.NoError(t, c.manager.runSchedules(ctx))
+	require.Len(t, c.loopIns, 1)
+
+	req := c.loopIns[0]
+	require.Equal(t, schedule.Amount, req.Amount)
+	require.Equal(t, schedule.MaxSwapFee, req.MaxSwapFee)
+	require.Equal(t, schedule.MaxMinerFee, req.MaxMinerFee)
+	require.EqualValues(t, loop.DefaultHtlcConfTarget, req.HtlcConfTarget)
+	require.Equal(t, "topup", req.Label)
+
+	require.Equal(
+		t, created.NextRun.Add(24*time.Hour),
+		c.getSchedule(t, schedule.Name).NextRun,
+	)
+}
+
+// TestRunFailures tests that failed runs are retried and that a schedule is
+// paused after too many consecutive failures.
+func TestRunFailures(t *testing.T) {
+	ctx := context.Background()
+	c := newScheduleHarness(t)
+
+	schedule := testLoopOut()
+	schedule.MaxFailures = 2
+	created, err := c.manager.CreateSchedule(ctx, schedule)
+	require.NoError(t, err)
+
+	// Quote a swap fee above our limit, so that the run fails and is
+	// retried an hour later.
+	c.outQuote = &loop.LoopOutQuote{
+		SwapFee:      101,
+		PrepayAmount: 50,
+		MinerFee:     200,
+	}
+
+	now := created.NextRun
+	c.clock.SetTime(now)
+	require.NoError(t, c.manager.runSchedules(ctx))
+	require.Empty(t, c.loopOuts)
+
+	schedule = c.getSchedule(t, schedule.Name)
+	require.Equal(t, StateActive, schedule.State)
+	require.Equal(t, uint32(1), schedule.ConsecutiveFailures)
+	require.Equal(t, now.Add(retryInterval), schedule.NextRun)
+
+	runs, err := c.manager.ListRuns(ctx, schedule.Name, 10)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	require.Nil(t, runs[0].SwapHash)
+	require.Contains(t, runs[0].Error, loop.ErrSwapFeeTooHigh.Error())
+
+	// Our second failure pauses the schedule.
+	now = schedule.NextRun
+	c.clock.SetTime(now)
+	require.NoError(t, c.manager.runSchedules(ctx))
+
+	schedule = c.getSchedule(t, schedule.Name)
+	require.Equal(t, StatePaused, schedule.State)
+	require.Equal(t, uint32(2), schedule.ConsecutiveFailures)
+
+	// A paused schedule doesn't run, even when fees are acceptable.
+	c.outQuote = testOutQuote
+	c.clock.SetTime(schedule.NextRun)
+	require.NoError(t, c.manager.runSchedules(ctx))
+	require.Empty(t, c.loopOuts)
+
+	// Once resumed, the failures are reset and the schedule runs at its
+	// next regular time.
+	now = c.clock.Now()
+	resumed, err := c.manager.ResumeSchedule(ctx, schedule.Name)
+	require.NoError(t, err)
+	require.Equal(t, StateActive, resumed.State)
+	require.Zero(t, resumed.ConsecutiveFailures)
+
+	next, err := resumed.nextRun(now)
+	require.NoError(t, err)
+	require.Equal(t, next, resumed.NextRun)
+
+	c.clock.SetTime(resumed.NextRun)
+	require.NoError(t, c.manager.runSchedules(ctx))
+	require.Len(t, c.loopOuts, 1)
+
+	// Loop outs that sweep to our wallet don't use an external address.
+	require.False(t, c.loopOuts[0].IsExternalAddr)
+}
+
+// TestPauseResumeDelete tests pausing, resuming and deleting schedules.
+func TestPauseResumeDelete(t *testing.T) {
+	ctx := context.Background()
+	c := newScheduleHarness(t)
+
+	_, err := c.manager.PauseSchedule(ctx, "weekly")
+	require.ErrorIs(t, err, ErrScheduleNotFound)
+
+	created, err := c.manager.CreateSchedule(ctx, testLoopOut())
+	require.NoError(t, err)
+
+	_, err = c.manager.ResumeSchedule(ctx, created.Name)
+	require.ErrorIs(t, err, ErrAlreadyActive)
+
+	paused, err := c.manager.PauseSchedule(ctx, created.Name)
+	require.NoError(t, err)
+	require.Equal(t, StatePaused, paused.State)
+
+	_, err = c.manager.PauseSchedule(ctx, created.Name)
+	require.ErrorIs(t, err, ErrAlreadyPaused)
+
+	c.clock.SetTime(created.NextRun)
+	require.NoError(t, c.manager.runSchedules(ctx))
+	require.Empty(t, c.loopOuts)
+
+	require.NoError(t, c.manager.DeleteSchedule(ctx, created.Name))
+
+	schedules, err := c.manager.ListSchedules(ctx)
+	require.NoError(t, err)
+	require.Empty(t, schedules)
+
+	err = c.manager.DeleteSchedule(ctx, created.Name)
+	require.ErrorIs(t, err, ErrScheduleNotFound)
+}
+
+// TestRun tests that our main loop runs schedules that became due while we
+// were offline when it starts.
+func TestRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := newScheduleHarness(t)
+
+	schedule := testLoopOut()
+	created, err := c.manager.CreateSchedule(ctx, schedule)
+	require.NoError(t, err)
+
+	// Move past the schedule's next run before we start.
+	c.clock.SetTime(created.NextRun.Add(time.Hour))
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- c.manager.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		runs, err := c.manager.ListRuns(ctx, schedule.Name, 1)
+		require.NoError(t, err)
+
+		return len(runs) == 1
+	}, test.Timeout, time.Millisecond*10)
+
+	cancel()
+	require.ErrorIs(t, <-errChan, context.Canceled)
+
+	// The schedule resumes its regular runs.
+	require.Equal(
+		t, created.NextRun.Add(schedule.Interval),
+		c.getSchedule(t, schedule.Name).NextRun,
+	)
+}
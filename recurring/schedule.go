@@ -0,0 +1,326 @@
+package recurring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// DefaultMaxFailures is the default number of consecutive failed runs
+	// after which a schedule is paused.
+	DefaultMaxFailures = 3
+
+	// MinInterval is the minimum interval between the runs of a schedule.
+	MinInterval = time.Hour
+)
+
+var (
+	// ErrNoName is returned when a schedule is created without a name.
+	ErrNoName = errors.New("schedule name required")
+
+	// ErrScheduleExists is returned when a schedule is created with the
+	// name of an existing schedule.
+	ErrScheduleExists = errors.New("schedule already exists")
+
+	// ErrScheduleNotFound is returned when a schedule is not found.
+	ErrScheduleNotFound = errors.New("schedule not found")
+
+	// ErrNoAmount is returned when a schedule is created without an
+	// amount.
+	ErrNoAmount = errors.New("swap amount required")
+
+	// ErrNoRecurrence is returned when a schedule is created with neither
+	// or both of an interval and a cron expression.
+	ErrNoRecurrence = errors.New("exactly one of an interval or a cron " +
+		"expression required")
+
+	// ErrInvalidCron is returned when a schedule is created with a cron
+	// expression that can't be parsed or that never matches.
+	ErrInvalidCron = errors.New("invalid cron expression")
+
+	// ErrIntervalTooShort is returned when a schedule is created with an
+	// interval below our minimum.
+	ErrIntervalTooShort = fmt.Errorf("interval must be at least %v",
+		MinInterval)
+
+	// ErrDestAndAccount is returned when a schedule is created with both
+	// a destination address and an account.
+	ErrDestAndAccount = errors.New("destination address and account are " +
+		"mutually exclusive")
+
+	// ErrLoopInDest is returned when a loop in schedule is created with a
+	// destination address or account.
+	ErrLoopInDest = errors.New("destination address and account can " +
+		"only be set for loop out")
+
+	// ErrLoopInLimits is returned when a loop in schedule is created with
+	// limits that only apply to loop outs.
+	ErrLoopInLimits = errors.New("prepay and routing fee limits can only " +
+		"be set for loop out")
+
+	// ErrMissingLimits is returned when a schedule is created without all
+	// of the fee limits that apply to its swap type.
+	ErrMissingLimits = errors.New("all fee limits of the swap type " +
+		"required")
+
+	// ErrAlreadyActive is returned when an active schedule is resumed.
+	ErrAlreadyActive = errors.New("schedule is already active")
+
+	// ErrAlreadyPaused is returned when a paused schedule is paused.
+	ErrAlreadyPaused = errors.New("schedule is already paused")
+)
+
+// State is an enum which represents the state of a schedule.
+type State uint8
+
+const (
+	// StateActive indicates that a schedule dispatches swaps when it is
+	// due.
+	StateActive State = iota
+
+	// StatePaused indicates that a schedule was paused, either by the
+	// user or because too many of its runs failed in a row.
+	StatePaused
+)
+
+// String returns a string representation of a state.
+func (s State) String() string {
+	switch s {
+	case StateActive:
+		return "active"
+
+	case StatePaused:
+		return "paused"
+
+	default:
+		return "unknown"
+	}
+}
+
+// Schedule is the definition of a swap that is dispatched on a recurring
+// schedule.
+type Schedule struct {
+	// ID is the unique id of the schedule.
+	ID uint64
+
+	// Name is the unique name of the schedule.
+	Name string
+
+	// Type is the type of the swaps that the schedule dispatches.
+	Type swap.Type
+
+	// Amount is the amount of each swap.
+	Amount btcutil.Amount
+
+	// Dest is the address that loop outs are swept to. If neither Dest
+	// nor Account are set, loop outs are swept to our wallet.
+	Dest string
+
+	// Account is the name of the lnd account that loop out addresses are
+	// derived from, such as an account imported from a cold storage xpub.
+	Account string
+
+	// AccountAddrType is the address type of the addresses that are
+	// derived from Account.
+	AccountAddrType walletrpc.AddressType
+
+	// MaxSwapFee is the maximum swap fee that we pay for a swap.
+	MaxSwapFee btcutil.Amount
+
+	// MaxMinerFee is the maximum on-chain fee that we pay for a swap.
+	MaxMinerFee btcutil.Amount
+
+	// MaxPrepayAmount is the maximum prepay amount of a loop out.
+	MaxPrepayAmount btcutil.Amount
+
+	// MaxSwapRoutingFee is the maximum routing fee of the swap payment of
+	// a loop out.
+	MaxSwapRoutingFee btcutil.Amount
+
+	// MaxPrepayRoutingFee is the maximum routing fee of the prepay payment
+	// of a loop out.
+	MaxPrepayRoutingFee btcutil.Amount
+
+	// ConfTarget is the sweep confirmation target of loop outs or the htlc
+	// confirmation target of loop ins. If zero, our default is used.
+	ConfTarget int32
+
+	// Interval is the interval between runs. Only one of Interval and
+	// Cron is set.
+	Interval time.Duration
+
+	// Cron is a five field cron expression that is evaluated in UTC.
+	Cron string
+
+	// Start is the time from which the schedule runs. Interval schedules
+	// run at Start plus a multiple of their interval.
+	Start time.Time
+
+	// Label is the label of the swaps that the schedule dispatches.
+	Label string
+
+	// MaxFailures is the number of consecutive failed runs after which
+	// the schedule is paused.
+	MaxFailures uint32
+
+	// State is the current state of the schedule.
+	State State
+
+	// ConsecutiveFailures is the number of runs that failed since the last
+	// successful run.
+	ConsecutiveFailures uint32
+
+	// NextRun is the time at which the schedule runs next.
+	NextRun time.Time
+
+	// Created is the time at which the schedule was created.
+	Created time.Time
+
+	// Updated is the time at which the schedule was last updated.
+	Updated time.Time
+}
+
+// validate checks that a schedule is well formed. It does not check the
+// schedule's destination, which depends on our chain parameters.
+func (s *Schedule) validate() error {
+	if s.Name == "" {
+		return ErrNoName
+	}
+
+	if s.Type != swap.TypeOut && s.Type != swap.TypeIn {
+		return fmt.Errorf("unsupported swap type: %v", s.Type)
+	}
+
+	if s.Amount <= 0 {
+		return ErrNoAmount
+	}
+
+	if (s.Interval == 0) == (s.Cron == "") {
+		return ErrNoRecurrence
+	}
+
+	if s.Cron != "" {
+		if _, err := parseCron(s.Cron); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidCron, err)
+		}
+	} else if s.Interval < MinInterval {
+		return ErrIntervalTooShort
+	}
+
+	if s.ConfTarget < 0 {
+		return fmt.Errorf("confirmation target must be positive, "+
+			"got: %v", s.ConfTarget)
+	}
+
+	if s.MaxSwapFee <= 0 || s.MaxMinerFee <= 0 {
+		return ErrMissingLimits
+	}
+
+	if s.Type == swap.TypeIn {
+		if s.Dest != "" || s.Account != "" {
+			return ErrLoopInDest
+		}
+
+		if s.MaxPrepayAmount != 0 || s.MaxSwapRoutingFee != 0 ||
+			s.MaxPrepayRoutingFee != 0 {
+
+			return ErrLoopInLimits
+		}
+
+		return nil
+	}
+
+	if s.Dest != "" && s.Account != "" {
+		return ErrDestAndAccount
+	}
+
+	if s.MaxPrepayAmount <= 0 || s.MaxSwapRoutingFee <= 0 ||
+		s.MaxPrepayRoutingFee <= 0 {
+
+		return ErrMissingLimits
+	}
+
+	return nil
+}
+
+// nextRun returns the first time after the time provided at which the
+// schedule runs.
+func (s *Schedule) nextRun(after time.Time) (time.Time, error) {
+	if s.Cron == "" {
+		if after.Before(s.Start) {
+			return s.Start, nil
+		}
+
+		periods := after.Sub(s.Start)/s.Interval + 1
+
+		return s.Start.Add(periods * s.Interval), nil
+	}
+
+	cron, err := parseCron(s.Cron)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Cron schedules don't run before their start time, so we search
+	// from just before it.
+	if after.Before(s.Start) {
+		after = s.Start.Add(-time.Nanosecond)
+	}
+
+	return cron.next(after)
+}
+
+// Run records a single run of a schedule.
+type Run struct {
+	// ID is the unique id of the run.
+	ID uint64
+
+	// ScheduleID is the id of the schedule that the run belongs to.
+	ScheduleID uint64
+
+	// Time is the time of the run.
+	Time time.Time
+
+	// SwapHash is the hash of the swap that the run dispatched, if any.
+	SwapHash *lntypes.Hash
+
+	// Error describes why the run failed, if it did.
+	Error string
+}
+
+// Store is the interface that persists our schedules and their runs.
+type Store interface {
+	// AddSchedule persists a new schedule and returns the id that it was
+	// stored under. ErrScheduleExists is returned if a schedule with the
+	// same name exists.
+	AddSchedule(ctx context.Context, schedule *Schedule) (uint64, error)
+
+	// GetSchedule returns the schedule with the name provided.
+	GetSchedule(ctx context.Context, name string) (*Schedule, error)
+
+	// ListSchedules returns all of our schedules, ordered by the time
+	// that they were created.
+	ListSchedules(ctx context.Context) ([]*Schedule, error)
+
+	// UpdateSchedule persists the state, consecutive failures and next
+	// run of a schedule.
+	UpdateSchedule(ctx context.Context, schedule *Schedule) error
+
+	// DeleteSchedule deletes a schedule along with its runs.
+	DeleteSchedule(ctx context.Context, id uint64) error
+
+	// RecordRun records the run of a schedule and persists the update of
+	// the schedule that resulted from it in a single transaction.
+	RecordRun(ctx context.Context, run *Run, schedule *Schedule) error
+
+	// ListRuns returns the most recent runs of a schedule, newest first.
+	ListRuns(ctx context.Context, scheduleID uint64, limit uint32) ([]*Run,
+		error)
+}
@@ -0,0 +1,313 @@
+package recurring
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// Querier holds the queries for schedules and the history of their runs. Runs
+// reference their schedule, so they are deleted before it is.
+type Querier interface {
+	// InsertSwapSchedule inserts a schedule and returns its id.
+	InsertSwapSchedule(ctx context.Context,
+		arg sqlc.InsertSwapScheduleParams) (int32, error)
+
+	// GetSwapSchedule returns the schedule with the name provided.
+	GetSwapSchedule(ctx context.Context, name string) (sqlc.SwapSchedule,
+		error)
+
+	// ListSwapSchedules returns all schedules.
+	ListSwapSchedules(ctx context.Context) ([]sqlc.SwapSchedule, error)
+
+	// UpdateSwapSchedule updates the state, consecutive failures and next
+	// run of a schedule.
+	UpdateSwapSchedule(ctx context.Context,
+		arg sqlc.UpdateSwapScheduleParams) error
+
+	// DeleteSwapSchedule deletes a schedule.
+	DeleteSwapSchedule(ctx context.Context, id int32) error
+
+	// InsertSwapScheduleRun inserts the run of a schedule.
+	InsertSwapScheduleRun(ctx context.Context,
+		arg sqlc.InsertSwapScheduleRunParams) error
+
+	// ListSwapScheduleRuns returns the most recent runs of a schedule.
+	ListSwapScheduleRuns(ctx context.Context,
+		arg sqlc.ListSwapScheduleRunsParams) ([]sqlc.SwapScheduleRun,
+		error)
+
+	// DeleteSwapScheduleRuns deletes all runs of a schedule.
+	DeleteSwapScheduleRuns(ctx context.Context, scheduleID int32) error
+}
+
+// BaseDB lets a schedule be updated together with the run that updated it.
+type BaseDB interface {
+	Querier
+
+	// ExecTx allows for executing a function in the context of a database
+	// transaction.
+	ExecTx(ctx context.Context, txOptions loopdb.TxOptions,
+		txBody func(Querier) error) error
+}
+
+// SQLStore manages our schedules and their runs in the database.
+type SQLStore struct {
+	baseDb BaseDB
+}
+
+// A compile-time check that SQLStore implements Store.
+var _ Store = (*SQLStore)(nil)
+
+// NewSQLStore returns a schedule store on top of the database provided.
+func NewSQLStore(db BaseDB) *SQLStore {
+	return &SQLStore{
+		baseDb: db,
+	}
+}
+
+// AddSchedule persists a new schedule and returns the id that it was stored
+// under. ErrScheduleExists is returned if a schedule with the same name
+// exists.
+func (s *SQLStore) AddSchedule(ctx context.Context,
+	schedule *Schedule) (uint64, error) {
+
+	args := sqlc.InsertSwapScheduleParams{
+		Name:                schedule.Name,
+		SwapType:            int32(schedule.Type),
+		Amount:              int64(schedule.Amount),
+		Dest:                schedule.Dest,
+		Account:             schedule.Account,
+		AccountAddrType:     int32(schedule.AccountAddrType),
+		MaxSwapFee:          int64(schedule.MaxSwapFee),
+		MaxMinerFee:         int64(schedule.MaxMinerFee),
+		MaxPrepayAmount:     int64(schedule.MaxPrepayAmount),
+		MaxSwapRoutingFee:   int64(schedule.MaxSwapRoutingFee),
+		MaxPrepayRoutingFee: int64(schedule.MaxPrepayRoutingFee),
+		ConfTarget:          schedule.ConfTarget,
+		IntervalSeconds:     int64(schedule.Interval.Seconds()),
+		Cron:                schedule.Cron,
+		StartTime:           schedule.Start.UTC(),
+		Label:               schedule.Label,
+		MaxFailures:         int32(schedule.MaxFailures),
+		State:               int32(schedule.State),
+		ConsecutiveFailures: int32(schedule.ConsecutiveFailures),
+		NextRun:             schedule.NextRun.UTC(),
+		CreatedAt:           schedule.Created.UTC(),
+		UpdatedAt:           schedule.Updated.UTC(),
+	}
+
+	var id int32
+	err := s.baseDb.ExecTx(ctx, loopdb.NewSqlWriteOpts(),
+		func(q Querier) error {
+			_, err := q.GetSwapSchedule(ctx, schedule.Name)
+			switch {
+			case err == nil:
+				return ErrScheduleExists
+
+			case !errors.Is(err, sql.ErrNoRows):
+				return err
+			}
+
+			id, err = q.InsertSwapSchedule(ctx, args)
+
+			return err
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(id), nil
+}
+
+// GetSchedule returns the schedule with the name provided.
+func (s *SQLStore) GetSchedule(ctx context.Context,
+	name string) (*Schedule, error) {
+
+	row, err := s.baseDb.GetSwapSchedule(ctx, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrScheduleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return sqlSchedule(row), nil
+}
+
+// ListSchedules returns all of our schedules, ordered by the time that they
+// were created.
+func (s *SQLStore) ListSchedules(ctx context.Context) ([]*Schedule, error) {
+	rows, err := s.baseDb.ListSwapSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]*Schedule, 0, len(rows))
+	for _, row := range rows {
+		schedules = append(schedules, sqlSchedule(row))
+	}
+
+	return schedules, nil
+}
+
+// UpdateSchedule persists the state, consecutive failures and next run of a
+// schedule.
+func (s *SQLStore) UpdateSchedule(ctx context.Context,
+	schedule *Schedule) error {
+
+	if schedule.ID > math.MaxInt32 {
+		return ErrScheduleNotFound
+	}
+
+	return s.baseDb.UpdateSwapSchedule(ctx, updateParams(schedule))
+}
+
+// DeleteSchedule deletes a schedule along with its runs.
+func (s *SQLStore) DeleteSchedule(ctx context.Context, id uint64) error {
+	if id > math.MaxInt32 {
+		return ErrScheduleNotFound
+	}
+
+	return s.baseDb.ExecTx(ctx, loopdb.NewSqlWriteOpts(),
+		func(q Querier) error {
+			err := q.DeleteSwapScheduleRuns(ctx, int32(id))
+			if err != nil {
+				return err
+			}
+
+			return q.DeleteSwapSchedule(ctx, int32(id))
+		},
+	)
+}
+
+// RecordRun records the run of a schedule and persists the update of the
+// schedule that resulted from it in a single transaction.
+func (s *SQLStore) RecordRun(ctx context.Context, run *Run,
+	schedule *Schedule) error {
+
+	if schedule.ID > math.MaxInt32 {
+		return ErrScheduleNotFound
+	}
+
+	args := sqlc.InsertSwapScheduleRunParams{
+		ScheduleID: int32(schedule.ID),
+		RunTime:    run.Time.UTC(),
+		Error:      run.Error,
+	}
+	if run.SwapHash != nil {
+		args.SwapHash = run.SwapHash[:]
+	}
+
+	return s.baseDb.ExecTx(ctx, loopdb.NewSqlWriteOpts(),
+		func(q Querier) error {
+			err := q.InsertSwapScheduleRun(ctx, args)
+			if err != nil {
+				return err
+			}
+
+			return q.UpdateSwapSchedule(
+				ctx, updateParams(schedule),
+			)
+		},
+	)
+}
+
+// ListRuns returns the most recent runs of a schedule, newest first.
+func (s *SQLStore) ListRuns(ctx context.Context, scheduleID uint64,
+	limit uint32) ([]*Run, error) {
+
+	if scheduleID > math.MaxInt32 {
+		return nil, ErrScheduleNotFound
+	}
+
+	if limit > math.MaxInt32 {
+		limit = math.MaxInt32
+	}
+
+	rows, err := s.baseDb.ListSwapScheduleRuns(
+		ctx, sqlc.ListSwapScheduleRunsParams{
+			ScheduleID: int32(scheduleID),
+			Limit:      int32(limit),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*Run, 0, len(rows))
+	for _, row := range rows {
+		run := &Run{
+			ID:         uint64(row.ID),
+			ScheduleID: uint64(row.ScheduleID),
+			Time:       row.RunTime,
+			Error:      row.Error,
+		}
+
+		if len(row.SwapHash) != 0 {
+			hash, err := lntypes.MakeHash(row.SwapHash)
+			if err != nil {
+				return nil, err
+			}
+
+			run.SwapHash = &hash
+		}
+
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
+
+// updateParams returns the parameters that persist the mutable fields of a
+// schedule.
+func updateParams(schedule *Schedule) sqlc.UpdateSwapScheduleParams {
+	return sqlc.UpdateSwapScheduleParams{
+		ID:                  int32(schedule.ID),
+		State:               int32(schedule.State),
+		ConsecutiveFailures: int32(schedule.ConsecutiveFailures),
+		NextRun:             schedule.NextRun.UTC(),
+		UpdatedAt:           schedule.Updated.UTC(),
+	}
+}
+
+// sqlSchedule converts a schedule from the database.
+func sqlSchedule(row sqlc.SwapSchedule) *Schedule {
+	interval := time.Duration(row.IntervalSeconds) * time.Second
+
+	return &Schedule{
+		ID:                  uint64(row.ID),
+		Name:                row.Name,
+		Type:                swap.Type(row.SwapType),
+		Amount:              btcutil.Amount(row.Amount),
+		Dest:                row.Dest,
+		Account:             row.Account,
+		AccountAddrType:     walletrpc.AddressType(row.AccountAddrType),
+		MaxSwapFee:          btcutil.Amount(row.MaxSwapFee),
+		MaxMinerFee:         btcutil.Amount(row.MaxMinerFee),
+		MaxPrepayAmount:     btcutil.Amount(row.MaxPrepayAmount),
+		MaxSwapRoutingFee:   btcutil.Amount(row.MaxSwapRoutingFee),
+		MaxPrepayRoutingFee: btcutil.Amount(row.MaxPrepayRoutingFee),
+		ConfTarget:          row.ConfTarget,
+		Interval:            interval,
+		Cron:                row.Cron,
+		Start:               row.StartTime,
+		Label:               row.Label,
+		MaxFailures:         uint32(row.MaxFailures),
+		State:               State(row.State),
+		ConsecutiveFailures: uint32(row.ConsecutiveFailures),
+		NextRun:             row.NextRun,
+		Created:             row.CreatedAt,
+		Updated:             row.UpdatedAt,
+	}
+}
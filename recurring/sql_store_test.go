@@ -0,0 +1,144 @@
+package recurring
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSQLStore tests persisting schedules and their runs.
+func TestSQLStore(t *testing.T) {
+	ctx := context.Background()
+	testDb := loopdb.NewTestDB(t)
+	defer testDb.Close()
+
+	store := NewSQLStore(loopdb.NewTypedStore[Querier](testDb))
+
+	_, err := store.GetSchedule(ctx, "weekly")
+	require.ErrorIs(t, err, ErrScheduleNotFound)
+
+	created := time.Unix(1000, 0).UTC()
+	weekly := &Schedule{
+		Name:                "weekly",
+		Type:                swap.TypeOut,
+		Amount:              500000,
+		Account:             "cold",
+		AccountAddrType:     walletrpc.AddressType_TAPROOT_PUBKEY,
+		MaxSwapFee:          1000,
+		MaxMinerFee:         2000,
+		MaxPrepayAmount:     3000,
+		MaxSwapRoutingFee:   4000,
+		MaxPrepayRoutingFee: 5000,
+		ConfTarget:          20,
+		Interval:            7 * 24 * time.Hour,
+		Start:               created,
+		Label:               "cold storage",
+		MaxFailures:         3,
+		State:               StateActive,
+		NextRun:             created.Add(7 * 24 * time.Hour),
+		Created:             created,
+		Updated:             created,
+	}
+
+	weekly.ID, err = store.AddSchedule(ctx, weekly)
+	require.NoError(t, err)
+
+	// Names are unique.
+	_, err = store.AddSchedule(ctx, weekly)
+	require.ErrorIs(t, err, ErrScheduleExists)
+
+	daily := &Schedule{
+		Name:        "daily",
+		Type:        swap.TypeIn,
+		Amount:      100000,
+		MaxSwapFee:  100,
+		MaxMinerFee: 200,
+		Cron:        "0 12 * * *",
+		Start:       created,
+		MaxFailures: 1,
+		State:       StateActive,
+		NextRun:     created.Add(time.Hour),
+		Created:     created,
+		Updated:     created,
+	}
+
+	daily.ID, err = store.AddSchedule(ctx, daily)
+	require.NoError(t, err)
+
+	schedule, err := store.GetSchedule(ctx, "weekly")
+	require.NoError(t, err)
+	require.Equal(t, weekly, schedule)
+
+	schedules, err := store.ListSchedules(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*Schedule{weekly, daily}, schedules)
+
+	// Record a successful and a failed run of our weekly schedule, along
+	// with the updates of the schedule.
+	hash := lntypes.Hash{1, 2, 3}
+	success := &Run{
+		ScheduleID: weekly.ID,
+		Time:       weekly.NextRun,
+		SwapHash:   &hash,
+	}
+
+	weekly.NextRun = weekly.NextRun.Add(7 * 24 * time.Hour)
+	weekly.Updated = success.Time
+	require.NoError(t, store.RecordRun(ctx, success, weekly))
+
+	failure := &Run{
+		ScheduleID: weekly.ID,
+		Time:       weekly.NextRun,
+		Error:      "swap fee too high",
+	}
+
+	weekly.ConsecutiveFailures = 1
+	weekly.NextRun = weekly.NextRun.Add(time.Hour)
+	weekly.Updated = failure.Time
+	require.NoError(t, store.RecordRun(ctx, failure, weekly))
+
+	schedule, err = store.GetSchedule(ctx, "weekly")
+	require.NoError(t, err)
+	require.Equal(t, weekly, schedule)
+
+	runs, err := store.ListRuns(ctx, weekly.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+
+	// Runs are returned newest first.
+	failure.ID, success.ID = runs[0].ID, runs[1].ID
+	require.Equal(t, []*Run{failure, success}, runs)
+
+	runs, err = store.ListRuns(ctx, weekly.ID, 1)
+	require.NoError(t, err)
+	require.Equal(t, []*Run{failure}, runs)
+
+	// Pause our daily schedule.
+	daily.State = StatePaused
+	daily.Updated = created.Add(time.Minute)
+	require.NoError(t, store.UpdateSchedule(ctx, daily))
+
+	schedule, err = store.GetSchedule(ctx, "daily")
+	require.NoError(t, err)
+	require.Equal(t, daily, schedule)
+
+	// Deleting a schedule deletes its runs.
+	require.NoError(t, store.DeleteSchedule(ctx, weekly.ID))
+
+	_, err = store.GetSchedule(ctx, "weekly")
+	require.ErrorIs(t, err, ErrScheduleNotFound)
+
+	runs, err = store.ListRuns(ctx, weekly.ID, 10)
+	require.NoError(t, err)
+	require.Empty(t, runs)
+
+	schedules, err = store.ListSchedules(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*Schedule{daily}, schedules)
+}
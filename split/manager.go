@@ -0,0 +1,438 @@
+package split
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// pollInterval is the interval at which we check whether the next parts of
+// our groups can be dispatched.
+const pollInterval = time.Minute
+
+// errPreviousPartFailed is the error that is recorded for the parts of a
+// sequential group that are skipped.
+var errPreviousPartFailed = errors.New("previous part failed")
+
+// Config contains the external functionality required by the manager.
+type Config struct {
+	// Store persists our split loop outs.
+	Store Store
+
+	// Lightning is used to look up the local balances of our channels.
+	Lightning lndclient.LightningClient
+
+	// Clock allows easy mocking of time in unit tests.
+	Clock clock.Clock
+
+	// LoopOutTerms returns the server's loop out terms, which limit the
+	// amount of each part.
+	LoopOutTerms func(ctx context.Context,
+		req *looprpc.TermsRequest) (*looprpc.OutTermsResponse, error)
+
+	// LoopOut dispatches the loop out of a single part. It validates the
+	// request in the same way as requests that are not split.
+	LoopOut func(ctx context.Context,
+		req *looprpc.LoopOutRequest) (*looprpc.SwapResponse, error)
+
+	// SwapState returns the current state of a swap, and false if the
+	// swap is not known.
+	SwapState func(hash lntypes.Hash) (loopdb.SwapState, bool)
+}
+
+// Manager splits loop outs into parts, dispatches the parts and tracks them
+// under their group.
+type Manager struct {
+	cfg *Config
+
+	// mu serializes the dispatch of parts, so that a part can't be
+	// dispatched twice.
+	mu sync.Mutex
+
+	// swapGroups maps the swap hashes of our dispatched parts to the ids
+	// of their groups. It is loaded from our store on first use.
+	swapGroups map[lntypes.Hash]uint64
+
+	// swapGroupsMu protects swapGroups.
+	swapGroupsMu sync.Mutex
+}
+
+// NewManager creates a new split loop out manager.
+func NewManager(cfg *Config) *Manager {
+	return &Manager{
+		cfg: cfg,
+	}
+}
+
+// Run dispatches the parts of our groups that are waiting for the swap of
+// their previous part at a regular interval. Since groups are loaded from our
+// store, parts that were not dispatched before a restart are picked up again.
+func (m *Manager) Run(ctx context.Context) error {
+	for {
+		if err := m.dispatchParts(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			log.Errorf("could not dispatch split loop out parts: %v",
+				err)
+		}
+
+		select {
+		case <-m.cfg.Clock.TickAfter(pollInterval):
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// LoopOut splits a loop out request into parts, persists them as a group and
+// dispatches the parts that can be dispatched right away. It returns the
+// group along with the response of the first part that was dispatched. If no
+// part could be dispatched, the error of the first part is returned.
+func (m *Manager) LoopOut(ctx context.Context,
+	req *looprpc.LoopOutRequest) (*Group, *looprpc.SwapResponse, error) {
+
+	if err := validateRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	terms, err := m.cfg.LoopOutTerms(ctx, &looprpc.TermsRequest{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	maxAmount := btcutil.Amount(terms.MaxSwapAmount)
+	if req.Split.MaxPartAmt > 0 {
+		maxAmount = min(maxAmount, btcutil.Amount(req.Split.MaxPartAmt))
+	}
+
+	amounts, err := splitAmount(
+		btcutil.Amount(req.Amt), btcutil.Amount(terms.MinSwapAmount),
+		maxAmount,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := &Group{
+		Created:    m.cfg.Clock.Now(),
+		Amount:     btcutil.Amount(req.Amt),
+		Sequential: req.Split.Sequential,
+		Request:    req,
+		Parts:      make([]*Part, len(amounts)),
+	}
+	for i, amount := range amounts {
+		group.Parts[i] = &Part{
+			Index:  uint32(i),
+			Amount: amount,
+		}
+	}
+
+	// The parts of a sequential group are paid one after another, so
+	// their channels are chosen when they are dispatched. We only choose
+	// the channels of the first part now.
+	dispatchNow := group.Parts
+	if group.Sequential {
+		dispatchNow = group.Parts[:1]
+	}
+
+	chanSets, err := m.selectChannels(ctx, group, dispatchNow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	group.ID, err = m.cfg.Store.AddGroup(ctx, group)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Infof("Split loop out of %v sats into group %v of %v parts "+
+		"(sequential: %v)", group.Amount, group.ID, len(group.Parts),
+		group.Sequential)
+
+	var resp *looprpc.SwapResponse
+	for i, part := range dispatchNow {
+		partResp, err := m.dispatchPart(ctx, group, part, chanSets[i])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if resp == nil {
+			resp = partResp
+		}
+	}
+
+	if resp == nil {
+		return nil, nil, fmt.Errorf("no part of split loop out %v "+
+			"could be dispatched: %v", group.ID,
+			group.Parts[0].Error)
+	}
+
+	return group, resp, nil
+}
+
+// ListGroups returns all of our groups along with their parts.
+func (m *Manager) ListGroups(ctx context.Context) ([]*Group, error) {
+	return m.cfg.Store.ListGroups(ctx)
+}
+
+// GroupID returns the id of the group that the swap provided is a part of,
+// and false if it is not part of a group.
+func (m *Manager) GroupID(ctx context.Context, hash lntypes.Hash) (uint64,
+	bool, error) {
+
+	m.swapGroupsMu.Lock()
+	defer m.swapGroupsMu.Unlock()
+
+	if m.swapGroups == nil {
+		groups, err := m.cfg.Store.ListGroups(ctx)
+		if err != nil {
+			return 0, false, err
+		}
+
+		m.swapGroups = make(map[lntypes.Hash]uint64)
+		for _, group := range groups {
+			for _, part := range group.Parts {
+				if part.SwapHash != nil {
+					m.swapGroups[*part.SwapHash] = group.ID
+				}
+			}
+		}
+	}
+
+	id, ok := m.swapGroups[hash]
+
+	return id, ok, nil
+}
+
+// validateRequest checks that a loop out request can be split.
+func validateRequest(req *looprpc.LoopOutRequest) error {
+	if req.Split == nil {
+		return ErrNotSplit
+	}
+
+	if req.AssetInfo != nil || req.AssetRfqInfo != nil {
+		return ErrAssetSplit
+	}
+
+	if len(req.ReservationIds) > 0 {
+		return ErrReservationSplit
+	}
+
+	if req.Split.MaxPartAmt < 0 {
+		return fmt.Errorf("maximum part amount must be positive, "+
+			"got: %v", req.Split.MaxPartAmt)
+	}
+
+	//nolint:staticcheck
+	if req.LoopOutChannel != 0 && len(req.OutgoingChanSet) > 0 {
+		return errors.New("loop_out_channel and outgoing_chan_ids " +
+			"are mutually exclusive")
+	}
+
+	return nil
+}
+
+// selectChannels chooses the outgoing channel sets of the parts provided
+// from the current local balances of the channels that the group's request
+// allows. Each part's set must cover its amount along with its maximum
+// routing fee.
+func (m *Manager) selectChannels(ctx context.Context, group *Group,
+	parts []*Part) ([]loopdb.ChannelSet, error) {
+
+	channels, err := m.cfg.Lightning.ListChannels(ctx, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := loopdb.ChannelSet(group.Request.OutgoingChanSet)
+	if group.Request.LoopOutChannel != 0 { //nolint:staticcheck
+		allowed = loopdb.ChannelSet{
+			group.Request.LoopOutChannel, //nolint:staticcheck
+		}
+	}
+
+	amounts := make([]btcutil.Amount, len(parts))
+	for i, part := range parts {
+		req := partRequest(group, part, nil, group.Created)
+		amounts[i] = part.Amount +
+			btcutil.Amount(req.MaxSwapRoutingFee)
+	}
+
+	return selectChannels(spendableBalances(channels, allowed), amounts)
+}
+
+// dispatchPart dispatches the swap of a part over the channel set provided
+// and persists the outcome. If the swap could not be dispatched, the part is
+// marked as failed and a nil response is returned. An error is only returned
+// if we could not persist the outcome.
+//
+// NOTE: m.mu must be held when calling this method.
+func (m *Manager) dispatchPart(ctx context.Context, group *Group, part *Part,
+	chanSet loopdb.ChannelSet) (*looprpc.SwapResponse, error) {
+
+	req := partRequest(group, part, chanSet, m.cfg.Clock.Now())
+
+	log.Infof("Dispatching part %v of split loop out %v: %v sats over "+
+		"channels %v", part.Index, group.ID, part.Amount, chanSet)
+
+	resp, err := m.cfg.LoopOut(ctx, req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		log.Warnf("Part %v of split loop out %v failed: %v",
+			part.Index, group.ID, err)
+
+		part.State = PartFailed
+		part.Error = err.Error()
+
+		return nil, m.cfg.Store.UpdatePart(ctx, group.ID, part)
+	}
+
+	hash, err := lntypes.MakeHash(resp.IdBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	part.State = PartDispatched
+	part.SwapHash = &hash
+
+	if err := m.cfg.Store.UpdatePart(ctx, group.ID, part); err != nil {
+		return nil, err
+	}
+
+	m.swapGroupsMu.Lock()
+	if m.swapGroups != nil {
+		m.swapGroups[hash] = group.ID
+	}
+	m.swapGroupsMu.Unlock()
+
+	log.Infof("Part %v of split loop out %v dispatched as swap %v",
+		part.Index, group.ID, hash)
+
+	return resp, nil
+}
+
+// dispatchParts dispatches the pending parts of our groups that can be
+// dispatched, and skips the remaining parts of sequential groups whose
+// previous part failed.
+func (m *Manager) dispatchParts(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	groups, err := m.cfg.Store.ListGroups(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		for i, part := range group.Parts {
+			if part.State != PartPending {
+				continue
+			}
+
+			var previous *Part
+			if i > 0 {
+				previous = group.Parts[i-1]
+			}
+
+			err := m.advancePart(ctx, group, part, previous)
+			if err != nil {
+				return err
+			}
+
+			// The parts of a sequential group are advanced one at
+			// a time, so that each part waits for the previous
+			// one.
+			if group.Sequential {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// advancePart dispatches a pending part if it can be dispatched. The parts of
+// concurrent groups are only pending here if we were interrupted while
+// dispatching them, so they can always be dispatched. The parts of sequential
+// groups wait for the swap of their previous part to succeed, and are skipped
+// if it failed.
+func (m *Manager) advancePart(ctx context.Context, group *Group, part,
+	previous *Part) error {
+
+	if group.Sequential && previous != nil {
+		switch previous.State {
+		case PartDispatched:
+			state, ok := m.cfg.SwapState(*previous.SwapHash)
+			if !ok || state.IsPending() {
+				return nil
+			}
+
+			if state != loopdb.StateSuccess {
+				return m.skipParts(ctx, group, part.Index)
+			}
+
+		case PartFailed, PartSkipped:
+			return m.skipParts(ctx, group, part.Index)
+
+		default:
+			return nil
+		}
+	}
+
+	chanSets, err := m.selectChannels(ctx, group, []*Part{part})
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		log.Warnf("Could not choose channels for part %v of split "+
+			"loop out %v: %v", part.Index, group.ID, err)
+
+		part.State = PartFailed
+		part.Error = err.Error()
+
+		return m.cfg.Store.UpdatePart(ctx, group.ID, part)
+	}
+
+	_, err = m.dispatchPart(ctx, group, part, chanSets[0])
+
+	return err
+}
+
+// skipParts marks the parts of a group from the index provided onwards as
+// skipped.
+func (m *Manager) skipParts(ctx context.Context, group *Group,
+	from uint32) error {
+
+	log.Infof("Skipping the remaining parts of split loop out %v from "+
+		"part %v: %v", group.ID, from, errPreviousPartFailed)
+
+	for _, part := range group.Parts[from:] {
+		part.State = PartSkipped
+		part.Error = errPreviousPartFailed.Error()
+
+		if err := m.cfg.Store.UpdatePart(ctx, group.ID, part); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,347 @@
+package split
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+var testTime = time.Unix(1700000000, 0).UTC()
+
+// splitHarness splits loop outs over mock channels and tracks the parts that
+// it dispatches, along with the swap states that the parts are resolved by.
+type splitHarness struct {
+	manager *Manager
+	lnd     *test.LndMockServices
+
+	// dispatched holds the loop out requests that were dispatched, and
+	// dispatchErr is the error that dispatching returns if set.
+	dispatched  []*looprpc.LoopOutRequest
+	dispatchErr error
+
+	// swapStates holds the states of the swaps that we know of.
+	swapStates map[lntypes.Hash]loopdb.SwapState
+}
+
+// newSplitHarness returns a harness with an active channel for each of the
+// local balances provided, numbered from 1. The server's terms allow swaps of
+// 100 to 1000 sats.
+func newSplitHarness(t *testing.T, balances ...btcutil.Amount) *splitHarness {
+	testDb := loopdb.NewTestDB(t)
+	t.Cleanup(func() {
+		testDb.Close()
+	})
+
+	c := &splitHarness{
+		lnd:        test.NewMockLnd(),
+		swapStates: make(map[lntypes.Hash]loopdb.SwapState),
+	}
+
+	for i, balance := range balances {
+		c.lnd.Channels = append(c.lnd.Channels, lndclient.ChannelInfo{
+			ChannelID:    uint64(i + 1),
+			Active:       true,
+			LocalBalance: balance,
+		})
+	}
+
+	c.manager = NewManager(&Config{
+		Store:     NewSQLStore(loopdb.NewTypedStore[Querier](testDb)),
+		Lightning: c.lnd.Client,
+		Clock:     clock.NewTestClock(testTime),
+		LoopOutTerms: func(_ context.Context,
+			_ *looprpc.TermsRequest) (*looprpc.OutTermsResponse,
+			error) {
+
+			return &looprpc.OutTermsResponse{
+				MinSwapAmount: 100,
+				MaxSwapAmount: 1000,
+			}, nil
+		},
+		LoopOut: func(_ context.Context,
+			req *looprpc.LoopOutRequest) (*looprpc.SwapResponse,
+			error) {
+
+			if c.dispatchErr != nil {
+				return nil, c.dispatchErr
+			}
+
+			c.dispatched = append(c.dispatched, req)
+			hash := lntypes.Hash{byte(len(c.dispatched))}
+
+			return &looprpc.SwapResponse{
+				IdBytes: hash[:],
+			}, nil
+		},
+		SwapState: func(hash lntypes.Hash) (loopdb.SwapState, bool) {
+			state, ok := c.swapStates[hash]
+			return state, ok
+		},
+	})
+
+	return c
+}
+
+// group returns the group with the id provided from our store.
+func (c *splitHarness) group(t *testing.T, id uint64) *Group {
+	groups, err := c.manager.ListGroups(context.Background())
+	require.NoError(t, err)
+
+	for _, group := range groups {
+		if group.ID == id {
+			return group
+		}
+	}
+
+	require.FailNow(t, "group not found")
+
+	return nil
+}
+
+// requirePartStates asserts that the parts of a group are in the states
+// provided.
+func (c *splitHarness) requirePartStates(t *testing.T, id uint64,
+	states ...PartState) {
+
+	group := c.group(t, id)
+	require.Len(t, group.Parts, len(states))
+
+	for i, state := range states {
+		require.Equal(t, state, group.Parts[i].State, "part %v", i)
+	}
+}
+
+// TestLoopOutValidation tests validation of the loop outs that we split.
+func TestLoopOutValidation(t *testing.T) {
+	ctx := context.Background()
+	c := newSplitHarness(t, 1000, 1000, 1000)
+
+	split := &looprpc.LoopOutSplit{}
+
+	tests := []struct {
+		name string
+		req  *looprpc.LoopOutRequest
+		err  error
+	}{
+		{
+			name: "not split",
+			req:  &looprpc.LoopOutRequest{Amt: 500},
+			err:  ErrNotSplit,
+		},
+		{
+			name: "asset loop out",
+			req: &looprpc.LoopOutRequest{
+				Amt:       500,
+				Split:     split,
+				AssetInfo: &looprpc.AssetLoopOutRequest{},
+			},
+			err: ErrAssetSplit,
+		},
+		{
+			name: "reservations",
+			req: &looprpc.LoopOutRequest{
+				Amt:            500,
+				Split:          split,
+				ReservationIds: [][]byte{{1}},
+			},
+			err: ErrReservationSplit,
+		},
+		{
+			name: "below minimum",
+			req: &looprpc.LoopOutRequest{
+				Amt:   50,
+				Split: split,
+			},
+			err: ErrAmountTooLow,
+		},
+		{
+			name: "part below minimum",
+			req: &looprpc.LoopOutRequest{
+				Amt: 500,
+				Split: &looprpc.LoopOutSplit{
+					MaxPartAmt: 50,
+				},
+			},
+			err: ErrPartAmountTooLow,
+		},
+		{
+			name: "insufficient balance",
+			req: &looprpc.LoopOutRequest{
+				Amt:   3500,
+				Split: split,
+			},
+			err: ErrInsufficientBalance,
+		},
+		{
+			name: "insufficient balance in allowed channels",
+			req: &looprpc.LoopOutRequest{
+				Amt:             1500,
+				Split:           split,
+				OutgoingChanSet: []uint64{1},
+			},
+			err: ErrInsufficientBalance,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, _, err := c.manager.LoopOut(ctx, testCase.req)
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+
+	// None of the invalid loop outs were persisted or dispatched.
+	groups, err := c.manager.ListGroups(ctx)
+	require.NoError(t, err)
+	require.Empty(t, groups)
+	require.Empty(t, c.dispatched)
+}
+
+// TestConcurrentLoopOut tests splitting a loop out into parts that are all
+// dispatched at once.
+func TestConcurrentLoopOut(t *testing.T) {
+	ctx := context.Background()
+	c := newSplitHarness(t, 1000, 1000, 1000)
+
+	group, resp, err := c.manager.LoopOut(ctx, &looprpc.LoopOutRequest{
+		Amt:               2400,
+		MaxSwapFee:        240,
+		MaxSwapRoutingFee: 60,
+		Split:             &looprpc.LoopOutSplit{},
+	})
+	require.NoError(t, err)
+	require.Len(t, group.Parts, 3)
+
+	// The response is that of the first part.
+	require.Equal(t, lntypes.Hash{1}, lntypes.Hash(resp.IdBytes))
+
+	// Each part is paid over a channel of its own, along with its share
+	// of the fee limits.
+	require.Len(t, c.dispatched, 3)
+	for i, req := range c.dispatched {
+		require.EqualValues(t, 800, req.Amt)
+		require.EqualValues(t, 80, req.MaxSwapFee)
+		require.EqualValues(t, 20, req.MaxSwapRoutingFee)
+		require.Len(t, req.OutgoingChanSet, 1, "part %v", i)
+		require.Nil(t, req.Split)
+	}
+
+	require.NotEqual(t, c.dispatched[0].OutgoingChanSet,
+		c.dispatched[1].OutgoingChanSet)
+	require.NotEqual(t, c.dispatched[1].OutgoingChanSet,
+		c.dispatched[2].OutgoingChanSet)
+
+	c.requirePartStates(
+		t, group.ID, PartDispatched, PartDispatched, PartDispatched,
+	)
+
+	// Our swaps are tracked under their group.
+	for i := 1; i <= 3; i++ {
+		id, ok, err := c.manager.GroupID(ctx, lntypes.Hash{byte(i)})
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, group.ID, id)
+	}
+
+	_, ok, err := c.manager.GroupID(ctx, lntypes.Hash{9})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Once all swaps succeeded, so did the group.
+	c.swapStates[lntypes.Hash{1}] = loopdb.StateSuccess
+	c.swapStates[lntypes.Hash{2}] = loopdb.StateSuccess
+	c.swapStates[lntypes.Hash{3}] = loopdb.StateFailTimeout
+
+	state, swapped := c.group(t, group.ID).Status(c.manager.cfg.SwapState)
+	require.Equal(t, GroupPartiallySucceeded, state)
+	require.EqualValues(t, 1600, swapped)
+}
+
+// TestLoopOutDispatchFailure tests that a loop out fails if none of its parts
+// could be dispatched, and that the failed parts are recorded.
+func TestLoopOutDispatchFailure(t *testing.T) {
+	ctx := context.Background()
+	c := newSplitHarness(t, 1000, 1000, 1000)
+
+	c.dispatchErr = errors.New("no route")
+
+	_, _, err := c.manager.LoopOut(ctx, &looprpc.LoopOutRequest{
+		Amt:   1500,
+		Split: &looprpc.LoopOutSplit{},
+	})
+	require.ErrorContains(t, err, "no route")
+
+	groups, err := c.manager.ListGroups(ctx)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+
+	c.requirePartStates(t, groups[0].ID, PartFailed, PartFailed)
+	require.Equal(t, "no route", groups[0].Parts[0].Error)
+
+	// Failed parts of concurrent groups are not retried.
+	c.dispatchErr = nil
+	require.NoError(t, c.manager.dispatchParts(ctx))
+	require.Empty(t, c.dispatched)
+}
+
+// TestSequentialLoopOut tests splitting a loop out into parts that are
+// dispatched one after another.
+func TestSequentialLoopOut(t *testing.T) {
+	ctx := context.Background()
+	c := newSplitHarness(t, 1000, 1000, 1000)
+
+	group, resp, err := c.manager.LoopOut(ctx, &looprpc.LoopOutRequest{
+		Amt: 1500,
+		Split: &looprpc.LoopOutSplit{
+			Sequential: true,
+			MaxPartAmt: 500,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, lntypes.Hash{1}, lntypes.Hash(resp.IdBytes))
+
+	// Only the first part is dispatched right away.
+	require.Len(t, c.dispatched, 1)
+	c.requirePartStates(
+		t, group.ID, PartDispatched, PartPending, PartPending,
+	)
+
+	// While the first swap is unknown or pending, we wait.
+	require.NoError(t, c.manager.dispatchParts(ctx))
+	require.Len(t, c.dispatched, 1)
+
+	c.swapStates[lntypes.Hash{1}] = loopdb.StateHtlcPublished
+	require.NoError(t, c.manager.dispatchParts(ctx))
+	require.Len(t, c.dispatched, 1)
+
+	// Once it succeeds, the second part is dispatched.
+	c.swapStates[lntypes.Hash{1}] = loopdb.StateSuccess
+	require.NoError(t, c.manager.dispatchParts(ctx))
+	require.Len(t, c.dispatched, 2)
+	require.EqualValues(t, 500, c.dispatched[1].Amt)
+	c.requirePartStates(
+		t, group.ID, PartDispatched, PartDispatched, PartPending,
+	)
+
+	// If the second swap fails, the last part is skipped.
+	c.swapStates[lntypes.Hash{2}] = loopdb.StateFailOffchainPayments
+	require.NoError(t, c.manager.dispatchParts(ctx))
+	require.Len(t, c.dispatched, 2)
+	c.requirePartStates(
+		t, group.ID, PartDispatched, PartDispatched, PartSkipped,
+	)
+
+	state, swapped := c.group(t, group.ID).Status(c.manager.cfg.SwapState)
+	require.Equal(t, GroupPartiallySucceeded, state)
+	require.EqualValues(t, 500, swapped)
+}
@@ -0,0 +1,399 @@
+package split
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrNotSplit is returned when a loop out request without split
+	// options is passed to the manager.
+	ErrNotSplit = errors.New("loop out request has no split options")
+
+	// ErrAssetSplit is returned when an asset loop out is split.
+	ErrAssetSplit = errors.New("asset loop outs can't be split")
+
+	// ErrReservationSplit is returned when a loop out that uses
+	// reservations is split. The amount of such a loop out must match its
+	// reservations, so it can't be divided.
+	ErrReservationSplit = errors.New("loop outs that use reservations " +
+		"can't be split")
+
+	// ErrAmountTooLow is returned when the amount of a loop out is below
+	// the server's minimum swap amount.
+	ErrAmountTooLow = errors.New("swap amount below minimum")
+
+	// ErrPartAmountTooLow is returned when the maximum part amount is
+	// below the server's minimum swap amount.
+	ErrPartAmountTooLow = errors.New("maximum part amount below minimum " +
+		"swap amount")
+
+	// ErrInsufficientBalance is returned when the local balance of our
+	// channels can't cover all parts of a loop out.
+	ErrInsufficientBalance = errors.New("insufficient local channel " +
+		"balance")
+)
+
+// PartState is an enum which represents the state of a part.
+type PartState uint8
+
+const (
+	// PartPending indicates that a part was not dispatched yet.
+	PartPending PartState = iota
+
+	// PartDispatched indicates that a swap was dispatched for a part.
+	PartDispatched
+
+	// PartFailed indicates that the swap of a part could not be
+	// dispatched.
+	PartFailed
+
+	// PartSkipped indicates that a part was not dispatched, because an
+	// earlier part of its sequential group failed.
+	PartSkipped
+)
+
+// String returns a string representation of a part state.
+func (s PartState) String() string {
+	switch s {
+	case PartPending:
+		return "pending"
+
+	case PartDispatched:
+		return "dispatched"
+
+	case PartFailed:
+		return "failed"
+
+	case PartSkipped:
+		return "skipped"
+
+	default:
+		return "unknown"
+	}
+}
+
+// GroupState is an enum which represents the overall state of a group, which
+// is derived from the states of its parts and their swaps.
+type GroupState uint8
+
+const (
+	// GroupInProgress indicates that some parts of a group are not
+	// resolved yet.
+	GroupInProgress GroupState = iota
+
+	// GroupSucceeded indicates that the swaps of all parts succeeded.
+	GroupSucceeded
+
+	// GroupFailed indicates that none of the parts succeeded.
+	GroupFailed
+
+	// GroupPartiallySucceeded indicates that the swaps of some parts
+	// succeeded, while other parts failed or were skipped.
+	GroupPartiallySucceeded
+)
+
+// String returns a string representation of a group state.
+func (s GroupState) String() string {
+	switch s {
+	case GroupInProgress:
+		return "in progress"
+
+	case GroupSucceeded:
+		return "succeeded"
+
+	case GroupFailed:
+		return "failed"
+
+	case GroupPartiallySucceeded:
+		return "partially succeeded"
+
+	default:
+		return "unknown"
+	}
+}
+
+// Part is a single loop out of a group.
+type Part struct {
+	// Index is the position of the part within its group.
+	Index uint32
+
+	// Amount is the amount of the part.
+	Amount btcutil.Amount
+
+	// State is the current state of the part.
+	State PartState
+
+	// SwapHash is the hash of the swap that was dispatched for the part,
+	// if any.
+	SwapHash *lntypes.Hash
+
+	// Error describes why the part could not be dispatched, if it could
+	// not.
+	Error string
+}
+
+// Group is a loop out that was split into several parts.
+type Group struct {
+	// ID is the unique id of the group.
+	ID uint64
+
+	// Created is the time at which the loop out was split.
+	Created time.Time
+
+	// Amount is the total amount of the loop out.
+	Amount btcutil.Amount
+
+	// Sequential is true if each part is only dispatched once the swap of
+	// the previous part succeeded.
+	Sequential bool
+
+	// Request is the loop out request that the requests of the parts are
+	// derived from.
+	Request *looprpc.LoopOutRequest
+
+	// Parts are the parts of the group, ordered by their index.
+	Parts []*Part
+}
+
+// Status returns the state of a group and the sum of the amounts of its parts
+// whose swaps succeeded. The swapState function returns the state of a swap,
+// and false if the swap is not known.
+func (g *Group) Status(swapState func(lntypes.Hash) (loopdb.SwapState,
+	bool)) (GroupState, btcutil.Amount) {
+
+	var (
+		inProgress        bool
+		succeeded, failed int
+		swapped           btcutil.Amount
+	)
+
+	for _, part := range g.Parts {
+		switch part.State {
+		case PartPending:
+			inProgress = true
+
+		case PartDispatched:
+			state, ok := swapState(*part.SwapHash)
+			switch {
+			case !ok || state.IsPending():
+				inProgress = true
+
+			case state == loopdb.StateSuccess:
+				succeeded++
+				swapped += part.Amount
+
+			default:
+				failed++
+			}
+
+		default:
+			failed++
+		}
+	}
+
+	switch {
+	case inProgress:
+		return GroupInProgress, swapped
+
+	case failed == 0:
+		return GroupSucceeded, swapped
+
+	case succeeded == 0:
+		return GroupFailed, swapped
+
+	default:
+		return GroupPartiallySucceeded, swapped
+	}
+}
+
+// Store is the interface that persists our split loop outs.
+type Store interface {
+	// AddGroup persists a group along with its parts and returns the id
+	// that it was stored under.
+	AddGroup(ctx context.Context, group *Group) (uint64, error)
+
+	// ListGroups returns all of our groups along with their parts,
+	// ordered by the time that they were created.
+	ListGroups(ctx context.Context) ([]*Group, error)
+
+	// UpdatePart persists the state, swap hash and error of a part.
+	UpdatePart(ctx context.Context, groupID uint64, part *Part) error
+}
+
+// splitAmount divides an amount into the smallest number of parts that don't
+// exceed the maximum amount provided. The parts are of equal size, apart from
+// the remainder of the division which is spread over the first parts, so
+// that no part falls below the minimum amount unless the maximum does.
+func splitAmount(amount, minAmount,
+	maxAmount btcutil.Amount) ([]btcutil.Amount, error) {
+
+	if amount < minAmount {
+		return nil, fmt.Errorf("%w: %v < %v", ErrAmountTooLow, amount,
+			minAmount)
+	}
+
+	if maxAmount < minAmount || maxAmount <= 0 {
+		return nil, fmt.Errorf("%w: %v < %v", ErrPartAmountTooLow,
+			maxAmount, minAmount)
+	}
+
+	count := (amount + maxAmount - 1) / maxAmount
+	partAmount, remainder := amount/count, amount%count
+
+	amounts := make([]btcutil.Amount, count)
+	for i := range amounts {
+		amounts[i] = partAmount
+		if btcutil.Amount(i) < remainder {
+			amounts[i]++
+		}
+	}
+
+	return amounts, nil
+}
+
+// channelBalance is the local balance of a channel that parts can be paid
+// from.
+type channelBalance struct {
+	chanID    uint64
+	available btcutil.Amount
+}
+
+// spendableBalances returns the local balances of our active channels, net of
+// their reserves. If the allowed set is not empty, only the channels in it
+// are returned.
+func spendableBalances(channels []lndclient.ChannelInfo,
+	allowed loopdb.ChannelSet) []*channelBalance {
+
+	allowedChans := make(map[uint64]bool, len(allowed))
+	for _, chanID := range allowed {
+		allowedChans[chanID] = true
+	}
+
+	var balances []*channelBalance
+	for _, channel := range channels {
+		if !channel.Active {
+			continue
+		}
+
+		if len(allowedChans) > 0 && !allowedChans[channel.ChannelID] {
+			continue
+		}
+
+		available := channel.LocalBalance
+		if channel.LocalConstraints != nil {
+			available -= channel.LocalConstraints.Reserve
+		}
+
+		if available <= 0 {
+			continue
+		}
+
+		balances = append(balances, &channelBalance{
+			chanID:    channel.ChannelID,
+			available: available,
+		})
+	}
+
+	return balances
+}
+
+// selectChannels chooses the outgoing channel set of each part from the
+// balances provided. A part's set is filled with the channels that have the
+// most balance left until it covers the part's amount, so that each part is
+// paid over as few channels as possible. The balance that is assigned to a
+// part is deducted from its channels, so the sets of concurrent parts don't
+// rely on the same balance.
+func selectChannels(balances []*channelBalance,
+	amounts []btcutil.Amount) ([]loopdb.ChannelSet, error) {
+
+	chanSets := make([]loopdb.ChannelSet, len(amounts))
+	for i, amount := range amounts {
+		sort.SliceStable(balances, func(i, j int) bool {
+			return balances[i].available > balances[j].available
+		})
+
+		remaining := amount
+		for _, balance := range balances {
+			if remaining <= 0 {
+				break
+			}
+
+			if balance.available <= 0 {
+				continue
+			}
+
+			assigned := min(balance.available, remaining)
+			balance.available -= assigned
+			remaining -= assigned
+
+			chanSets[i] = append(chanSets[i], balance.chanID)
+		}
+
+		if remaining > 0 {
+			return nil, fmt.Errorf("%w: part %v of %v sats is short "+
+				"of %v sats", ErrInsufficientBalance, i, amount,
+				remaining)
+		}
+
+		// Keep the channel ids in a stable order, which makes the sets
+		// easier to compare.
+		sort.Slice(chanSets[i], func(a, b int) bool {
+			return chanSets[i][a] < chanSets[i][b]
+		})
+	}
+
+	return chanSets, nil
+}
+
+// partRequest returns the loop out request that a part is dispatched with.
+// The request's fee limits apply to the whole amount of the group, so they
+// are scaled down to the part's share of it. The swap publication deadline
+// of the request is set relative to the time that the loop out was split, so
+// we move it forward by the time that has passed since, so that parts that
+// are dispatched later are not all dispatched as fast swaps.
+func partRequest(group *Group, part *Part, chanSet loopdb.ChannelSet,
+	now time.Time) *looprpc.LoopOutRequest {
+
+	req := proto.Clone(group.Request).(*looprpc.LoopOutRequest)
+
+	scale := func(limit int64) int64 {
+		return limit * int64(part.Amount) / int64(group.Amount)
+	}
+
+	req.Amt = int64(part.Amount)
+	req.MaxSwapFee = scale(req.MaxSwapFee)
+	req.MaxMinerFee = scale(req.MaxMinerFee)
+	req.MaxPrepayAmt = scale(req.MaxPrepayAmt)
+	req.MaxSwapRoutingFee = scale(req.MaxSwapRoutingFee)
+	req.MaxPrepayRoutingFee = scale(req.MaxPrepayRoutingFee)
+	req.OutgoingChanSet = chanSet
+	req.LoopOutChannel = 0 //nolint:staticcheck
+	req.Split = nil
+
+	deadline := req.SwapPublicationDeadline
+	if deadline == 0 || !now.After(group.Created) {
+		return req
+	}
+
+	// The deadline may be set in seconds or in milliseconds, timestamps
+	// in milliseconds have at least 13 digits.
+	waited := uint64(now.Sub(group.Created).Seconds())
+	if deadline >= 1e12 {
+		waited = uint64(now.Sub(group.Created).Milliseconds())
+	}
+
+	req.SwapPublicationDeadline = deadline + waited
+
+	return req
+}
@@ -0,0 +1,276 @@
+package split
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestSplitAmount tests dividing amounts into parts.
+func TestSplitAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   btcutil.Amount
+		min      btcutil.Amount
+		max      btcutil.Amount
+		expected []btcutil.Amount
+		err      error
+	}{
+		{
+			name:     "single part",
+			amount:   500,
+			min:      100,
+			max:      1000,
+			expected: []btcutil.Amount{500},
+		},
+		{
+			name:     "exact multiple",
+			amount:   3000,
+			min:      100,
+			max:      1000,
+			expected: []btcutil.Amount{1000, 1000, 1000},
+		},
+		{
+			// Splitting 2100 into 1000, 1000 and 100 would
+			// produce a small last part, so we split evenly.
+			name:     "remainder spread",
+			amount:   2101,
+			min:      100,
+			max:      1000,
+			expected: []btcutil.Amount{701, 700, 700},
+		},
+		{
+			name:   "below minimum",
+			amount: 50,
+			min:    100,
+			max:    1000,
+			err:    ErrAmountTooLow,
+		},
+		{
+			name:   "maximum below minimum",
+			amount: 5000,
+			min:    100,
+			max:    50,
+			err:    ErrPartAmountTooLow,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			amounts, err := splitAmount(
+				testCase.amount, testCase.min, testCase.max,
+			)
+			require.ErrorIs(t, err, testCase.err)
+			require.Equal(t, testCase.expected, amounts)
+		})
+	}
+}
+
+// TestSelectChannels tests choosing the channel sets of parts.
+func TestSelectChannels(t *testing.T) {
+	channels := []lndclient.ChannelInfo{
+		{
+			ChannelID:    1,
+			Active:       true,
+			LocalBalance: 600,
+			LocalConstraints: &lndclient.ChannelConstraints{
+				Reserve: 100,
+			},
+		},
+		{
+			ChannelID:    2,
+			Active:       true,
+			LocalBalance: 800,
+		},
+		{
+			ChannelID:    3,
+			Active:       true,
+			LocalBalance: 300,
+		},
+		{
+			// Inactive channels are never used.
+			ChannelID:    4,
+			LocalBalance: 5000,
+		},
+	}
+
+	// The first part is paid from our largest channel alone. The second
+	// part uses the spendable balance of channel 1, which now has the
+	// most balance left, and part of channel 3. The third part uses the
+	// rest of our balance.
+	chanSets, err := selectChannels(
+		spendableBalances(channels, nil),
+		[]btcutil.Amount{700, 550, 350},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []loopdb.ChannelSet{
+		{2}, {1, 3}, {2, 3},
+	}, chanSets)
+
+	// Our channels can't cover more than their spendable balance.
+	_, err = selectChannels(
+		spendableBalances(channels, nil),
+		[]btcutil.Amount{1000, 700},
+	)
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	// Only allowed channels are used.
+	_, err = selectChannels(
+		spendableBalances(channels, loopdb.ChannelSet{1, 3}),
+		[]btcutil.Amount{900},
+	)
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	chanSets, err = selectChannels(
+		spendableBalances(channels, loopdb.ChannelSet{1, 3}),
+		[]btcutil.Amount{400, 400},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []loopdb.ChannelSet{{1}, {1, 3}}, chanSets)
+}
+
+// TestGroupStatus tests deriving the state of a group from its parts.
+func TestGroupStatus(t *testing.T) {
+	var (
+		success = lntypes.Hash{1}
+		failure = lntypes.Hash{2}
+		pending = lntypes.Hash{3}
+		unknown = lntypes.Hash{4}
+	)
+
+	swapStates := map[lntypes.Hash]loopdb.SwapState{
+		success: loopdb.StateSuccess,
+		failure: loopdb.StateFailOffchainPayments,
+		pending: loopdb.StateHtlcPublished,
+	}
+	swapState := func(hash lntypes.Hash) (loopdb.SwapState, bool) {
+		state, ok := swapStates[hash]
+		return state, ok
+	}
+
+	dispatched := func(hash lntypes.Hash) *Part {
+		return &Part{
+			Amount:   100,
+			State:    PartDispatched,
+			SwapHash: &hash,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		parts   []*Part
+		state   GroupState
+		swapped btcutil.Amount
+	}{
+		{
+			name: "all succeeded",
+			parts: []*Part{
+				dispatched(success), dispatched(success),
+			},
+			state:   GroupSucceeded,
+			swapped: 200,
+		},
+		{
+			name: "swap pending",
+			parts: []*Part{
+				dispatched(success), dispatched(pending),
+			},
+			state:   GroupInProgress,
+			swapped: 100,
+		},
+		{
+			name: "swap unknown",
+			parts: []*Part{
+				dispatched(unknown),
+			},
+			state: GroupInProgress,
+		},
+		{
+			name: "part not dispatched",
+			parts: []*Part{
+				dispatched(success), {Amount: 100},
+			},
+			state:   GroupInProgress,
+			swapped: 100,
+		},
+		{
+			name: "partially succeeded",
+			parts: []*Part{
+				dispatched(success), dispatched(failure),
+				{Amount: 100, State: PartSkipped},
+			},
+			state:   GroupPartiallySucceeded,
+			swapped: 100,
+		},
+		{
+			name: "all failed",
+			parts: []*Part{
+				dispatched(failure),
+				{Amount: 100, State: PartFailed},
+			},
+			state: GroupFailed,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			group := &Group{Parts: testCase.parts}
+
+			state, swapped := group.Status(swapState)
+			require.Equal(t, testCase.state, state)
+			require.Equal(t, testCase.swapped, swapped)
+		})
+	}
+}
+
+// TestPartRequest tests deriving the request of a part from the request of
+// its group.
+func TestPartRequest(t *testing.T) {
+	created := time.Unix(1700000000, 0)
+	group := &Group{
+		Created: created,
+		Amount:  4000,
+		Request: &looprpc.LoopOutRequest{
+			Amt:                     4000,
+			MaxSwapFee:              400,
+			MaxMinerFee:             800,
+			MaxPrepayAmt:            40,
+			MaxSwapRoutingFee:       80,
+			MaxPrepayRoutingFee:     20,
+			OutgoingChanSet:         []uint64{1, 2, 3},
+			SwapPublicationDeadline: uint64(created.Unix()),
+			Label:                   "label",
+			Split: &looprpc.LoopOutSplit{
+				Sequential: true,
+			},
+		},
+	}
+
+	part := &Part{Index: 1, Amount: 1000}
+	req := partRequest(
+		group, part, loopdb.ChannelSet{2}, created.Add(time.Minute),
+	)
+
+	require.True(t, proto.Equal(&looprpc.LoopOutRequest{
+		Amt:                     1000,
+		MaxSwapFee:              100,
+		MaxMinerFee:             200,
+		MaxPrepayAmt:            10,
+		MaxSwapRoutingFee:       20,
+		MaxPrepayRoutingFee:     5,
+		OutgoingChanSet:         []uint64{2},
+		SwapPublicationDeadline: uint64(created.Unix()) + 60,
+		Label:                   "label",
+	}, req))
+
+	// The group's request is not modified.
+	require.EqualValues(t, 4000, group.Request.Amt)
+	require.NotNil(t, group.Request.Split)
+}
@@ -0,0 +1,221 @@
+package split
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/loopdb/sqlc"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"google.golang.org/protobuf/proto"
+)
+
+// Querier holds the queries for split loop out groups and their parts. Parts
+// are listed across all groups and matched to their group in memory.
+type Querier interface {
+	// InsertSplitLoopOutGroup inserts a group and returns its id.
+	InsertSplitLoopOutGroup(ctx context.Context,
+		arg sqlc.InsertSplitLoopOutGroupParams) (int32, error)
+
+	// InsertSplitLoopOutPart inserts a part of a group.
+	InsertSplitLoopOutPart(ctx context.Context,
+		arg sqlc.InsertSplitLoopOutPartParams) error
+
+	// ListSplitLoopOutGroups returns all groups.
+	ListSplitLoopOutGroups(ctx context.Context) ([]sqlc.SplitLoopOutGroup,
+		error)
+
+	// ListSplitLoopOutParts returns the parts of all groups.
+	ListSplitLoopOutParts(ctx context.Context) ([]sqlc.SplitLoopOutPart,
+		error)
+
+	// UpdateSplitLoopOutPart updates the state of a part.
+	UpdateSplitLoopOutPart(ctx context.Context,
+		arg sqlc.UpdateSplitLoopOutPartParams) error
+}
+
+// BaseDB lets groups be written and read together with their parts in a
+// single transaction.
+type BaseDB interface {
+	Querier
+
+	// ExecTx allows for executing a function in the context of a database
+	// transaction.
+	ExecTx(ctx context.Context, txOptions loopdb.TxOptions,
+		txBody func(Querier) error) error
+}
+
+// SQLStore manages our split loop outs in the database.
+type SQLStore struct {
+	baseDb BaseDB
+}
+
+// A compile-time check that SQLStore implements Store.
+var _ Store = (*SQLStore)(nil)
+
+// NewSQLStore returns a store for split loop outs that uses the database
+// provided.
+func NewSQLStore(db BaseDB) *SQLStore {
+	return &SQLStore{
+		baseDb: db,
+	}
+}
+
+// AddGroup persists a group along with its parts and returns the id that it
+// was stored under.
+func (s *SQLStore) AddGroup(ctx context.Context, group *Group) (uint64,
+	error) {
+
+	request, err := proto.Marshal(group.Request)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int32
+	err = s.baseDb.ExecTx(ctx, loopdb.NewSqlWriteOpts(),
+		func(q Querier) error {
+			id, err = q.InsertSplitLoopOutGroup(
+				ctx, sqlc.InsertSplitLoopOutGroupParams{
+					CreatedAt:  group.Created.UTC(),
+					Amount:     int64(group.Amount),
+					Sequential: group.Sequential,
+					Request:    request,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, part := range group.Parts {
+				args := partParams(uint64(id), part)
+				err := q.InsertSplitLoopOutPart(
+					ctx, sqlc.InsertSplitLoopOutPartParams{
+						GroupID:   args.GroupID,
+						PartIndex: args.PartIndex,
+						Amount:    int64(part.Amount),
+						State:     args.State,
+						SwapHash:  args.SwapHash,
+						Error:     args.Error,
+					},
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(id), nil
+}
+
+// ListGroups returns all of our groups along with their parts, ordered by the
+// time that they were created.
+func (s *SQLStore) ListGroups(ctx context.Context) ([]*Group, error) {
+	var (
+		groupRows []sqlc.SplitLoopOutGroup
+		partRows  []sqlc.SplitLoopOutPart
+	)
+	err := s.baseDb.ExecTx(ctx, loopdb.NewSqlReadOpts(),
+		func(q Querier) error {
+			var err error
+			groupRows, err = q.ListSplitLoopOutGroups(ctx)
+			if err != nil {
+				return err
+			}
+
+			partRows, err = q.ListSplitLoopOutParts(ctx)
+
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*Group, 0, len(groupRows))
+	groupsByID := make(map[int32]*Group, len(groupRows))
+	for _, row := range groupRows {
+		request := &looprpc.LoopOutRequest{}
+		err := proto.Unmarshal(row.Request, request)
+		if err != nil {
+			return nil, err
+		}
+
+		group := &Group{
+			ID:         uint64(row.ID),
+			Created:    row.CreatedAt,
+			Amount:     btcutil.Amount(row.Amount),
+			Sequential: row.Sequential,
+			Request:    request,
+		}
+
+		groups = append(groups, group)
+		groupsByID[row.ID] = group
+	}
+
+	// Our parts are ordered by group and index, so we can append them to
+	// their groups in order.
+	for _, row := range partRows {
+		group, ok := groupsByID[row.GroupID]
+		if !ok {
+			return nil, fmt.Errorf("part %v of unknown group %v",
+				row.PartIndex, row.GroupID)
+		}
+
+		part := &Part{
+			Index:  uint32(row.PartIndex),
+			Amount: btcutil.Amount(row.Amount),
+			State:  PartState(row.State),
+			Error:  row.Error,
+		}
+
+		if len(row.SwapHash) != 0 {
+			hash, err := lntypes.MakeHash(row.SwapHash)
+			if err != nil {
+				return nil, err
+			}
+
+			part.SwapHash = &hash
+		}
+
+		group.Parts = append(group.Parts, part)
+	}
+
+	return groups, nil
+}
+
+// UpdatePart persists the state, swap hash and error of a part.
+func (s *SQLStore) UpdatePart(ctx context.Context, groupID uint64,
+	part *Part) error {
+
+	if groupID > math.MaxInt32 {
+		return errors.New("group id out of range")
+	}
+
+	return s.baseDb.UpdateSplitLoopOutPart(ctx, partParams(groupID, part))
+}
+
+// partParams converts the mutable fields of a part to their database
+// representation.
+func partParams(groupID uint64, part *Part) sqlc.UpdateSplitLoopOutPartParams {
+	args := sqlc.UpdateSplitLoopOutPartParams{
+		GroupID:   int32(groupID),
+		PartIndex: int32(part.Index),
+		State:     int32(part.State),
+		Error:     part.Error,
+	}
+	if part.SwapHash != nil {
+		args.SwapHash = part.SwapHash[:]
+	}
+
+	return args
+}
@@ -0,0 +1,94 @@
+package split
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestSQLStore tests persisting groups and updating their parts.
+func TestSQLStore(t *testing.T) {
+	ctx := context.Background()
+	testDb := loopdb.NewTestDB(t)
+	defer testDb.Close()
+
+	store := NewSQLStore(loopdb.NewTypedStore[Querier](testDb))
+
+	groups, err := store.ListGroups(ctx)
+	require.NoError(t, err)
+	require.Empty(t, groups)
+
+	created := time.Unix(1000, 0).UTC()
+	concurrent := &Group{
+		Created: created,
+		Amount:  3000,
+		Request: &looprpc.LoopOutRequest{
+			Amt:   3000,
+			Split: &looprpc.LoopOutSplit{},
+		},
+		Parts: []*Part{
+			{Index: 0, Amount: 1500},
+			{Index: 1, Amount: 1500},
+		},
+	}
+
+	concurrent.ID, err = store.AddGroup(ctx, concurrent)
+	require.NoError(t, err)
+
+	sequential := &Group{
+		Created:    created.Add(time.Minute),
+		Amount:     1000,
+		Sequential: true,
+		Request: &looprpc.LoopOutRequest{
+			Amt: 1000,
+			Split: &looprpc.LoopOutSplit{
+				Sequential: true,
+				MaxPartAmt: 500,
+			},
+		},
+		Parts: []*Part{
+			{Index: 0, Amount: 500},
+			{Index: 1, Amount: 500},
+		},
+	}
+
+	sequential.ID, err = store.AddGroup(ctx, sequential)
+	require.NoError(t, err)
+
+	// Dispatch the first part of each group and fail the second part of
+	// our concurrent group.
+	hash := lntypes.Hash{1}
+	concurrent.Parts[0].State = PartDispatched
+	concurrent.Parts[0].SwapHash = &hash
+	require.NoError(t, store.UpdatePart(ctx, concurrent.ID,
+		concurrent.Parts[0]))
+
+	concurrent.Parts[1].State = PartFailed
+	concurrent.Parts[1].Error = "no route"
+	require.NoError(t, store.UpdatePart(ctx, concurrent.ID,
+		concurrent.Parts[1]))
+
+	otherHash := lntypes.Hash{2}
+	sequential.Parts[0].State = PartDispatched
+	sequential.Parts[0].SwapHash = &otherHash
+	require.NoError(t, store.UpdatePart(ctx, sequential.ID,
+		sequential.Parts[0]))
+
+	groups, err = store.ListGroups(ctx)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+
+	for i, expected := range []*Group{concurrent, sequential} {
+		actual := groups[i]
+		require.True(t, proto.Equal(expected.Request, actual.Request))
+
+		expected.Request, actual.Request = nil, nil
+		require.Equal(t, expected, actual)
+	}
+}
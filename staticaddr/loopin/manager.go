@@ -16,7 +16,6 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/fsm"
-	"github.com/lightninglabs/loop/labels"
 	"github.com/lightninglabs/loop/staticaddr/deposit"
 	"github.com/lightninglabs/loop/swapserverrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -549,12 +548,6 @@ func (m *Manager) initiateLoopIn(ctx context.Context,
 	}
 	totalDepositAmount := tmp.TotalDepositAmount()
 
-	// Check that the label is valid.
-	err := labels.Validate(req.Label)
-	if err != nil {
-		return nil, fmt.Errorf("invalid label: %w", err)
-	}
-
 	// Private and route hints are mutually exclusive as setting private
 	// means we retrieve our own route hints from the connected node.
 	if len(req.RouteHints) != 0 && req.Private {
@@ -573,6 +566,7 @@ func (m *Manager) initiateLoopIn(ctx context.Context,
 
 		// Because the Private flag is set, we'll generate our own set
 		// of hop hints.
+		var err error
 		req.RouteHints, err = loop.SelectHopHints(
 			ctx, m.cfg.LndClient, totalDepositAmount,
 			loop.DefaultMaxHopHints, includeNodes,
@@ -209,12 +209,6 @@ func (b *batch) presign(ctx context.Context, newSweeps []*sweep) error {
 			"a non-empty batch")
 	}
 
-	// priorityConfTarget defines the confirmation target for quick
-	// inclusion in a block. A value of 2, rather than 1, is used to prevent
-	// fee estimator from failing.
-	// See https://github.com/lightninglabs/loop/issues/898
-	const priorityConfTarget = 2
-
 	// Find the feerate needed to get into next block.
 	nextBlockFeeRate, err := b.wallet.EstimateFeeRate(
 		ctx, priorityConfTarget,
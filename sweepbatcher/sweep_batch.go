@@ -54,6 +54,18 @@ const (
 	// It is needed to prevent sweep tx from becoming non-standard. Max
 	// standard transaction is 400k wu, a non-cooperative input is 393 wu.
 	MaxSweepsPerBatch = 1000
+
+	// channelFundingDelta is the number of blocks before the timeout of a
+	// sweep until which we retry to negotiate the channel that it funds.
+	// Once it is reached, the sweep falls back to paying its destination
+	// address, so that it confirms in time.
+	channelFundingDelta = 20
+
+	// priorityConfTarget defines the confirmation target for quick
+	// inclusion in a block. A value of 2, rather than 1, is used to prevent
+	// fee estimator from failing.
+	// See https://github.com/lightninglabs/loop/issues/898
+	priorityConfTarget = 2
 )
 
 var (
@@ -193,6 +205,10 @@ type batchConfig struct {
 	// enabled.
 	presignedHelper PresignedHelper
 
+	// channelFunder negotiates the funding outputs of channels that
+	// sweeps pay to directly.
+	channelFunder ChannelFunder
+
 	// skippedTxns is the list of previous transactions to ignore when
 	// loading the sweeps from DB. This is needed to fix a historical bug.
 	skippedTxns map[chainhash.Hash]struct{}
@@ -1528,7 +1544,11 @@ func (b *batch) publishMixedBatch(ctx context.Context) (btcutil.Amount, error,
 	}
 
 	// Find destination address.
-	var address btcutil.Address
+	var (
+		address btcutil.Address
+		funding *wire.TxOut
+		err     error
+	)
 	if addrOverride {
 		// Sanity check, there should be exactly 1 sweep in this batch.
 		if len(sweeps) != 1 {
@@ -1537,8 +1557,38 @@ func (b *batch) publishMixedBatch(ctx context.Context) (btcutil.Amount, error,
 		}
 
 		address = sweeps[0].destAddr
+
+		// The sweep may pay to the funding output of a channel, which
+		// is added as a fixed output after the main output.
+		var fundingTx *wire.MsgTx
+		funding, fundingTx, err = b.channelFunding(ctx, sweeps[0])
+		if err != nil {
+			return 0, err, true
+		}
+
+		// If the funding was finalized already, the transaction must
+		// not change, so we republish it as is.
+		if fundingTx != nil {
+			return b.republishFunding(ctx, sweeps[0], fundingTx)
+		}
+
+		if funding != nil {
+			sweeps[0].change = funding
+
+			// Unless the transaction is finalized below, we
+			// cancel the negotiation, so that it is retried at the
+			// next block.
+			defer func() {
+				if funding != nil {
+					b.cancelFunding(
+						ctx, sweeps[0].swapHash, false,
+						errors.New("sweep tx not "+
+							"finalized"),
+					)
+				}
+			}()
+		}
 	} else {
-		var err error
 		address, err = b.getBatchDestAddr(ctx)
 		if err != nil {
 			return 0, err, false
@@ -1560,11 +1610,33 @@ func (b *batch) publishMixedBatch(ctx context.Context) (btcutil.Amount, error,
 		minRelayFeeRate chainfee.SatPerKWeight
 		coopInputs      int
 	)
-	minRelayFeeRate, err := b.wallet.MinRelayFee(ctx)
+	minRelayFeeRate, err = b.wallet.MinRelayFee(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get min relay fee: %w", err),
 			false
 	}
+
+	// A sweep that funds a channel can't be fee bumped once it is
+	// finalized, so it pays at least the fee rate for quick inclusion in a
+	// block.
+	feeRate := b.rbfCache.FeeRate
+	if funding != nil {
+		priorityFeeRate, err := b.wallet.EstimateFeeRate(
+			ctx, priorityConfTarget,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get priority fee "+
+				"rate: %w", err), false
+		}
+
+		if priorityFeeRate > feeRate {
+			b.Infof("raising fee rate of channel funding tx from "+
+				"%v to %v", feeRate, priorityFeeRate)
+
+			feeRate = priorityFeeRate
+		}
+	}
+
 	for attempt := 1; ; attempt++ {
 		b.Infof("Attempt %d of collecting cooperative signatures.",
 			attempt)
@@ -1572,10 +1644,20 @@ func (b *batch) publishMixedBatch(ctx context.Context) (btcutil.Amount, error,
 		// Construct unsigned batch transaction.
 		var err error
 		tx, weight, feeForWeight, fee, err = constructUnsignedTx(
-			sweeps, address, b.currentHeight, b.rbfCache.FeeRate,
+			sweeps, address, b.currentHeight, feeRate,
 			minRelayFeeRate,
 		)
-		if err != nil {
+		switch {
+		// If the funding output of a channel leaves no room for the
+		// main output, we give up on the channel.
+		case err != nil && funding != nil:
+			b.cancelFunding(ctx, sweeps[0].swapHash, true, err)
+			funding = nil
+
+			return 0, fmt.Errorf("failed to construct channel "+
+				"funding tx: %w", err), true
+
+		case err != nil:
 			return 0, fmt.Errorf("failed to construct tx: %w", err),
 				false
 		}
@@ -1607,10 +1689,14 @@ func (b *batch) publishMixedBatch(ctx context.Context) (btcutil.Amount, error,
 					"sweep %x: %v", sweep.swapHash[:6], err)
 
 				// Set coopFailed flag for this sweep in all the
-				// places we store the sweep.
+				// places we store the sweep. The stored sweep
+				// is updated separately, since the funding
+				// output of a channel is only set on our copy.
 				sweep.coopFailed = true
 				sweeps[i] = sweep
-				b.sweeps[sweep.outpoint] = sweep
+				stored := b.sweeps[sweep.outpoint]
+				stored.coopFailed = true
+				b.sweeps[sweep.outpoint] = stored
 
 				// Update newCoopFailures to know if we need
 				// another attempt of cooperative signing.
@@ -1745,7 +1831,7 @@ func (b *batch) publishMixedBatch(ctx context.Context) (btcutil.Amount, error,
 	b.Infof("attempting to publish batch tx=%v with feerate=%v, "+
 		"weight=%v, feeForWeight=%v, fee=%v, sweeps=%d, "+
 		"%d cooperative: (%s) and %d non-cooperative (%s), destAddr=%s",
-		txHash, b.rbfCache.FeeRate, weight, feeForWeight, fee,
+		txHash, feeRate, weight, feeForWeight, fee,
 		len(tx.TxIn), coopInputs, strings.Join(coopHexs, ", "),
 		nonCoopInputs, strings.Join(nonCoopHexs, ", "), address)
 
@@ -1760,6 +1846,18 @@ func (b *batch) publishMixedBatch(ctx context.Context) (btcutil.Amount, error,
 			txHash, realWeight, weight)
 	}
 
+	// Hand the transaction to the negotiation of the channel that it
+	// funds, which must succeed before we may publish it.
+	if funding != nil {
+		err = b.finalizeFunding(ctx, sweeps, tx)
+		if err != nil {
+			return 0, err, true
+		}
+
+		// The funding was finalized, so it must not be canceled.
+		funding = nil
+	}
+
 	// Publish the transaction.
 	err = b.wallet.PublishTransaction(
 		ctx, tx, b.cfg.txLabeler(b.id),
@@ -1776,6 +1874,125 @@ func (b *batch) publishMixedBatch(ctx context.Context) (btcutil.Amount, error,
 	return fee, nil, true
 }
 
+// channelFunding returns the funding output of the channel that a sweep pays
+// to, or the sweep transaction that was finalized for the channel already.
+// Nil values are returned if the sweep doesn't fund a channel. If the
+// negotiation of the channel is pending or fails, an error is returned so that
+// we retry at the next block, unless the sweep is close to its timeout. In
+// that case, we give up on the channel and fall back to sweeping to the
+// destination address.
+func (b *batch) channelFunding(ctx context.Context, s sweep) (*wire.TxOut,
+	*wire.MsgTx, error) {
+
+	if b.cfg.channelFunder == nil {
+		return nil, nil, nil
+	}
+
+	funding, tx, err := b.cfg.channelFunder.FundingOutput(ctx, s.swapHash)
+	if err == nil {
+		return funding, tx, nil
+	}
+
+	if b.currentHeight < s.timeout-channelFundingDelta {
+		if errors.Is(err, ErrFundingPending) {
+			return nil, nil, fmt.Errorf("channel funding of sweep "+
+				"%x pending, retrying at next block: %w",
+				s.swapHash[:6], err)
+		}
+
+		return nil, nil, fmt.Errorf("channel funding of sweep %x "+
+			"failed, retrying at next block: %w", s.swapHash[:6],
+			err)
+	}
+
+	b.Warnf("channel funding of sweep %x failed, falling back to %v: %v",
+		s.swapHash[:6], s.destAddr, err)
+
+	err = b.cfg.channelFunder.CancelFunding(ctx, s.swapHash, true, err)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fall back from channel "+
+			"funding: %w", err)
+	}
+
+	return nil, nil, nil
+}
+
+// finalizeFunding hands a signed sweep transaction to the negotiation of the
+// channel that it funds.
+func (b *batch) finalizeFunding(ctx context.Context, sweeps []sweep,
+	tx *wire.MsgTx) error {
+
+	// The channel is committed to the unsigned transaction, which we pass
+	// as a PSBT that has the previous outputs of its inputs filled in.
+	unsignedTx := tx.Copy()
+	for _, txIn := range unsignedTx.TxIn {
+		txIn.Witness = nil
+	}
+
+	packet, _, err := b.createPsbt(unsignedTx, sweeps)
+	if err != nil {
+		return fmt.Errorf("createPsbt failed: %w", err)
+	}
+
+	swapHash := sweeps[0].swapHash
+	err = b.cfg.channelFunder.FinalizeFunding(ctx, swapHash, packet, tx)
+	if err != nil {
+		return fmt.Errorf("channel funding of sweep %x failed: %w",
+			swapHash[:6], err)
+	}
+
+	return nil
+}
+
+// cancelFunding cancels the negotiation of the channel that a sweep funds.
+// Failures are only logged, since the negotiation is restarted or abandoned
+// anyway.
+func (b *batch) cancelFunding(ctx context.Context, swapHash lntypes.Hash,
+	fallBack bool, reason error) {
+
+	err := b.cfg.channelFunder.CancelFunding(
+		ctx, swapHash, fallBack, reason,
+	)
+	if err != nil {
+		b.Warnf("failed to cancel channel funding of sweep %x: %v",
+			swapHash[:6], err)
+	}
+}
+
+// republishFunding publishes a sweep transaction that was finalized for the
+// channel that it funds. The transaction is not fee bumped, since the channel
+// is committed to its txid. Its main output pays to our destination address,
+// so it can still be bumped by CPFP if needed.
+func (b *batch) republishFunding(ctx context.Context, s sweep,
+	tx *wire.MsgTx) (btcutil.Amount, error, bool) {
+
+	// Sanity check: the transaction must spend our sweep.
+	if len(tx.TxIn) != 1 || tx.TxIn[0].PreviousOutPoint != s.outpoint {
+		return 0, fmt.Errorf("channel funding tx %v doesn't spend "+
+			"sweep %v", tx.TxHash(), s.outpoint), false
+	}
+
+	var outputs btcutil.Amount
+	for _, txOut := range tx.TxOut {
+		outputs += btcutil.Amount(txOut.Value)
+	}
+	fee := s.value - outputs
+
+	txHash := tx.TxHash()
+	b.Infof("attempting to publish channel funding tx=%v, fee=%v, "+
+		"sweep=%x", txHash, fee, s.swapHash[:6])
+
+	err := b.wallet.PublishTransaction(ctx, tx, b.cfg.txLabeler(b.id))
+	if err != nil {
+		return 0, fmt.Errorf("publishing tx failed: %w", err), true
+	}
+
+	b.batchTxid = &txHash
+	b.batchPkScript = tx.TxOut[0].PkScript
+
+	return fee, nil, true
+}
+
 func (b *batch) debugLogTx(msg string, tx *wire.MsgTx) {
 	// Serialize the transaction and convert to hex string.
 	buf := bytes.NewBuffer(make([]byte, 0, tx.SerializeSize()))
@@ -195,6 +195,47 @@ type PresignedHelper interface {
 	CleanupTransactions(ctx context.Context, inputs []wire.OutPoint) error
 }
 
+// ChannelFunder negotiates the funding outputs of channels that the sweeps of
+// swaps pay to directly, so that the swapped funds open a channel without a
+// second on-chain transaction. A sweep that funds a channel is published in a
+// transaction that has the funding output as its second output, while its main
+// output pays the rest of the swept value, minus fees, to the destination
+// address of the sweep. Such a sweep must not be batched with other sweeps,
+// which is ensured by marking its destination address as external. Once the
+// sweep transaction was handed to the channel negotiation, it is frozen: it
+// is republished as is, rather than fee bumped. To make up for this, it pays
+// at least the fee rate for quick inclusion in a block. If it still doesn't
+// confirm, it can be fee bumped by a child transaction (CPFP) that spends its
+// main output, for example with lnd's bumpfee if the destination address
+// belongs to lnd's wallet.
+type ChannelFunder interface {
+	// FundingOutput negotiates the funding of the channel that the sweep
+	// of a swap pays to and returns its funding output. It must not block
+	// on the negotiation: ErrFundingPending is returned while the funding
+	// output is not available yet, and the call is repeated at the next
+	// block. If a sweep transaction was finalized for the channel already,
+	// it is returned instead and must be published unchanged. Nil values
+	// are returned if the sweep doesn't fund a channel.
+	FundingOutput(ctx context.Context, swapHash lntypes.Hash) (
+		*wire.TxOut, *wire.MsgTx, error)
+
+	// FinalizeFunding hands the sweep transaction to the channel
+	// negotiation, which commits the channel to the transaction. The
+	// unsigned transaction is passed as a PSBT, along with the signed
+	// transaction. The caller publishes the transaction if no error is
+	// returned.
+	FinalizeFunding(ctx context.Context, swapHash lntypes.Hash,
+		packet []byte, tx *wire.MsgTx) error
+
+	// CancelFunding cancels the ongoing negotiation of the channel that
+	// the sweep of a swap pays to. If fallBack is set, the sweep no longer
+	// funds a channel and pays its whole value to its destination address
+	// instead. Otherwise, the negotiation is retried at the next attempt
+	// to publish the sweep.
+	CancelFunding(ctx context.Context, swapHash lntypes.Hash,
+		fallBack bool, reason error) error
+}
+
 // VerifySchnorrSig is a function that can be used to verify a schnorr
 // signature.
 type VerifySchnorrSig func(pubKey *btcec.PublicKey, hash, sig []byte) error
@@ -330,6 +371,10 @@ type SpendNotifier struct {
 
 var (
 	ErrBatcherShuttingDown = errors.New("batcher shutting down")
+
+	// ErrFundingPending is returned by a ChannelFunder while the funding
+	// output of a channel is still being negotiated.
+	ErrFundingPending = errors.New("channel funding negotiation pending")
 )
 
 // testRequest is a function passed to an event loop and a channel used to
@@ -441,6 +486,10 @@ type Batcher struct {
 	// enabled.
 	presignedHelper PresignedHelper
 
+	// channelFunder negotiates the funding outputs of channels that
+	// sweeps pay to directly.
+	channelFunder ChannelFunder
+
 	// skippedTxns is the list of previous transactions to ignore when
 	// loading the sweeps from DB. This is needed to fix a historical bug.
 	skippedTxns map[chainhash.Hash]struct{}
@@ -489,6 +538,10 @@ type BatcherConfig struct {
 	// enabled.
 	presignedHelper PresignedHelper
 
+	// channelFunder negotiates the funding outputs of channels that
+	// sweeps pay to directly.
+	channelFunder ChannelFunder
+
 	// skippedTxns is the list of previous transactions to ignore when
 	// loading the sweeps from DB. This is needed to fix a historical bug.
 	skippedTxns map[chainhash.Hash]struct{}
@@ -574,6 +627,15 @@ func WithPresignedHelper(presignedHelper PresignedHelper) BatcherOption {
 	}
 }
 
+// WithChannelFunder enables sweeps that pay to the funding outputs of
+// channels. The funder is asked for a funding output whenever a sweep that
+// doesn't share its batch is published.
+func WithChannelFunder(channelFunder ChannelFunder) BatcherOption {
+	return func(cfg *BatcherConfig) {
+		cfg.channelFunder = channelFunder
+	}
+}
+
 // WithSkippedTxns is the list of previous transactions to ignore when
 // loading the sweeps from DB. This is needed to fix a historical bug.
 func WithSkippedTxns(skippedTxns map[chainhash.Hash]struct{}) BatcherOption {
@@ -649,6 +711,7 @@ func NewBatcher(wallet lndclient.WalletKitClient,
 		customMuSig2Signer:   cfg.customMuSig2Signer,
 		publishErrorHandler:  cfg.publishErrorHandler,
 		presignedHelper:      cfg.presignedHelper,
+		channelFunder:        cfg.channelFunder,
 		skippedTxns:          cfg.skippedTxns,
 	}
 }
@@ -1632,6 +1695,7 @@ func (b *Batcher) newBatchConfig(maxTimeoutDistance int32) batchConfig {
 		txLabeler:          b.txLabeler,
 		customMuSig2Signer: b.customMuSig2Signer,
 		presignedHelper:    b.presignedHelper,
+		channelFunder:      b.channelFunder,
 		skippedTxns:        b.skippedTxns,
 		clock:              b.clock,
 		chainParams:        b.chainParams,
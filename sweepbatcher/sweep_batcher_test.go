@@ -1,6 +1,7 @@
 package sweepbatcher
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"errors"
@@ -13,7 +14,9 @@ import (
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btclog/v2"
 	"github.com/lightninglabs/lndclient"
@@ -4853,6 +4856,240 @@ func testFeeRateGrows(t *testing.T, store testStore,
 	require.Equal(t, feeRateHigh, snapshot.rbfCache.FeeRate)
 }
 
+// mockChannelFunder is a ChannelFunder that hands out a fixed funding output
+// and memorizes the sweep transactions that were finalized.
+type mockChannelFunder struct {
+	t *testing.T
+
+	mu sync.Mutex
+
+	// output is the funding output that is handed out.
+	output *wire.TxOut
+
+	// errs are returned when the funding output of a swap is requested.
+	errs map[lntypes.Hash]error
+
+	// finalized are the sweep transactions that were finalized.
+	finalized map[lntypes.Hash]*wire.MsgTx
+
+	// fallBack is the set of swaps that fell back to their destination
+	// address.
+	fallBack map[lntypes.Hash]bool
+}
+
+// FundingOutput returns the funding output, or the finalized sweep
+// transaction if there is one.
+func (f *mockChannelFunder) FundingOutput(_ context.Context,
+	swapHash lntypes.Hash) (*wire.TxOut, *wire.MsgTx, error) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case f.fallBack[swapHash]:
+		return nil, nil, nil
+
+	case f.finalized[swapHash] != nil:
+		return nil, f.finalized[swapHash], nil
+
+	case f.errs[swapHash] != nil:
+		return nil, nil, f.errs[swapHash]
+	}
+
+	return f.output, nil, nil
+}
+
+// FinalizeFunding checks that the PSBT matches the signed transaction and
+// memorizes the transaction.
+func (f *mockChannelFunder) FinalizeFunding(_ context.Context,
+	swapHash lntypes.Hash, packet []byte, tx *wire.MsgTx) error {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	parsed, err := psbt.NewFromRawBytes(bytes.NewReader(packet), false)
+	require.NoError(f.t, err)
+	require.Equal(f.t, tx.TxHash(), parsed.UnsignedTx.TxHash())
+	require.NotNil(f.t, parsed.Inputs[0].WitnessUtxo)
+
+	f.finalized[swapHash] = tx.Copy()
+
+	return nil
+}
+
+// CancelFunding records whether the sweep fell back to its destination
+// address.
+func (f *mockChannelFunder) CancelFunding(_ context.Context,
+	swapHash lntypes.Hash, fallBack bool, _ error) error {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if fallBack {
+		f.fallBack[swapHash] = true
+	}
+
+	return nil
+}
+
+// testChannelFunding tests sweeps that pay to the funding output of a channel,
+// and that fall back to their destination address if the channel can't be
+// negotiated before their timeout.
+func testChannelFunding(t *testing.T, store testStore,
+	batcherStore testBatcherStore) {
+
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sweepStore, err := NewSweepFetcherFromSwapStore(store, lnd.ChainParams)
+	require.NoError(t, err)
+
+	fundingOutput := &wire.TxOut{
+		Value:    900_000,
+		PkScript: []byte{0x51, 0x20, 0x01},
+	}
+	funder := &mockChannelFunder{
+		t:         t,
+		output:    fundingOutput,
+		errs:      make(map[lntypes.Hash]error),
+		finalized: make(map[lntypes.Hash]*wire.MsgTx),
+		fallBack:  make(map[lntypes.Hash]bool),
+	}
+
+	batcher := NewBatcher(lnd.WalletKit, lnd.ChainNotifier, lnd.Signer,
+		testMuSig2SignSweep, testVerifySchnorrSig, lnd.ChainParams,
+		batcherStore, sweepStore, WithChannelFunder(funder))
+	go func() {
+		err := batcher.Run(ctx)
+		checkBatcherError(t, err)
+	}()
+
+	destPkScript, err := txscript.PayToAddrScript(destAddr)
+	require.NoError(t, err)
+
+	addSweep := func(hash lntypes.Hash, op wire.OutPoint,
+		cltvExpiry int32) {
+
+		sweepReq := SweepRequest{
+			SwapHash: hash,
+			Inputs: []Input{{
+				Value:    1_000_000,
+				Outpoint: op,
+			}},
+			Notifier: &dummyNotifier,
+		}
+
+		swap := &loopdb.LoopOutContract{
+			SwapContract: loopdb.SwapContract{
+				CltvExpiry:      cltvExpiry,
+				AmountRequested: 1_000_000,
+				ProtocolVersion: loopdb.ProtocolVersionMuSig2,
+				HtlcKeys:        htlcKeys,
+
+				// Make preimage unique to pass SQL
+				// constraints.
+				Preimage: lntypes.Preimage{hash[0]},
+			},
+			IsExternalAddr:  true,
+			DestAddr:        destAddr,
+			SwapInvoice:     swapInvoice,
+			SweepConfTarget: 111,
+		}
+
+		err = store.CreateLoopOut(ctx, sweepReq.SwapHash, swap)
+		require.NoError(t, err)
+		store.AssertLoopOutStored()
+
+		require.NoError(t, batcher.AddSweep(ctx, &sweepReq))
+		<-lnd.RegisterSpendChannel
+	}
+
+	// Sweeps that fund a channel can't be fee bumped once they are
+	// finalized, so they pay at least the priority fee rate, which is
+	// higher than the fee rate of our sweeps.
+	priorityFeeRate := test.DefaultMockFee * 3
+	lnd.SetFeeEstimate(priorityConfTarget, priorityFeeRate)
+
+	// The first sweep pays to the funding output, and the rest of its
+	// value goes to its destination address.
+	op1 := wire.OutPoint{
+		Hash:  chainhash.Hash{1, 1},
+		Index: 1,
+	}
+	swapHash1 := lntypes.Hash{1, 1, 1}
+	addSweep(swapHash1, op1, 800)
+
+	tx1 := <-lnd.TxPublishChannel
+	require.Len(t, tx1.TxOut, 2)
+	require.Equal(t, destPkScript, tx1.TxOut[0].PkScript)
+	require.Less(t, tx1.TxOut[0].Value, int64(100_000))
+	require.Equal(t, fundingOutput, tx1.TxOut[1])
+
+	fee1 := btcutil.Amount(1_000_000 - tx1.TxOut[0].Value -
+		tx1.TxOut[1].Value)
+	weight1 := lntypes.WeightUnit(
+		blockchain.GetTransactionWeight(btcutil.NewTx(tx1)),
+	)
+	require.GreaterOrEqual(
+		t, chainfee.NewSatPerKWeight(fee1, weight1), priorityFeeRate,
+	)
+
+	funder.mu.Lock()
+	require.Equal(t, tx1.TxHash(), funder.finalized[swapHash1].TxHash())
+	funder.mu.Unlock()
+
+	// The channel is committed to the transaction, so it is republished
+	// unchanged at the next block, rather than fee bumped.
+	err = lnd.NotifyHeight(601)
+	require.NoError(t, err)
+
+	tx2 := <-lnd.TxPublishChannel
+	require.Equal(t, tx1.TxHash(), tx2.TxHash())
+
+	// The negotiation of the second channel fails. We retry until the
+	// sweep gets close to its timeout, and then fall back to sweeping to
+	// the destination address.
+	swapHash2 := lntypes.Hash{2, 2, 2}
+	funder.mu.Lock()
+	funder.errs[swapHash2] = errors.New("peer offline")
+	funder.mu.Unlock()
+
+	op2 := wire.OutPoint{
+		Hash:  chainhash.Hash{2, 2},
+		Index: 2,
+	}
+	addSweep(swapHash2, op2, 603+channelFundingDelta)
+
+	// Only the first batch publishes at this height.
+	err = lnd.NotifyHeight(602)
+	require.NoError(t, err)
+
+	tx3 := <-lnd.TxPublishChannel
+	require.Equal(t, op1, tx3.TxIn[0].PreviousOutPoint)
+
+	err = lnd.NotifyHeight(603)
+	require.NoError(t, err)
+
+	// Both batches publish now, in any order.
+	for i := 0; i < 2; i++ {
+		tx := <-lnd.TxPublishChannel
+		if tx.TxIn[0].PreviousOutPoint != op2 {
+			continue
+		}
+
+		require.Len(t, tx.TxOut, 1)
+		require.Equal(t, destPkScript, tx.TxOut[0].PkScript)
+	}
+
+	funder.mu.Lock()
+	require.True(t, funder.fallBack[swapHash2])
+	require.False(t, funder.fallBack[swapHash1])
+	funder.mu.Unlock()
+}
+
 // TestSweepBatcherBatchCreation tests that sweep requests enter the expected
 // batch based on their timeout distance.
 func TestSweepBatcherBatchCreation(t *testing.T) {
@@ -5017,6 +5254,13 @@ func TestFeeRateGrows(t *testing.T) {
 	runTests(t, testFeeRateGrows)
 }
 
+// TestChannelFunding tests sweeps that pay to the funding output of a channel,
+// and that fall back to their destination address if the channel can't be
+// negotiated before their timeout.
+func TestChannelFunding(t *testing.T) {
+	runTests(t, testChannelFunding)
+}
+
 // testBatcherStore is BatcherStore used in tests.
 type testBatcherStore interface {
 	BatcherStore
@@ -61,6 +61,15 @@ func (h *mockLightningClient) ConfirmedWalletBalance(ctx context.Context) (
 	return 1000000, nil
 }
 
+// WalletBalance returns a summary of the mock's wallet balance.
+func (h *mockLightningClient) WalletBalance(ctx context.Context) (
+	*lndclient.WalletBalance, error) {
+
+	return &lndclient.WalletBalance{
+		Confirmed: 1000000,
+	}, nil
+}
+
 func (h *mockLightningClient) GetInfo(ctx context.Context) (*lndclient.Info,
 	error) {
 
@@ -184,9 +193,14 @@ func (h *mockLightningClient) ListTransactions(
 func (h *mockLightningClient) GetNodeInfo(ctx context.Context,
 	pubKeyBytes route.Vertex, includeChannels bool) (*lndclient.NodeInfo, error) {
 
+	h.lnd.lock.Lock()
+	alias := h.lnd.NodeAliases[pubKeyBytes]
+	h.lnd.lock.Unlock()
+
 	nodeInfo := &lndclient.NodeInfo{
 		Node: &lndclient.Node{
 			PubKey: pubKeyBytes,
+			Alias:  alias,
 		},
 	}
 
@@ -244,6 +258,13 @@ func (h *mockLightningClient) ClosedChannels(_ context.Context) ([]lndclient.Clo
 	return h.lnd.ClosedChannels, nil
 }
 
+// PendingChannels returns a list of our pending channels.
+func (h *mockLightningClient) PendingChannels(_ context.Context) (
+	*lndclient.PendingChannels, error) {
+
+	return &h.lnd.PendingChannels, nil
+}
+
 // ForwardingHistory returns the mock's set of forwarding events.
 func (h *mockLightningClient) ForwardingHistory(_ context.Context,
 	_ lndclient.ForwardingHistoryRequest) (*lndclient.ForwardingHistoryResponse,
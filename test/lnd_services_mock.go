@@ -12,6 +12,7 @@ import (
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
 )
 
@@ -173,10 +174,15 @@ type LndMockServices struct {
 	Channels            []lndclient.ChannelInfo
 	ChannelEdges        map[uint64]*lndclient.ChannelEdge
 	ClosedChannels      []lndclient.ClosedChannel
+	PendingChannels     lndclient.PendingChannels
 	ForwardingEvents    []lndclient.ForwardingEvent
 	Payments            []lndclient.Payment
 	MissionControlState []lndclient.MissionControlEntry
 
+	// NodeAliases maps the pubkeys of nodes to the aliases that the mock
+	// reports for them.
+	NodeAliases map[route.Vertex]string
+
 	WaitForFinished func()
 
 	PublishHandler PublishHandler